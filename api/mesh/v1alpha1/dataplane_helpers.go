@@ -24,6 +24,10 @@ const (
 	// Optional tag that has a reserved meaning in Kuma.
 	// If absent, Kuma will treat application's protocol as opaque TCP.
 	ProtocolTag = "kuma.io/protocol"
+	// Optional tag that opts a service with `kuma.io/protocol: redis` into command-level proxying.
+	// Envoy terminates the Redis protocol in this mode, so AUTH, SELECT, MULTI/EXEC and pub/sub commands are not supported.
+	// If absent, Kuma will proxy Redis traffic as opaque TCP, passing every command through.
+	RedisProxyTag = "kuma.io/redis-proxy"
 	// InstanceTag is set only for Dataplanes that implements headless services
 	InstanceTag = "kuma.io/instance"
 
@@ -50,7 +50,7 @@ func (ds *DataplaneInsight) GetSubscription(id string) (int, *DiscoverySubscript
 	return -1, nil
 }
 
-func (ds *DataplaneInsight) UpdateCert(generation time.Time, expiration time.Time) error {
+func (ds *DataplaneInsight) UpdateCert(generation time.Time, expiration time.Time, serial string, issuedBackend string, supportedBackends []string) error {
 	if ds.MTLS == nil {
 		ds.MTLS = &DataplaneInsight_MTLS{}
 	}
@@ -65,9 +65,33 @@ func (ds *DataplaneInsight) UpdateCert(generation time.Time, expiration time.Tim
 		return err
 	}
 	ds.MTLS.LastCertificateRegeneration = ts
+	ds.MTLS.CertificateSerial = serial
+	ds.MTLS.IssuedBackend = issuedBackend
+	ds.MTLS.SupportedBackends = supportedBackends
+
+	// expired certificates don't have to be revoked, therefore they are not tracked anymore
+	issued := ds.GetValidCertificates(generation)
+	if serial != "" {
+		issued = append(issued, &DataplaneInsight_MTLS_IssuedCertificate{
+			Serial:         serial,
+			ExpirationTime: ds.MTLS.CertificateExpirationTime,
+		})
+	}
+	ds.MTLS.IssuedCertificates = issued
 	return nil
 }
 
+// GetValidCertificates returns certificates issued to the Dataplane that have not expired at the given time
+func (ds *DataplaneInsight) GetValidCertificates(now time.Time) []*DataplaneInsight_MTLS_IssuedCertificate {
+	var valid []*DataplaneInsight_MTLS_IssuedCertificate
+	for _, cert := range ds.GetMTLS().GetIssuedCertificates() {
+		if cert.GetExpirationTime() != nil && cert.GetExpirationTime().AsTime().After(now) {
+			valid = append(valid, cert)
+		}
+	}
+	return valid
+}
+
 func (ds *DataplaneInsight) UpdateSubscription(s *DiscoverySubscription) {
 	if ds == nil {
 		return
@@ -26,6 +26,39 @@ var _ = Describe("DataplaneHelpers", func() {
 			t3, _ = time.Parse(time.RFC3339, "2019-09-19T19:09:49+00:00")
 		})
 
+		Describe("UpdateCert()", func() {
+
+			It("should track certificates that have not expired", func() {
+				// given certificates issued at t1 and t2
+				Expect(status.UpdateCert(t1, t2, "1a", "ca-1", []string{"ca-1"})).To(Succeed())
+				Expect(status.UpdateCert(t2, t3.Add(30*time.Minute), "2b", "ca-1", []string{"ca-1"})).To(Succeed())
+
+				// when a certificate is issued at t3, after the first one expired
+				Expect(status.UpdateCert(t3, t3.Add(time.Hour), "3c", "ca-1", []string{"ca-1"})).To(Succeed())
+
+				// then
+				Expect(util_proto.ToYAML(status.MTLS)).To(MatchYAML(`
+                certificateExpirationTime: "2019-09-19T20:09:49Z"
+                certificateRegenerations: 3
+                certificateSerial: 3c
+                issuedBackend: ca-1
+                issuedCertificates:
+                - expirationTime: "2019-09-19T19:39:49Z"
+                  serial: 2b
+                - expirationTime: "2019-09-19T20:09:49Z"
+                  serial: 3c
+                lastCertificateRegeneration: "2019-09-19T19:09:49Z"
+                supportedBackends:
+                - ca-1
+`))
+
+				// and only the last certificate is valid after the second one expires
+				valid := status.GetValidCertificates(t3.Add(45 * time.Minute))
+				Expect(valid).To(HaveLen(1))
+				Expect(valid[0].Serial).To(Equal("3c"))
+			})
+		})
+
 		Describe("UpdateSubscription()", func() {
 
 			It("should add new subscriptions", func() {
@@ -3,8 +3,11 @@ package v1alpha1
 const (
 	LoggingTcpType  = "tcp"
 	LoggingFileType = "file"
+	LoggingGrpcType = "grpc"
 
-	TracingZipkinType = "zipkin"
+	TracingZipkinType        = "zipkin"
+	TracingDatadogType       = "datadog"
+	TracingOpenTelemetryType = "opentelemetry"
 
 	MetricsPrometheusType = "prometheus"
 )
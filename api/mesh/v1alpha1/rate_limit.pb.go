@@ -29,6 +29,59 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// Mode defines where the requests are accounted.
+type RateLimit_Conf_Http_Mode int32
+
+const (
+	// Every instance of the destination accounts the requests on its own.
+	RateLimit_Conf_Http_LOCAL RateLimit_Conf_Http_Mode = 0
+	// Requests are accounted across all instances of the destination by
+	// the rate limit service defined in Mesh.rateLimits.service.
+	// Descriptors sent to the service are built out of the source and
+	// destination tags, the limits are defined in the configuration of
+	// the service, therefore `requests` and `interval` are not used.
+	RateLimit_Conf_Http_GLOBAL RateLimit_Conf_Http_Mode = 1
+)
+
+// Enum value maps for RateLimit_Conf_Http_Mode.
+var (
+	RateLimit_Conf_Http_Mode_name = map[int32]string{
+		0: "LOCAL",
+		1: "GLOBAL",
+	}
+	RateLimit_Conf_Http_Mode_value = map[string]int32{
+		"LOCAL":  0,
+		"GLOBAL": 1,
+	}
+)
+
+func (x RateLimit_Conf_Http_Mode) Enum() *RateLimit_Conf_Http_Mode {
+	p := new(RateLimit_Conf_Http_Mode)
+	*p = x
+	return p
+}
+
+func (x RateLimit_Conf_Http_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateLimit_Conf_Http_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_rate_limit_proto_enumTypes[0].Descriptor()
+}
+
+func (RateLimit_Conf_Http_Mode) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_rate_limit_proto_enumTypes[0]
+}
+
+func (x RateLimit_Conf_Http_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateLimit_Conf_Http_Mode.Descriptor instead.
+func (RateLimit_Conf_Http_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_rate_limit_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
 type RateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -104,6 +157,13 @@ type RateLimit_Conf struct {
 	// The HTTP RateLimit configuration
 	// +optional
 	Http *RateLimit_Conf_Http `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	// The TCP RateLimit configuration. Connections are accounted by every
+	// instance of the destination regardless of the source, therefore only
+	// the most specific RateLimit matching the destination is applied.
+	// Sources have to match every service (`kuma.io/service: '*'`),
+	// TCP connections cannot be limited per source.
+	// +optional
+	Tcp *RateLimit_Conf_Tcp `protobuf:"bytes,2,opt,name=tcp,proto3" json:"tcp,omitempty"`
 }
 
 func (x *RateLimit_Conf) Reset() {
@@ -145,6 +205,13 @@ func (x *RateLimit_Conf) GetHttp() *RateLimit_Conf_Http {
 	return nil
 }
 
+func (x *RateLimit_Conf) GetTcp() *RateLimit_Conf_Tcp {
+	if x != nil {
+		return x.Tcp
+	}
+	return nil
+}
+
 type RateLimit_Conf_Http struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -159,6 +226,9 @@ type RateLimit_Conf_Http struct {
 	// Describes the actions to take on RatelLimiter event
 	// +optional
 	OnRateLimit *RateLimit_Conf_Http_OnRateLimit `protobuf:"bytes,3,opt,name=onRateLimit,proto3" json:"onRateLimit,omitempty"`
+	// Mode of the RateLimiter. Defaults to LOCAL
+	// +optional
+	Mode RateLimit_Conf_Http_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=kuma.mesh.v1alpha1.RateLimit_Conf_Http_Mode" json:"mode,omitempty"`
 }
 
 func (x *RateLimit_Conf_Http) Reset() {
@@ -214,6 +284,72 @@ func (x *RateLimit_Conf_Http) GetOnRateLimit() *RateLimit_Conf_Http_OnRateLimit
 	return nil
 }
 
+func (x *RateLimit_Conf_Http) GetMode() RateLimit_Conf_Http_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return RateLimit_Conf_Http_LOCAL
+}
+
+type RateLimit_Conf_Tcp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of TCP connections this RateLimiter allows
+	// +required
+	Connections uint32 `protobuf:"varint,1,opt,name=connections,proto3" json:"connections,omitempty"`
+	// The the interval for which `connections` will be accounted.
+	// +required
+	Interval *duration.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *RateLimit_Conf_Tcp) Reset() {
+	*x = RateLimit_Conf_Tcp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimit_Conf_Tcp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit_Conf_Tcp) ProtoMessage() {}
+
+func (x *RateLimit_Conf_Tcp) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit_Conf_Tcp.ProtoReflect.Descriptor instead.
+func (*RateLimit_Conf_Tcp) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_rate_limit_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *RateLimit_Conf_Tcp) GetConnections() uint32 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+func (x *RateLimit_Conf_Tcp) GetInterval() *duration.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
 type RateLimit_Conf_Http_OnRateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -230,7 +366,7 @@ type RateLimit_Conf_Http_OnRateLimit struct {
 func (x *RateLimit_Conf_Http_OnRateLimit) Reset() {
 	*x = RateLimit_Conf_Http_OnRateLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[3]
+		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -243,7 +379,7 @@ func (x *RateLimit_Conf_Http_OnRateLimit) String() string {
 func (*RateLimit_Conf_Http_OnRateLimit) ProtoMessage() {}
 
 func (x *RateLimit_Conf_Http_OnRateLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[3]
+	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +428,7 @@ type RateLimit_Conf_Http_OnRateLimit_HeaderValue struct {
 func (x *RateLimit_Conf_Http_OnRateLimit_HeaderValue) Reset() {
 	*x = RateLimit_Conf_Http_OnRateLimit_HeaderValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[4]
+		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -305,7 +441,7 @@ func (x *RateLimit_Conf_Http_OnRateLimit_HeaderValue) String() string {
 func (*RateLimit_Conf_Http_OnRateLimit_HeaderValue) ProtoMessage() {}
 
 func (x *RateLimit_Conf_Http_OnRateLimit_HeaderValue) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[4]
+	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +492,7 @@ var file_mesh_v1alpha1_rate_limit_proto_rawDesc = []byte{
 	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
 	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x05, 0x0a, 0x09, 0x52, 0x61,
+	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x07, 0x0a, 0x09, 0x52, 0x61,
 	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
 	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65,
@@ -369,43 +505,59 @@ var file_mesh_v1alpha1_rate_limit_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
 	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0x82, 0x04,
+	0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xfd, 0x05,
 	0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x3b, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
 	0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x04, 0x68,
-	0x74, 0x74, 0x70, 0x1a, 0xbc, 0x03, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
-	0x55, 0x0a, 0x0b, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4f, 0x6e,
-	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0b, 0x6f, 0x6e, 0x52, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x89, 0x02, 0x0a, 0x0b, 0x4f, 0x6e, 0x52, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x07,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
-	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x69, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32,
-	0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65,
-	0x6e, 0x64, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x74, 0x70, 0x12, 0x38, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x03, 0x74, 0x63, 0x70, 0x1a, 0x9d, 0x04,
+	0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x55, 0x0a, 0x0b, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x0b, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x40, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x1a, 0x89, 0x02, 0x0a, 0x0b, 0x4f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x2e, 0x4f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x1a, 0x69, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x1d,
+	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x01, 0x1a, 0x5e, 0x0a,
+	0x03, 0x54, 0x63, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x2a, 0x5a,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61,
+	0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -420,33 +572,39 @@ func file_mesh_v1alpha1_rate_limit_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_rate_limit_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_rate_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_mesh_v1alpha1_rate_limit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mesh_v1alpha1_rate_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_mesh_v1alpha1_rate_limit_proto_goTypes = []interface{}{
-	(*RateLimit)(nil),                                   // 0: kuma.mesh.v1alpha1.RateLimit
-	(*RateLimit_Conf)(nil),                              // 1: kuma.mesh.v1alpha1.RateLimit.Conf
-	(*RateLimit_Conf_Http)(nil),                         // 2: kuma.mesh.v1alpha1.RateLimit.Conf.Http
-	(*RateLimit_Conf_Http_OnRateLimit)(nil),             // 3: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit
-	(*RateLimit_Conf_Http_OnRateLimit_HeaderValue)(nil), // 4: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue
-	(*Selector)(nil),                                    // 5: kuma.mesh.v1alpha1.Selector
-	(*duration.Duration)(nil),                           // 6: google.protobuf.Duration
-	(*wrappers.UInt32Value)(nil),                        // 7: google.protobuf.UInt32Value
-	(*wrappers.BoolValue)(nil),                          // 8: google.protobuf.BoolValue
+	(RateLimit_Conf_Http_Mode)(0),                       // 0: kuma.mesh.v1alpha1.RateLimit.Conf.Http.Mode
+	(*RateLimit)(nil),                                   // 1: kuma.mesh.v1alpha1.RateLimit
+	(*RateLimit_Conf)(nil),                              // 2: kuma.mesh.v1alpha1.RateLimit.Conf
+	(*RateLimit_Conf_Http)(nil),                         // 3: kuma.mesh.v1alpha1.RateLimit.Conf.Http
+	(*RateLimit_Conf_Tcp)(nil),                          // 4: kuma.mesh.v1alpha1.RateLimit.Conf.Tcp
+	(*RateLimit_Conf_Http_OnRateLimit)(nil),             // 5: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit
+	(*RateLimit_Conf_Http_OnRateLimit_HeaderValue)(nil), // 6: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue
+	(*Selector)(nil),                                    // 7: kuma.mesh.v1alpha1.Selector
+	(*duration.Duration)(nil),                           // 8: google.protobuf.Duration
+	(*wrappers.UInt32Value)(nil),                        // 9: google.protobuf.UInt32Value
+	(*wrappers.BoolValue)(nil),                          // 10: google.protobuf.BoolValue
 }
 var file_mesh_v1alpha1_rate_limit_proto_depIdxs = []int32{
-	5, // 0: kuma.mesh.v1alpha1.RateLimit.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	5, // 1: kuma.mesh.v1alpha1.RateLimit.destinations:type_name -> kuma.mesh.v1alpha1.Selector
-	1, // 2: kuma.mesh.v1alpha1.RateLimit.conf:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf
-	2, // 3: kuma.mesh.v1alpha1.RateLimit.Conf.http:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http
-	6, // 4: kuma.mesh.v1alpha1.RateLimit.Conf.Http.interval:type_name -> google.protobuf.Duration
-	3, // 5: kuma.mesh.v1alpha1.RateLimit.Conf.Http.onRateLimit:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit
-	7, // 6: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.status:type_name -> google.protobuf.UInt32Value
-	4, // 7: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.headers:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue
-	8, // 8: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue.append:type_name -> google.protobuf.BoolValue
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	7,  // 0: kuma.mesh.v1alpha1.RateLimit.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	7,  // 1: kuma.mesh.v1alpha1.RateLimit.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	2,  // 2: kuma.mesh.v1alpha1.RateLimit.conf:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf
+	3,  // 3: kuma.mesh.v1alpha1.RateLimit.Conf.http:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http
+	4,  // 4: kuma.mesh.v1alpha1.RateLimit.Conf.tcp:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Tcp
+	8,  // 5: kuma.mesh.v1alpha1.RateLimit.Conf.Http.interval:type_name -> google.protobuf.Duration
+	5,  // 6: kuma.mesh.v1alpha1.RateLimit.Conf.Http.onRateLimit:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit
+	0,  // 7: kuma.mesh.v1alpha1.RateLimit.Conf.Http.mode:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http.Mode
+	8,  // 8: kuma.mesh.v1alpha1.RateLimit.Conf.Tcp.interval:type_name -> google.protobuf.Duration
+	9,  // 9: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.status:type_name -> google.protobuf.UInt32Value
+	6,  // 10: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.headers:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue
+	10, // 11: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue.append:type_name -> google.protobuf.BoolValue
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_rate_limit_proto_init() }
@@ -493,7 +651,7 @@ func file_mesh_v1alpha1_rate_limit_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_rate_limit_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RateLimit_Conf_Http_OnRateLimit); i {
+			switch v := v.(*RateLimit_Conf_Tcp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -505,6 +663,18 @@ func file_mesh_v1alpha1_rate_limit_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_rate_limit_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimit_Conf_Http_OnRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_rate_limit_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RateLimit_Conf_Http_OnRateLimit_HeaderValue); i {
 			case 0:
 				return &v.state
@@ -522,13 +692,14 @@ func file_mesh_v1alpha1_rate_limit_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_rate_limit_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_mesh_v1alpha1_rate_limit_proto_goTypes,
 		DependencyIndexes: file_mesh_v1alpha1_rate_limit_proto_depIdxs,
+		EnumInfos:         file_mesh_v1alpha1_rate_limit_proto_enumTypes,
 		MessageInfos:      file_mesh_v1alpha1_rate_limit_proto_msgTypes,
 	}.Build()
 	File_mesh_v1alpha1_rate_limit_proto = out.File
@@ -6,3 +6,19 @@ func (rl *RateLimit) SourceTags() (setList []SingleValueTagSet) {
 	}
 	return
 }
+
+// AppliesToAllSources returns true when one of the sources matches every service.
+// TCP connections cannot be distinguished by the source, so only such RateLimits can limit them.
+func (rl *RateLimit) AppliesToAllSources() bool {
+	for _, selector := range rl.GetSources() {
+		if TagSelector(selector.GetMatch()).Equal(MatchAnyService()) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsGlobal returns true when requests are accounted by the external rate limit service.
+func (rl *RateLimit) IsGlobal() bool {
+	return rl.GetConf().GetHttp().GetMode() == RateLimit_Conf_Http_GLOBAL
+}
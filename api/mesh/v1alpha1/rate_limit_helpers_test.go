@@ -51,4 +51,23 @@ var _ = Describe("RateLimitHelper", func() {
 				},
 			}))
 	})
+
+	Describe("AppliesToAllSources", func() {
+		DescribeTable("should check whether one of the sources matches every service",
+			func(sources []*Selector, expected bool) {
+				// given
+				rateLimit := &RateLimit{Sources: sources}
+
+				// expect
+				Expect(rateLimit.AppliesToAllSources()).To(Equal(expected))
+			},
+			Entry("any service", []*Selector{{Match: SingleValueTagSet{"kuma.io/service": "*"}}}, true),
+			Entry("any service among other sources", []*Selector{
+				{Match: SingleValueTagSet{"kuma.io/service": "frontend"}},
+				{Match: SingleValueTagSet{"kuma.io/service": "*"}},
+			}, true),
+			Entry("specific service", []*Selector{{Match: SingleValueTagSet{"kuma.io/service": "frontend"}}}, false),
+			Entry("any service with other tags", []*Selector{{Match: SingleValueTagSet{"kuma.io/service": "*", "version": "v1"}}}, false),
+		)
+	})
 })
@@ -8,6 +8,8 @@ package v1alpha1
 
 import (
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -101,6 +103,9 @@ type TrafficLog_Conf struct {
 
 	// Backend defined in the Mesh entity.
 	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Filters of HTTP requests. If defined, only requests matching at least one
+	// of the filters are logged. Filters do not apply to TCP traffic.
+	Filters []*TrafficLog_Conf_Filter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
 }
 
 func (x *TrafficLog_Conf) Reset() {
@@ -142,6 +147,150 @@ func (x *TrafficLog_Conf) GetBackend() string {
 	return ""
 }
 
+func (x *TrafficLog_Conf) GetFilters() []*TrafficLog_Conf_Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+// Filter defines conditions of logging HTTP requests.
+// All the conditions of a filter have to be met for a request to be logged.
+type TrafficLog_Conf_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// StatusCodes matches requests with a response status code in the range.
+	StatusCodes *TrafficLog_Conf_Filter_StatusCodes `protobuf:"bytes,1,opt,name=statusCodes,proto3" json:"statusCodes,omitempty"`
+	// MinDuration matches requests that took at least the given time.
+	MinDuration *duration.Duration `protobuf:"bytes,2,opt,name=minDuration,proto3" json:"minDuration,omitempty"`
+	// Match matches HTTP requests the same way as TrafficRoute does.
+	// The path is matched without the query string, except for a regex that
+	// can match the '?' character.
+	Match *TrafficRoute_Http_Match `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	// Sampling is a percentage of matching requests that are logged.
+	// Value has to be in [0.0 - 100.0] range. All the requests are logged
+	// if not defined.
+	Sampling *wrappers.DoubleValue `protobuf:"bytes,4,opt,name=sampling,proto3" json:"sampling,omitempty"`
+}
+
+func (x *TrafficLog_Conf_Filter) Reset() {
+	*x = TrafficLog_Conf_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_log_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficLog_Conf_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficLog_Conf_Filter) ProtoMessage() {}
+
+func (x *TrafficLog_Conf_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_log_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficLog_Conf_Filter.ProtoReflect.Descriptor instead.
+func (*TrafficLog_Conf_Filter) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_log_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *TrafficLog_Conf_Filter) GetStatusCodes() *TrafficLog_Conf_Filter_StatusCodes {
+	if x != nil {
+		return x.StatusCodes
+	}
+	return nil
+}
+
+func (x *TrafficLog_Conf_Filter) GetMinDuration() *duration.Duration {
+	if x != nil {
+		return x.MinDuration
+	}
+	return nil
+}
+
+func (x *TrafficLog_Conf_Filter) GetMatch() *TrafficRoute_Http_Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *TrafficLog_Conf_Filter) GetSampling() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+// StatusCodes defines an inclusive range of response status codes.
+type TrafficLog_Conf_Filter_StatusCodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min uint32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *TrafficLog_Conf_Filter_StatusCodes) Reset() {
+	*x = TrafficLog_Conf_Filter_StatusCodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_log_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficLog_Conf_Filter_StatusCodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficLog_Conf_Filter_StatusCodes) ProtoMessage() {}
+
+func (x *TrafficLog_Conf_Filter_StatusCodes) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_log_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficLog_Conf_Filter_StatusCodes.ProtoReflect.Descriptor instead.
+func (*TrafficLog_Conf_Filter_StatusCodes) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_log_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *TrafficLog_Conf_Filter_StatusCodes) GetMin() uint32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *TrafficLog_Conf_Filter_StatusCodes) GetMax() uint32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
 var File_mesh_v1alpha1_traffic_log_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_traffic_log_proto_rawDesc = []byte{
@@ -150,27 +299,59 @@ var file_mesh_v1alpha1_traffic_log_proto_rawDesc = []byte{
 	0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
 	0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xe1, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67,
-	0x12, 0x36, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x04, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x4c, 0x6f, 0x67, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
-	0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x04, 0x63, 0x6f,
-	0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
-	0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x04, 0x63,
-	0x6f, 0x6e, 0x66, 0x1a, 0x20, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x4b, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x8a, 0xb5, 0x18, 0x1d, 0x50, 0x01, 0xa2, 0x01, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x4c, 0x6f, 0x67, 0xf2, 0x01, 0x0b, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x6c,
-	0x6f, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a,
+	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xb8, 0x03, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x44, 0x0a, 0x07, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a,
+	0xcf, 0x02, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x41, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x1a, 0x31,
+	0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x42, 0x4b, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18,
+	0x1d, 0x50, 0x01, 0xa2, 0x01, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x6f, 0x67,
+	0xf2, 0x01, 0x0b, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x6c, 0x6f, 0x67, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -185,21 +366,31 @@ func file_mesh_v1alpha1_traffic_log_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_traffic_log_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_traffic_log_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mesh_v1alpha1_traffic_log_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_mesh_v1alpha1_traffic_log_proto_goTypes = []interface{}{
-	(*TrafficLog)(nil),      // 0: kuma.mesh.v1alpha1.TrafficLog
-	(*TrafficLog_Conf)(nil), // 1: kuma.mesh.v1alpha1.TrafficLog.Conf
-	(*Selector)(nil),        // 2: kuma.mesh.v1alpha1.Selector
+	(*TrafficLog)(nil),                         // 0: kuma.mesh.v1alpha1.TrafficLog
+	(*TrafficLog_Conf)(nil),                    // 1: kuma.mesh.v1alpha1.TrafficLog.Conf
+	(*TrafficLog_Conf_Filter)(nil),             // 2: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter
+	(*TrafficLog_Conf_Filter_StatusCodes)(nil), // 3: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.StatusCodes
+	(*Selector)(nil),                           // 4: kuma.mesh.v1alpha1.Selector
+	(*duration.Duration)(nil),                  // 5: google.protobuf.Duration
+	(*TrafficRoute_Http_Match)(nil),            // 6: kuma.mesh.v1alpha1.TrafficRoute.Http.Match
+	(*wrappers.DoubleValue)(nil),               // 7: google.protobuf.DoubleValue
 }
 var file_mesh_v1alpha1_traffic_log_proto_depIdxs = []int32{
-	2, // 0: kuma.mesh.v1alpha1.TrafficLog.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	2, // 1: kuma.mesh.v1alpha1.TrafficLog.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	4, // 0: kuma.mesh.v1alpha1.TrafficLog.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	4, // 1: kuma.mesh.v1alpha1.TrafficLog.destinations:type_name -> kuma.mesh.v1alpha1.Selector
 	1, // 2: kuma.mesh.v1alpha1.TrafficLog.conf:type_name -> kuma.mesh.v1alpha1.TrafficLog.Conf
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2, // 3: kuma.mesh.v1alpha1.TrafficLog.Conf.filters:type_name -> kuma.mesh.v1alpha1.TrafficLog.Conf.Filter
+	3, // 4: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.statusCodes:type_name -> kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.StatusCodes
+	5, // 5: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.minDuration:type_name -> google.protobuf.Duration
+	6, // 6: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.match:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match
+	7, // 7: kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.sampling:type_name -> google.protobuf.DoubleValue
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_traffic_log_proto_init() }
@@ -208,6 +399,7 @@ func file_mesh_v1alpha1_traffic_log_proto_init() {
 		return
 	}
 	file_mesh_v1alpha1_selector_proto_init()
+	file_mesh_v1alpha1_traffic_route_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_mesh_v1alpha1_traffic_log_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficLog); i {
@@ -233,6 +425,30 @@ func file_mesh_v1alpha1_traffic_log_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_traffic_log_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficLog_Conf_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_log_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficLog_Conf_Filter_StatusCodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -240,7 +456,7 @@ func file_mesh_v1alpha1_traffic_log_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_traffic_log_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
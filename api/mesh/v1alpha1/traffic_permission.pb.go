@@ -26,6 +26,56 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// Action defines what happens with the traffic matched by the permission.
+type TrafficPermission_Action int32
+
+const (
+	// The traffic is allowed.
+	TrafficPermission_ALLOW TrafficPermission_Action = 0
+	// The traffic is denied. Denying permissions take precedence over the
+	// allowing ones regardless of how specific their selectors are.
+	TrafficPermission_DENY TrafficPermission_Action = 1
+)
+
+// Enum value maps for TrafficPermission_Action.
+var (
+	TrafficPermission_Action_name = map[int32]string{
+		0: "ALLOW",
+		1: "DENY",
+	}
+	TrafficPermission_Action_value = map[string]int32{
+		"ALLOW": 0,
+		"DENY":  1,
+	}
+)
+
+func (x TrafficPermission_Action) Enum() *TrafficPermission_Action {
+	p := new(TrafficPermission_Action)
+	*p = x
+	return p
+}
+
+func (x TrafficPermission_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrafficPermission_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_traffic_permission_proto_enumTypes[0].Descriptor()
+}
+
+func (TrafficPermission_Action) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_traffic_permission_proto_enumTypes[0]
+}
+
+func (x TrafficPermission_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrafficPermission_Action.Descriptor instead.
+func (TrafficPermission_Action) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_permission_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // TrafficPermission defines permission for traffic between dataplanes.
 type TrafficPermission struct {
 	state         protoimpl.MessageState
@@ -36,6 +86,11 @@ type TrafficPermission struct {
 	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
 	// List of selectors to match services that are destinations of traffic.
 	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Configuration of the permission. When omitted, all the traffic between
+	// sources and destinations is permitted.
+	Conf *TrafficPermission_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	// Action of the permission. Defaults to ALLOW.
+	Action TrafficPermission_Action `protobuf:"varint,4,opt,name=action,proto3,enum=kuma.mesh.v1alpha1.TrafficPermission_Action" json:"action,omitempty"`
 }
 
 func (x *TrafficPermission) Reset() {
@@ -84,6 +139,187 @@ func (x *TrafficPermission) GetDestinations() []*Selector {
 	return nil
 }
 
+func (x *TrafficPermission) GetConf() *TrafficPermission_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+func (x *TrafficPermission) GetAction() TrafficPermission_Action {
+	if x != nil {
+		return x.Action
+	}
+	return TrafficPermission_ALLOW
+}
+
+// Conf defines which requests are permitted.
+type TrafficPermission_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Http *TrafficPermission_Conf_Http `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+}
+
+func (x *TrafficPermission_Conf) Reset() {
+	*x = TrafficPermission_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_permission_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPermission_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPermission_Conf) ProtoMessage() {}
+
+func (x *TrafficPermission_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_permission_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPermission_Conf.ProtoReflect.Descriptor instead.
+func (*TrafficPermission_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_permission_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *TrafficPermission_Conf) GetHttp() *TrafficPermission_Conf_Http {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+// Http defines layer-7 rules for the traffic. Rules are applied only when
+// the destination inbound uses http, http2 or grpc protocol. Otherwise the
+// traffic from the sources is permitted at the network level.
+type TrafficPermission_Conf_Http struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of rules. Request is permitted when it matches any of the rules.
+	Rules []*TrafficPermission_Conf_Http_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *TrafficPermission_Conf_Http) Reset() {
+	*x = TrafficPermission_Conf_Http{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_permission_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPermission_Conf_Http) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPermission_Conf_Http) ProtoMessage() {}
+
+func (x *TrafficPermission_Conf_Http) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_permission_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPermission_Conf_Http.ProtoReflect.Descriptor instead.
+func (*TrafficPermission_Conf_Http) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_permission_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *TrafficPermission_Conf_Http) GetRules() []*TrafficPermission_Conf_Http_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Rule matches a request. All the defined elements have to match.
+type TrafficPermission_Conf_Http_Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of permitted HTTP methods, e.g. GET. Empty list matches any
+	// method.
+	Methods []string `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+	// Path of the request (without query string).
+	Path *TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Headers of the request.
+	Headers map[string]*TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TrafficPermission_Conf_Http_Rule) Reset() {
+	*x = TrafficPermission_Conf_Http_Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_permission_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPermission_Conf_Http_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPermission_Conf_Http_Rule) ProtoMessage() {}
+
+func (x *TrafficPermission_Conf_Http_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_permission_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPermission_Conf_Http_Rule.ProtoReflect.Descriptor instead.
+func (*TrafficPermission_Conf_Http_Rule) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_permission_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *TrafficPermission_Conf_Http_Rule) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *TrafficPermission_Conf_Http_Rule) GetPath() *TrafficRoute_Http_Match_StringMatcher {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *TrafficPermission_Conf_Http_Rule) GetHeaders() map[string]*TrafficRoute_Http_Match_StringMatcher {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
 var File_mesh_v1alpha1_traffic_permission_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_traffic_permission_proto_rawDesc = []byte{
@@ -92,23 +328,66 @@ var file_mesh_v1alpha1_traffic_permission_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
 	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x6d, 0x65,
 	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36,
-	0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6d, 0x65, 0x73, 0x68,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x06, 0x0a, 0x11,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b,
-	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x5b, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75,
-	0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x2d, 0x50, 0x01, 0xa2, 0x01, 0x12, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0xf2,
-	0x01, 0x13, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x04, 0x63,
+	0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x12, 0x44, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0xe6, 0x03, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x43, 0x0a, 0x04, 0x68, 0x74,
+	0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a,
+	0x98, 0x03, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x4a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x1a, 0xc3, 0x02, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x4d, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x5b, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x1a, 0x75, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1d, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x01, 0x42, 0x5b, 0x5a, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x2d, 0x50, 0x01, 0xa2, 0x01, 0x12, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0xf2, 0x01, 0x13, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -123,19 +402,33 @@ func file_mesh_v1alpha1_traffic_permission_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_traffic_permission_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_traffic_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mesh_v1alpha1_traffic_permission_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mesh_v1alpha1_traffic_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_mesh_v1alpha1_traffic_permission_proto_goTypes = []interface{}{
-	(*TrafficPermission)(nil), // 0: kuma.mesh.v1alpha1.TrafficPermission
-	(*Selector)(nil),          // 1: kuma.mesh.v1alpha1.Selector
+	(TrafficPermission_Action)(0),                 // 0: kuma.mesh.v1alpha1.TrafficPermission.Action
+	(*TrafficPermission)(nil),                     // 1: kuma.mesh.v1alpha1.TrafficPermission
+	(*TrafficPermission_Conf)(nil),                // 2: kuma.mesh.v1alpha1.TrafficPermission.Conf
+	(*TrafficPermission_Conf_Http)(nil),           // 3: kuma.mesh.v1alpha1.TrafficPermission.Conf.Http
+	(*TrafficPermission_Conf_Http_Rule)(nil),      // 4: kuma.mesh.v1alpha1.TrafficPermission.Conf.Http.Rule
+	nil,                                           // 5: kuma.mesh.v1alpha1.TrafficPermission.Conf.Http.Rule.HeadersEntry
+	(*Selector)(nil),                              // 6: kuma.mesh.v1alpha1.Selector
+	(*TrafficRoute_Http_Match_StringMatcher)(nil), // 7: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
 }
 var file_mesh_v1alpha1_traffic_permission_proto_depIdxs = []int32{
-	1, // 0: kuma.mesh.v1alpha1.TrafficPermission.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	1, // 1: kuma.mesh.v1alpha1.TrafficPermission.destinations:type_name -> kuma.mesh.v1alpha1.Selector
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 0: kuma.mesh.v1alpha1.TrafficPermission.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	6, // 1: kuma.mesh.v1alpha1.TrafficPermission.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	2, // 2: kuma.mesh.v1alpha1.TrafficPermission.conf:type_name -> kuma.mesh.v1alpha1.TrafficPermission.Conf
+	0, // 3: kuma.mesh.v1alpha1.TrafficPermission.action:type_name -> kuma.mesh.v1alpha1.TrafficPermission.Action
+	3, // 4: kuma.mesh.v1alpha1.TrafficPermission.Conf.http:type_name -> kuma.mesh.v1alpha1.TrafficPermission.Conf.Http
+	4, // 5: kuma.mesh.v1alpha1.TrafficPermission.Conf.Http.rules:type_name -> kuma.mesh.v1alpha1.TrafficPermission.Conf.Http.Rule
+	7, // 6: kuma.mesh.v1alpha1.TrafficPermission.Conf.Http.Rule.path:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	5, // 7: kuma.mesh.v1alpha1.TrafficPermission.Conf.Http.Rule.headers:type_name -> kuma.mesh.v1alpha1.TrafficPermission.Conf.Http.Rule.HeadersEntry
+	7, // 8: kuma.mesh.v1alpha1.TrafficPermission.Conf.Http.Rule.HeadersEntry.value:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_traffic_permission_proto_init() }
@@ -144,6 +437,7 @@ func file_mesh_v1alpha1_traffic_permission_proto_init() {
 		return
 	}
 	file_mesh_v1alpha1_selector_proto_init()
+	file_mesh_v1alpha1_traffic_route_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_mesh_v1alpha1_traffic_permission_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficPermission); i {
@@ -157,19 +451,56 @@ func file_mesh_v1alpha1_traffic_permission_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_traffic_permission_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPermission_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_permission_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPermission_Conf_Http); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_permission_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPermission_Conf_Http_Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_traffic_permission_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_mesh_v1alpha1_traffic_permission_proto_goTypes,
 		DependencyIndexes: file_mesh_v1alpha1_traffic_permission_proto_depIdxs,
+		EnumInfos:         file_mesh_v1alpha1_traffic_permission_proto_enumTypes,
 		MessageInfos:      file_mesh_v1alpha1_traffic_permission_proto_msgTypes,
 	}.Build()
 	File_mesh_v1alpha1_traffic_permission_proto = out.File
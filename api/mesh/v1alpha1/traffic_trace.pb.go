@@ -8,6 +8,7 @@ package v1alpha1
 
 import (
 	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -92,6 +93,10 @@ type TrafficTrace_Conf struct {
 
 	// Backend defined in the Mesh entity.
 	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Sampling overrides sampling of the backend for selected dataplanes.
+	Sampling *TrafficTrace_Conf_Sampling `protobuf:"bytes,2,opt,name=sampling,proto3" json:"sampling,omitempty"`
+	// Custom tags added to every span.
+	Tags []*TrafficTrace_Conf_Tag `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (x *TrafficTrace_Conf) Reset() {
@@ -133,6 +138,286 @@ func (x *TrafficTrace_Conf) GetBackend() string {
 	return ""
 }
 
+func (x *TrafficTrace_Conf) GetSampling() *TrafficTrace_Conf_Sampling {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+func (x *TrafficTrace_Conf) GetTags() []*TrafficTrace_Conf_Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Sampling defines percentages of traced requests. Every value has to be
+// in [0.0 - 100.0] range.
+type TrafficTrace_Conf_Sampling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Random is a percentage of requests that are randomly selected for
+	// trace generation.
+	Random *wrappers.DoubleValue `protobuf:"bytes,1,opt,name=random,proto3" json:"random,omitempty"`
+	// Client is a percentage of requests that are force traced if
+	// the x-client-trace-id header is set by the client.
+	Client *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	// Overall is a percentage of requests that are traced after all other
+	// sampling checks have been applied.
+	Overall *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=overall,proto3" json:"overall,omitempty"`
+}
+
+func (x *TrafficTrace_Conf_Sampling) Reset() {
+	*x = TrafficTrace_Conf_Sampling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficTrace_Conf_Sampling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficTrace_Conf_Sampling) ProtoMessage() {}
+
+func (x *TrafficTrace_Conf_Sampling) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficTrace_Conf_Sampling.ProtoReflect.Descriptor instead.
+func (*TrafficTrace_Conf_Sampling) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_trace_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *TrafficTrace_Conf_Sampling) GetRandom() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Random
+	}
+	return nil
+}
+
+func (x *TrafficTrace_Conf_Sampling) GetClient() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *TrafficTrace_Conf_Sampling) GetOverall() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+// Tag defines a custom tag added to every span. Exactly one of literal,
+// header or environment has to be defined.
+type TrafficTrace_Conf_Tag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the tag.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Literal is a static value of the tag.
+	Literal string `protobuf:"bytes,2,opt,name=literal,proto3" json:"literal,omitempty"`
+	// Header is a request header that is a source of the tag value.
+	Header *TrafficTrace_Conf_Tag_Header `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	// Environment is an environment variable that is a source of the tag
+	// value.
+	Environment *TrafficTrace_Conf_Tag_Environment `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+}
+
+func (x *TrafficTrace_Conf_Tag) Reset() {
+	*x = TrafficTrace_Conf_Tag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficTrace_Conf_Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficTrace_Conf_Tag) ProtoMessage() {}
+
+func (x *TrafficTrace_Conf_Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficTrace_Conf_Tag.ProtoReflect.Descriptor instead.
+func (*TrafficTrace_Conf_Tag) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_trace_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *TrafficTrace_Conf_Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficTrace_Conf_Tag) GetLiteral() string {
+	if x != nil {
+		return x.Literal
+	}
+	return ""
+}
+
+func (x *TrafficTrace_Conf_Tag) GetHeader() *TrafficTrace_Conf_Tag_Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TrafficTrace_Conf_Tag) GetEnvironment() *TrafficTrace_Conf_Tag_Environment {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+// Header defines a tag whose value comes from a request header.
+type TrafficTrace_Conf_Tag_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the request header.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Default value if the header is absent.
+	Default string `protobuf:"bytes,2,opt,name=default,proto3" json:"default,omitempty"`
+}
+
+func (x *TrafficTrace_Conf_Tag_Header) Reset() {
+	*x = TrafficTrace_Conf_Tag_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficTrace_Conf_Tag_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficTrace_Conf_Tag_Header) ProtoMessage() {}
+
+func (x *TrafficTrace_Conf_Tag_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficTrace_Conf_Tag_Header.ProtoReflect.Descriptor instead.
+func (*TrafficTrace_Conf_Tag_Header) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_trace_proto_rawDescGZIP(), []int{0, 0, 1, 0}
+}
+
+func (x *TrafficTrace_Conf_Tag_Header) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficTrace_Conf_Tag_Header) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+// Environment defines a tag whose value comes from an environment
+// variable of Envoy.
+type TrafficTrace_Conf_Tag_Environment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the environment variable.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Default value if the environment variable is not set.
+	Default string `protobuf:"bytes,2,opt,name=default,proto3" json:"default,omitempty"`
+}
+
+func (x *TrafficTrace_Conf_Tag_Environment) Reset() {
+	*x = TrafficTrace_Conf_Tag_Environment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficTrace_Conf_Tag_Environment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficTrace_Conf_Tag_Environment) ProtoMessage() {}
+
+func (x *TrafficTrace_Conf_Tag_Environment) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_trace_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficTrace_Conf_Tag_Environment.ProtoReflect.Descriptor instead.
+func (*TrafficTrace_Conf_Tag_Environment) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_trace_proto_rawDescGZIP(), []int{0, 0, 1, 1}
+}
+
+func (x *TrafficTrace_Conf_Tag_Environment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficTrace_Conf_Tag_Environment) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
 var File_mesh_v1alpha1_traffic_trace_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_traffic_trace_proto_rawDesc = []byte{
@@ -141,8 +426,10 @@ var file_mesh_v1alpha1_traffic_trace_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x01, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54,
+	0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x06, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54,
 	0x72, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
 	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c,
@@ -150,15 +437,56 @@ var file_mesh_v1alpha1_traffic_trace_proto_rawDesc = []byte{
 	0x12, 0x39, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
 	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0x20, 0x0a, 0x04, 0x43,
-	0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x4f, 0x5a,
-	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61,
-	0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68,
-	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x21, 0x50, 0x01, 0xa2,
-	0x01, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0xf2, 0x01,
-	0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xaa, 0x05, 0x0a, 0x04,
+	0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x4a,
+	0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0xae, 0x01, 0x0a, 0x08, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x1a, 0xcb, 0x02, 0x0a, 0x03, 0x54,
+	0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x12, 0x48, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x61, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x0b, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x61, 0x67, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x1a, 0x36, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x1a, 0x3b, 0x0a, 0x0b, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x4f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75,
+	0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x21, 0x50, 0x01, 0xa2, 0x01, 0x0c, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x54, 0x72, 0x61, 0x63, 0x65, 0xf2, 0x01, 0x0d, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x2d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -173,20 +501,32 @@ func file_mesh_v1alpha1_traffic_trace_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_traffic_trace_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_traffic_trace_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mesh_v1alpha1_traffic_trace_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_mesh_v1alpha1_traffic_trace_proto_goTypes = []interface{}{
-	(*TrafficTrace)(nil),      // 0: kuma.mesh.v1alpha1.TrafficTrace
-	(*TrafficTrace_Conf)(nil), // 1: kuma.mesh.v1alpha1.TrafficTrace.Conf
-	(*Selector)(nil),          // 2: kuma.mesh.v1alpha1.Selector
+	(*TrafficTrace)(nil),                      // 0: kuma.mesh.v1alpha1.TrafficTrace
+	(*TrafficTrace_Conf)(nil),                 // 1: kuma.mesh.v1alpha1.TrafficTrace.Conf
+	(*TrafficTrace_Conf_Sampling)(nil),        // 2: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling
+	(*TrafficTrace_Conf_Tag)(nil),             // 3: kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag
+	(*TrafficTrace_Conf_Tag_Header)(nil),      // 4: kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag.Header
+	(*TrafficTrace_Conf_Tag_Environment)(nil), // 5: kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag.Environment
+	(*Selector)(nil),                          // 6: kuma.mesh.v1alpha1.Selector
+	(*wrappers.DoubleValue)(nil),              // 7: google.protobuf.DoubleValue
 }
 var file_mesh_v1alpha1_traffic_trace_proto_depIdxs = []int32{
-	2, // 0: kuma.mesh.v1alpha1.TrafficTrace.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	6, // 0: kuma.mesh.v1alpha1.TrafficTrace.selectors:type_name -> kuma.mesh.v1alpha1.Selector
 	1, // 1: kuma.mesh.v1alpha1.TrafficTrace.conf:type_name -> kuma.mesh.v1alpha1.TrafficTrace.Conf
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 2: kuma.mesh.v1alpha1.TrafficTrace.Conf.sampling:type_name -> kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling
+	3, // 3: kuma.mesh.v1alpha1.TrafficTrace.Conf.tags:type_name -> kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag
+	7, // 4: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.random:type_name -> google.protobuf.DoubleValue
+	7, // 5: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.client:type_name -> google.protobuf.DoubleValue
+	7, // 6: kuma.mesh.v1alpha1.TrafficTrace.Conf.Sampling.overall:type_name -> google.protobuf.DoubleValue
+	4, // 7: kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag.header:type_name -> kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag.Header
+	5, // 8: kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag.environment:type_name -> kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag.Environment
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_traffic_trace_proto_init() }
@@ -220,6 +560,54 @@ func file_mesh_v1alpha1_traffic_trace_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_traffic_trace_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficTrace_Conf_Sampling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_trace_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficTrace_Conf_Tag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_trace_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficTrace_Conf_Tag_Header); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_trace_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficTrace_Conf_Tag_Environment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -227,7 +615,7 @@ func file_mesh_v1alpha1_traffic_trace_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_traffic_trace_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,338 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.14.0
+// source: system/v1alpha1/access_role.proto
+
+package v1alpha1
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// AccessRole grants subjects access to the resources exposed by the API
+// Server.
+type AccessRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of rules granted by the role.
+	Rules []*AccessRole_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	// List of subjects that are granted the role.
+	Subjects []*AccessRole_Subject `protobuf:"bytes,2,rep,name=subjects,proto3" json:"subjects,omitempty"`
+}
+
+func (x *AccessRole) Reset() {
+	*x = AccessRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_access_role_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRole) ProtoMessage() {}
+
+func (x *AccessRole) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_access_role_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRole.ProtoReflect.Descriptor instead.
+func (*AccessRole) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessRole) GetRules() []*AccessRole_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *AccessRole) GetSubjects() []*AccessRole_Subject {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+// Rule defines which actions are allowed on which resources.
+type AccessRole_Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Actions that are allowed. Either "get", "list", "create", "update",
+	// "delete" or "*" for all of them.
+	Actions []string `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Types of resources, for example "Mesh", "TrafficPermission", "Secret",
+	// "DataplaneToken" or "Config" for the configuration of the Control Plane.
+	// "*" matches all types.
+	Types []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	// Meshes to which the rule is applied. Empty list or "*" matches all
+	// meshes. Global resources like Mesh or Zone are matched only by rules
+	// that apply to all meshes.
+	Meshes []string `protobuf:"bytes,3,rep,name=meshes,proto3" json:"meshes,omitempty"`
+}
+
+func (x *AccessRole_Rule) Reset() {
+	*x = AccessRole_Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_access_role_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRole_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRole_Rule) ProtoMessage() {}
+
+func (x *AccessRole_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_access_role_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRole_Rule.ProtoReflect.Descriptor instead.
+func (*AccessRole_Rule) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AccessRole_Rule) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *AccessRole_Rule) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *AccessRole_Rule) GetMeshes() []string {
+	if x != nil {
+		return x.Meshes
+	}
+	return nil
+}
+
+// Subject is a user or a group of users.
+type AccessRole_Subject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the user. It is matched with the Common Name of a client
+	// certificate or the name of a user token.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Name of the group. It is matched with the Organization of a client
+	// certificate or the groups of a user token.
+	Group string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *AccessRole_Subject) Reset() {
+	*x = AccessRole_Subject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_access_role_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRole_Subject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRole_Subject) ProtoMessage() {}
+
+func (x *AccessRole_Subject) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_access_role_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRole_Subject.ProtoReflect.Descriptor instead.
+func (*AccessRole_Subject) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *AccessRole_Subject) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AccessRole_Subject) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+var File_system_v1alpha1_access_role_proto protoreflect.FileDescriptor
+
+var file_system_v1alpha1_access_role_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x94, 0x02, 0x0a, 0x0a, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x4e, 0x0a, 0x04, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x1a, 0x33, 0x0a, 0x07, 0x53,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_system_v1alpha1_access_role_proto_rawDescOnce sync.Once
+	file_system_v1alpha1_access_role_proto_rawDescData = file_system_v1alpha1_access_role_proto_rawDesc
+)
+
+func file_system_v1alpha1_access_role_proto_rawDescGZIP() []byte {
+	file_system_v1alpha1_access_role_proto_rawDescOnce.Do(func() {
+		file_system_v1alpha1_access_role_proto_rawDescData = protoimpl.X.CompressGZIP(file_system_v1alpha1_access_role_proto_rawDescData)
+	})
+	return file_system_v1alpha1_access_role_proto_rawDescData
+}
+
+var file_system_v1alpha1_access_role_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_system_v1alpha1_access_role_proto_goTypes = []interface{}{
+	(*AccessRole)(nil),         // 0: kuma.system.v1alpha1.AccessRole
+	(*AccessRole_Rule)(nil),    // 1: kuma.system.v1alpha1.AccessRole.Rule
+	(*AccessRole_Subject)(nil), // 2: kuma.system.v1alpha1.AccessRole.Subject
+}
+var file_system_v1alpha1_access_role_proto_depIdxs = []int32{
+	1, // 0: kuma.system.v1alpha1.AccessRole.rules:type_name -> kuma.system.v1alpha1.AccessRole.Rule
+	2, // 1: kuma.system.v1alpha1.AccessRole.subjects:type_name -> kuma.system.v1alpha1.AccessRole.Subject
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_system_v1alpha1_access_role_proto_init() }
+func file_system_v1alpha1_access_role_proto_init() {
+	if File_system_v1alpha1_access_role_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_system_v1alpha1_access_role_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_system_v1alpha1_access_role_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRole_Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_system_v1alpha1_access_role_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRole_Subject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_system_v1alpha1_access_role_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_system_v1alpha1_access_role_proto_goTypes,
+		DependencyIndexes: file_system_v1alpha1_access_role_proto_depIdxs,
+		MessageInfos:      file_system_v1alpha1_access_role_proto_msgTypes,
+	}.Build()
+	File_system_v1alpha1_access_role_proto = out.File
+	file_system_v1alpha1_access_role_proto_rawDesc = nil
+	file_system_v1alpha1_access_role_proto_goTypes = nil
+	file_system_v1alpha1_access_role_proto_depIdxs = nil
+}
@@ -1,13 +1,20 @@
 package cmd
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/kumahq/kuma/pkg/config"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/bootstrap"
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
+	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/postgres"
 	"github.com/kumahq/kuma/pkg/version"
 )
 
@@ -20,6 +27,7 @@ func newMigrateCmd() *cobra.Command {
 		Long:  `Migrate database to which Control Plane is connected. The database contains all policies, dataplanes and secrets. The schema has to be in sync with version of Kuma CP to properly work. Make sure to run "kuma-cp migrate up" before running new version of Kuma.`,
 	}
 	cmd.AddCommand(newMigrateUpCmd())
+	cmd.AddCommand(newMigrateSecretsCmd())
 	return cmd
 }
 
@@ -79,3 +87,52 @@ func migrate(cfg kuma_cp.Config) error {
 	_, err = plugin.Migrate(nil, pluginConfig)
 	return err
 }
+
+func newMigrateSecretsCmd() *cobra.Command {
+	args := struct {
+		configPath string
+	}{}
+	cmd := &cobra.Command{
+		Use:   "secrets",
+		Short: "Re-encrypt all Secrets with the current encryption configuration.",
+		Long:  `Re-encrypt all Secrets and GlobalSecrets with the primary key defined in the file set by "store.secretsEncryption.keysFile" or with the data keys wrapped by "store.secretsEncryption.keyProvider". Secrets stored in plain text are encrypted. Run it after changing the primary key or enabling the key provider, then the old keys can be removed from the file.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg := kuma_cp.DefaultConfig()
+			err := config.Load(args.configPath, &cfg)
+			if err != nil {
+				migrateLog.Error(err, "could not load the configuration")
+				return err
+			}
+			count, err := migrateSecrets(cfg)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("%d secrets have been re-encrypted\n", count)
+			return nil
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&args.configPath, "config-file", "c", "", "configuration file")
+	return cmd
+}
+
+func migrateSecrets(cfg kuma_cp.Config) (int, error) {
+	if cfg.Store.Type != store.PostgresStore {
+		return 0, errors.Errorf("secrets can be re-encrypted only with %s store", store.PostgresStore)
+	}
+	if cfg.Store.SecretsEncryption.KeysFile == "" && cfg.Store.SecretsEncryption.KeyProvider.Type == store.NoKeyProvider {
+		return 0, errors.New("secrets encryption is not enabled. Set store.secretsEncryption.keysFile or store.secretsEncryption.keyProvider")
+	}
+	cipher, err := bootstrap.SecretCipher(cfg.Store)
+	if err != nil {
+		return 0, err
+	}
+	metrics, err := core_metrics.NewMetrics("")
+	if err != nil {
+		return 0, err
+	}
+	resourceStore, err := postgres.NewStore(metrics, *cfg.Store.Postgres)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not connect to the database")
+	}
+	return secret_manager.ReEncrypt(context.Background(), secret_store.NewSecretStore(resourceStore), cipher)
+}
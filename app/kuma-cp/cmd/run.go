@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	api_server "github.com/kumahq/kuma/pkg/api-server"
+	ca_lifecycle "github.com/kumahq/kuma/pkg/ca/lifecycle"
 	"github.com/kumahq/kuma/pkg/clusterid"
 	"github.com/kumahq/kuma/pkg/config"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
@@ -127,6 +128,10 @@ func newRunCmdWithOpts(opts runCmdOpts) *cobra.Command {
 					runLog.Error(err, "unable to set up Insights resyncer")
 					return err
 				}
+				if err := ca_lifecycle.Setup(rt); err != nil {
+					runLog.Error(err, "unable to set up CA lifecycle")
+					return err
+				}
 				if err := defaults.Setup(rt); err != nil {
 					runLog.Error(err, "unable to set up Defaults")
 					return err
@@ -173,6 +178,10 @@ func newRunCmdWithOpts(opts runCmdOpts) *cobra.Command {
 					runLog.Error(err, "unable to set up Insights resyncer")
 					return err
 				}
+				if err := ca_lifecycle.Setup(rt); err != nil {
+					runLog.Error(err, "unable to set up CA lifecycle")
+					return err
+				}
 				if err := defaults.Setup(rt); err != nil {
 					runLog.Error(err, "unable to set up Defaults")
 					return err
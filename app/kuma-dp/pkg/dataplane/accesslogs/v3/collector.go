@@ -0,0 +1,114 @@
+package v3
+
+import (
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+
+	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// collectorHandler handles log streams of the `grpc` logging backend.
+//
+// Every destination has its own queue of log entries delivered in the background,
+// so a slow destination (e.g. an HTTP logging backend that does not respond within
+// the request timeout) does not delay the log stream and the delivery to the other ones.
+// If a destination cannot keep up, log entries for it are dropped once its queue is full.
+// A destination that fails does not stop the delivery to the other ones.
+func collectorHandler(log logr.Logger, logName string) (logHandler, error) {
+	config, err := accesslog.ParseCollectorLogName(logName)
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := collectorFormat(config)
+	if err != nil {
+		return nil, err
+	}
+
+	senders := &fanoutSender{log: log}
+	if config.Path != "" {
+		senders.senders = append(senders.senders, newQueuedSender(log, &fileSender{log: log, path: config.Path}))
+	}
+	if config.Address != "" {
+		senders.senders = append(senders.senders, newQueuedSender(log, &sender{log: log, address: config.Address}))
+	}
+	if config.Url != "" {
+		senders.senders = append(senders.senders, newQueuedSender(log, &httpSender{log: log, url: config.Url}))
+	}
+	if len(senders.senders) == 0 {
+		return nil, errors.Errorf("collector config has no destinations: expected at least one of path, address or url")
+	}
+	if err := senders.Connect(); err != nil {
+		return nil, err
+	}
+
+	return &handler{
+		format: format,
+		sender: senders,
+	}, nil
+}
+
+func collectorFormat(config *accesslog.CollectorConfig) (logFormatter, error) {
+	switch {
+	case len(config.JsonFormat) > 0:
+		return accesslog.ParseJsonFormat(config.JsonFormat)
+	case config.Format != "":
+		return accesslog.ParseFormat(config.Format)
+	default:
+		return &structuredFormatter{metadata: config.Metadata}, nil
+	}
+}
+
+// structuredFormatter renders log entries as JSON records
+// that carry metadata of a log stream along with the original entry.
+type structuredFormatter struct {
+	metadata accesslog.CollectorMetadata
+}
+
+type structuredRecord struct {
+	accesslog.CollectorMetadata
+	Http json.RawMessage `json:"http,omitempty"`
+	Tcp  json.RawMessage `json:"tcp,omitempty"`
+}
+
+func (f *structuredFormatter) FormatHttpLogEntry(entry *accesslog_data.HTTPAccessLogEntry) (string, error) {
+	bytes, err := f.marshalEntry(entry)
+	if err != nil {
+		return "", err
+	}
+	return f.format(structuredRecord{CollectorMetadata: f.metadata, Http: bytes})
+}
+
+func (f *structuredFormatter) FormatTcpLogEntry(entry *accesslog_data.TCPAccessLogEntry) (string, error) {
+	bytes, err := f.marshalEntry(entry)
+	if err != nil {
+		return "", err
+	}
+	return f.format(structuredRecord{CollectorMetadata: f.metadata, Tcp: bytes})
+}
+
+func (f *structuredFormatter) marshalEntry(entry proto.Message) (json.RawMessage, error) {
+	bytes, err := util_proto.ToJSON(entry)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal %T", entry)
+	}
+	return bytes, nil
+}
+
+func (f *structuredFormatter) format(record structuredRecord) (string, error) {
+	bytes, err := json.Marshal(record)
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal a log record")
+	}
+	return string(bytes) + "\n", nil
+}
+
+func (f *structuredFormatter) String() string {
+	return "structured JSON"
+}
@@ -0,0 +1,235 @@
+package v3
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+
+	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("collectorHandler", func() {
+
+	var tmpDir string
+	var server *httptest.Server
+	var mutex sync.Mutex
+	var received []string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "accesslogs-collector")
+		Expect(err).ToNot(HaveOccurred())
+
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			mutex.Lock()
+			defer mutex.Unlock()
+			received = append(received, string(body))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	handle := func(config accesslog.CollectorConfig, entries string) {
+		// given
+		logName, err := config.LogName()
+		Expect(err).ToNot(HaveOccurred())
+		msg := &envoy_accesslog.StreamAccessLogsMessage{}
+		Expect(util_proto.FromYAML([]byte(entries), msg)).To(Succeed())
+		msg.Identifier = &envoy_accesslog.StreamAccessLogsMessage_Identifier{
+			LogName: logName,
+		}
+
+		// when
+		handler, err := defaultHandler(logger, msg)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = handler.Handle(msg)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handler.Close()).To(Succeed())
+	}
+
+	It("should fan out structured records with metadata", func() {
+		// given
+		path := filepath.Join(tmpDir, "access.log")
+
+		// when
+		handle(accesslog.CollectorConfig{
+			Path: path,
+			Url:  server.URL,
+			Metadata: accesslog.CollectorMetadata{
+				Mesh:             "demo",
+				TrafficDirection: "OUTBOUND",
+				Source:           map[string]string{"kuma.io/service": "web", "version": "v1"},
+				Destination:      map[string]string{"kuma.io/service": "backend"},
+			},
+		}, `
+        tcp_logs:
+          log_entry:
+          - connection_properties:
+              received_bytes: 234
+              sent_bytes: 567
+`)
+
+		// then
+		expected := `{"mesh":"demo","trafficDirection":"OUTBOUND","source":{"kuma.io/service":"web","version":"v1"},"destination":{"kuma.io/service":"backend"},"tcp":{"connectionProperties":{"receivedBytes":"234","sentBytes":"567"}}}` + "\n"
+		content, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal(expected))
+		// and
+		mutex.Lock()
+		defer mutex.Unlock()
+		Expect(received).To(Equal([]string{expected}))
+	})
+
+	It("should format records according to the format string", func() {
+		// given
+		path := filepath.Join(tmpDir, "access.log")
+
+		// when
+		handle(accesslog.CollectorConfig{
+			Path:   path,
+			Format: "%REQ(:METHOD)% %RESPONSE_CODE%\n",
+		}, `
+        http_logs:
+          log_entry:
+          - request:
+              request_method: GET
+            response:
+              response_code: 200
+`)
+
+		// then
+		content, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("GET 200\n"))
+	})
+
+	It("should deliver records to other destinations when one of them fails", func() {
+		// given
+		path := filepath.Join(tmpDir, "access.log")
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer failing.Close()
+
+		// when
+		handle(accesslog.CollectorConfig{
+			Path:    path,
+			Url:     failing.URL,
+			Address: "127.0.0.1:0",
+			Format:  "%REQ(:METHOD)% %RESPONSE_CODE%\n",
+		}, `
+        http_logs:
+          log_entry:
+          - request:
+              request_method: GET
+            response:
+              response_code: 200
+          - request:
+              request_method: POST
+            response:
+              response_code: 503
+`)
+
+		// then
+		content, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("GET 200\nPOST 503\n"))
+	})
+
+	It("should not delay other destinations when the HTTP logging backend is blocked", func() {
+		// given
+		path := filepath.Join(tmpDir, "access.log")
+		unblock := make(chan struct{})
+		blocked := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			<-unblock
+		}))
+		defer blocked.Close()
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer listener.Close()
+		tcpReceived := make(chan string, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			line, _ := bufio.NewReader(conn).ReadString('\n')
+			tcpReceived <- line
+		}()
+
+		config := accesslog.CollectorConfig{
+			Path:    path,
+			Address: listener.Addr().String(),
+			Url:     blocked.URL,
+			Format:  "%REQ(:METHOD)% %RESPONSE_CODE%\n",
+		}
+		logName, err := config.LogName()
+		Expect(err).ToNot(HaveOccurred())
+		msg := &envoy_accesslog.StreamAccessLogsMessage{}
+		Expect(util_proto.FromYAML([]byte(`
+        http_logs:
+          log_entry:
+          - request:
+              request_method: GET
+            response:
+              response_code: 200
+`), msg)).To(Succeed())
+		msg.Identifier = &envoy_accesslog.StreamAccessLogsMessage_Identifier{
+			LogName: logName,
+		}
+		handler, err := defaultHandler(logger, msg)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		start := time.Now()
+		Expect(handler.Handle(msg)).To(Succeed())
+
+		// then the log stream is not blocked
+		Expect(time.Since(start)).To(BeNumerically("<", defaultRequestTimeout))
+		// and the record is delivered to the file and the TCP logging backend while the HTTP logging backend is still blocked
+		Eventually(func() (string, error) {
+			content, err := ioutil.ReadFile(path)
+			return string(content), err
+		}, "2s", "10ms").Should(Equal("GET 200\n"))
+		Eventually(tcpReceived, "2s").Should(Receive(Equal("GET 200\n")))
+
+		// when
+		close(unblock)
+		// then
+		Expect(handler.Close()).To(Succeed())
+	})
+
+	It("should fail if there are no destinations", func() {
+		// given
+		logName, err := (&accesslog.CollectorConfig{}).LogName()
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = collectorHandler(logger, logName)
+		// then
+		Expect(err).To(MatchError("collector config has no destinations: expected at least one of path, address or url"))
+	})
+})
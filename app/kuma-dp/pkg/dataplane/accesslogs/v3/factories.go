@@ -13,13 +13,17 @@ import (
 )
 
 func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage) (logHandler, error) {
+	if accesslog.IsCollectorLogName(msg.GetIdentifier().GetLogName()) {
+		return collectorHandler(log, msg.GetIdentifier().GetLogName())
+	}
+
 	parts := strings.SplitN(msg.GetIdentifier().GetLogName(), ";", 2)
 	if len(parts) != 2 {
 		return nil, errors.Errorf("log name %q has invalid format: expected %d components separated by ';', got %d", msg.GetIdentifier().GetLogName(), 2, len(parts))
 	}
 	address, formatString := parts[0], parts[1]
 
-	format, err := accesslog.ParseFormat(formatString)
+	format, err := parseFormat(formatString)
 	if err != nil {
 		return nil, err
 	}
@@ -44,3 +48,13 @@ func defaultSender(log logr.Logger, address string) (logSender, error) {
 		address: address,
 	}, nil
 }
+
+// jsonFormatPrefix distinguishes a JSON access log format from a plain text one.
+const jsonFormatPrefix = "json;"
+
+func parseFormat(formatString string) (logFormatter, error) {
+	if strings.HasPrefix(formatString, jsonFormatPrefix) {
+		return accesslog.ParseJsonFormatString(strings.TrimPrefix(formatString, jsonFormatPrefix))
+	}
+	return accesslog.ParseFormat(formatString)
+}
@@ -35,6 +35,22 @@ var _ = Describe("defaultHandler", func() {
 				},
 				expectedErr: `format string is not valid: expected a command operator to start at position 1, instead got: "%bytes_sent%"`,
 			}),
+			Entry("invalid access log JSON format", testCase{
+				msg: &envoy_accesslog.StreamAccessLogsMessage{
+					Identifier: &envoy_accesslog.StreamAccessLogsMessage_Identifier{
+						LogName: `;json;{"bytes":`,
+					},
+				},
+				expectedErr: `JSON format is not valid: unexpected end of JSON input`,
+			}),
+			Entry("invalid collector config", testCase{
+				msg: &envoy_accesslog.StreamAccessLogsMessage{
+					Identifier: &envoy_accesslog.StreamAccessLogsMessage_Identifier{
+						LogName: `grpc;{"path":`,
+					},
+				},
+				expectedErr: `collector config is not valid: unexpected end of JSON input`,
+			}),
 		)
 	})
 })
@@ -0,0 +1,53 @@
+package v3
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+)
+
+// fanoutSender delivers every log entry to all underlying senders.
+//
+// Destinations are independent of each other, e.g. an unreachable HTTP
+// logging backend does not stop delivery of log entries to a file.
+// Failures are logged and the log entry is skipped only for the failing destination.
+type fanoutSender struct {
+	log     logr.Logger
+	senders []logSender
+}
+
+func (s *fanoutSender) Connect() error {
+	var connected []logSender
+	var lastErr error
+	for _, sender := range s.senders {
+		if err := sender.Connect(); err != nil {
+			s.log.Error(err, "could not connect to a logging destination, log entries will not be delivered to it")
+			lastErr = err
+			continue
+		}
+		connected = append(connected, sender)
+	}
+	if len(connected) == 0 {
+		return errors.Wrap(lastErr, "could not connect to any logging destination")
+	}
+	s.senders = connected
+	return nil
+}
+
+func (s *fanoutSender) Send(record string) error {
+	for _, sender := range s.senders {
+		if err := sender.Send(record); err != nil {
+			s.log.Error(err, "could not deliver a log entry to a logging destination")
+		}
+	}
+	return nil
+}
+
+func (s *fanoutSender) Close() error {
+	var firstErr error
+	for _, sender := range s.senders {
+		if err := sender.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
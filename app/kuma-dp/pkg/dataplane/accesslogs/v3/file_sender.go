@@ -0,0 +1,36 @@
+package v3
+
+import (
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+)
+
+type fileSender struct {
+	log  logr.Logger
+	path string
+	file *os.File
+}
+
+func (s *fileSender) Connect() error {
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open a file logging backend: %s", s.path)
+	}
+	s.log.Info("opened file logging backend", "path", s.path)
+	s.file = file
+	return nil
+}
+
+func (s *fileSender) Send(record string) error {
+	_, err := s.file.WriteString(record)
+	return errors.Wrapf(err, "failed to write a log entry to a file logging backend: %s", s.path)
+}
+
+func (s *fileSender) Close() error {
+	if s.file != nil {
+		return s.file.Close()
+	}
+	return nil
+}
@@ -4,12 +4,10 @@ import (
 	"github.com/pkg/errors"
 
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
-
-	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 )
 
 type handler struct {
-	format *accesslog.AccessLogFormat
+	format logFormatter
 	sender logSender
 }
 
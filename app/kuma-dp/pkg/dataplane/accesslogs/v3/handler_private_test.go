@@ -31,7 +31,7 @@ var _ = Describe("handler", func() {
 					By("doing setup")
 					fakeSender := fakeSender{}
 					// when
-					format, err := accesslog.ParseFormat(given.format)
+					format, err := parseFormat(given.format)
 					// then
 					Expect(err).ToNot(HaveOccurred())
 					// and
@@ -162,6 +162,30 @@ var _ = Describe("handler", func() {
 						"[2020-02-18T23:45:07.456Z] \"- - -\" 0 - 0 89012 - -\n",
 					},
 				}),
+				Entry("1 HTTP log entry in JSON format", testCase{
+					format: `json;{"method":"%REQ(:METHOD)%","path":"%REQ(:PATH)%","status":"%RESPONSE_CODE%"}`,
+					msg: `
+                    http_logs:
+                      log_entry:
+                      - request:
+                          request_method: POST
+                          path: /api
+                        response:
+                          response_code: 200
+`,
+					expected: []string{`{"method":"POST","path":"/api","status":"200"}` + "\n"},
+				}),
+				Entry("1 TCP log entry in JSON format", testCase{
+					format: `json;{"received":"%BYTES_RECEIVED%","sent":"%BYTES_SENT%"}`,
+					msg: `
+                    tcp_logs:
+                      log_entry:
+                      - connection_properties:
+                          received_bytes: 234
+                          sent_bytes: 567
+`,
+					expected: []string{`{"received":"234","sent":"567"}` + "\n"},
+				}),
 			)
 		})
 
@@ -0,0 +1,57 @@
+package v3
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultRequestTimeout = 5 * time.Second
+)
+
+type httpSender struct {
+	log    logr.Logger
+	url    string
+	client *http.Client
+}
+
+func (s *httpSender) Connect() error {
+	s.client = &http.Client{
+		Timeout: defaultRequestTimeout,
+	}
+	s.log.Info("configured HTTP logging backend", "url", s.url)
+	return nil
+}
+
+var _ batchSender = &httpSender{}
+
+func (s *httpSender) Send(record string) error {
+	return s.SendBatch([]string{record})
+}
+
+// SendBatch sends log entries in one request. Every log entry ends with a new line, so the body contains one entry per line.
+func (s *httpSender) SendBatch(records []string) error {
+	resp, err := s.client.Post(s.url, "application/json", strings.NewReader(strings.Join(records, "")))
+	if err != nil {
+		return errors.Wrapf(err, "failed to send log entries to an HTTP logging backend: %s", s.url)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("HTTP logging backend %s responded with status code %d", s.url, resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *httpSender) Close() error {
+	if s.client != nil {
+		s.client.CloseIdleConnections()
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/go-logr/logr"
 
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+
+	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 )
 
 // logHandler represents a contract between a log stream receiver and a log handler.
@@ -14,6 +16,13 @@ type logHandler interface {
 	io.Closer
 }
 
+// logFormatter represents a contract between a log handler and an access log format,
+// e.g. a plain text or a JSON one.
+type logFormatter interface {
+	accesslog.HttpLogEntryFormatter
+	accesslog.TcpLogEntryFormatter
+}
+
 // logSender represents a contract between a log handler and a log sender.
 type logSender interface {
 	Connect() error
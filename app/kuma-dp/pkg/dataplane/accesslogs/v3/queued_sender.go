@@ -0,0 +1,121 @@
+package v3
+
+import (
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	defaultQueueSize    = 1024
+	defaultMaxBatchSize = 128
+)
+
+// batchSender is implemented by senders that deliver multiple log entries at once more efficiently, e.g. in one HTTP request.
+type batchSender interface {
+	SendBatch(records []string) error
+}
+
+// queuedSender delivers log entries to a destination in the background,
+// so a slow destination does not block the log stream and the other destinations.
+//
+// Log entries are buffered in a bounded queue. When the queue is full, because the destination
+// cannot keep up, new log entries are dropped and counted instead of stalling the log stream.
+type queuedSender struct {
+	log          logr.Logger
+	sender       logSender
+	queueSize    int
+	maxBatchSize int
+
+	queue   chan string
+	done    chan struct{}
+	dropped uint64
+}
+
+func newQueuedSender(log logr.Logger, sender logSender) *queuedSender {
+	return &queuedSender{
+		log:          log,
+		sender:       sender,
+		queueSize:    defaultQueueSize,
+		maxBatchSize: defaultMaxBatchSize,
+	}
+}
+
+func (s *queuedSender) Connect() error {
+	if err := s.sender.Connect(); err != nil {
+		return err
+	}
+	s.queue = make(chan string, s.queueSize)
+	s.done = make(chan struct{})
+	go s.run()
+	return nil
+}
+
+func (s *queuedSender) Send(record string) error {
+	select {
+	case s.queue <- record:
+	default:
+		atomic.AddUint64(&s.dropped, 1)
+	}
+	return nil
+}
+
+// Dropped returns the number of log entries dropped because the queue was full.
+func (s *queuedSender) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+func (s *queuedSender) run() {
+	defer close(s.done)
+	var reported uint64
+	for record := range s.queue {
+		s.deliver(s.batch(record))
+		if dropped := s.Dropped(); dropped != reported {
+			s.log.Info("logging destination cannot keep up, log entries were dropped", "dropped", dropped-reported, "totalDropped", dropped)
+			reported = dropped
+		}
+	}
+}
+
+// batch takes log entries that are already waiting in the queue, so they can be delivered together.
+func (s *queuedSender) batch(first string) []string {
+	records := []string{first}
+	if _, ok := s.sender.(batchSender); !ok {
+		return records
+	}
+	for len(records) < s.maxBatchSize {
+		select {
+		case record, ok := <-s.queue:
+			if !ok {
+				return records
+			}
+			records = append(records, record)
+		default:
+			return records
+		}
+	}
+	return records
+}
+
+func (s *queuedSender) deliver(records []string) {
+	if batch, ok := s.sender.(batchSender); ok {
+		if err := batch.SendBatch(records); err != nil {
+			s.log.Error(err, "could not deliver log entries to a logging destination", "entries", len(records))
+		}
+		return
+	}
+	for _, record := range records {
+		if err := s.sender.Send(record); err != nil {
+			s.log.Error(err, "could not deliver a log entry to a logging destination")
+		}
+	}
+}
+
+// Close delivers log entries that are still in the queue and closes the destination.
+func (s *queuedSender) Close() error {
+	if s.queue != nil {
+		close(s.queue)
+		<-s.done
+	}
+	return s.sender.Close()
+}
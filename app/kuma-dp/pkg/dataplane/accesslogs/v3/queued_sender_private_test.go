@@ -0,0 +1,65 @@
+package v3
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type blockingSender struct {
+	unblock chan struct{}
+	mutex   sync.Mutex
+	batches [][]string
+}
+
+func (s *blockingSender) Connect() error {
+	return nil
+}
+
+func (s *blockingSender) Send(record string) error {
+	return s.SendBatch([]string{record})
+}
+
+func (s *blockingSender) SendBatch(records []string) error {
+	<-s.unblock
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.batches = append(s.batches, records)
+	return nil
+}
+
+func (s *blockingSender) Close() error {
+	return nil
+}
+
+var _ = Describe("queuedSender", func() {
+
+	It("should drop and count log entries when the queue is full", func() {
+		// given
+		destination := &blockingSender{unblock: make(chan struct{})}
+		sender := newQueuedSender(logger, destination)
+		sender.queueSize = 2
+		Expect(sender.Connect()).To(Succeed())
+
+		// when the first record is taken by the blocked destination
+		Expect(sender.Send("1\n")).To(Succeed())
+		Eventually(func() int {
+			return len(sender.queue)
+		}).Should(Equal(0))
+		// and the queue is filled up
+		for _, record := range []string{"2\n", "3\n", "4\n", "5\n"} {
+			Expect(sender.Send(record)).To(Succeed())
+		}
+
+		// then
+		Expect(sender.Dropped()).To(Equal(uint64(2)))
+
+		// when
+		close(destination.unblock)
+		Expect(sender.Close()).To(Succeed())
+
+		// then queued records are delivered in a batch
+		Expect(destination.batches).To(Equal([][]string{{"1\n"}, {"2\n", "3\n"}}))
+	})
+})
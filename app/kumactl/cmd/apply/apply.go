@@ -113,6 +113,9 @@ $ kumactl apply -f https://example.com/resource.yaml
 				if err := mesh.ValidateMeta(res.GetMeta().GetName(), res.GetMeta().GetMesh(), res.Scope()); err.HasViolations() {
 					return err.OrNil()
 				}
+				if err := mesh.ValidateLabels(res.GetMeta().GetLabels()); err.HasViolations() {
+					return err.OrNil()
+				}
 				resources = append(resources, res)
 			}
 			for _, resource := range resources {
@@ -153,7 +156,7 @@ func upsert(rs store.ResourceStore, res model.Resource) error {
 	meta := res.GetMeta()
 	if err := rs.Get(context.Background(), newRes, store.GetByKey(meta.GetName(), meta.GetMesh())); err != nil {
 		if store.IsResourceNotFound(err) {
-			return rs.Create(context.Background(), res, store.CreateByKey(meta.GetName(), meta.GetMesh()))
+			return rs.Create(context.Background(), res, store.CreateByKey(meta.GetName(), meta.GetMesh()), store.CreateWithLabels(meta.GetLabels()))
 		} else {
 			return err
 		}
@@ -161,5 +164,5 @@ func upsert(rs store.ResourceStore, res model.Resource) error {
 	if err := newRes.SetSpec(res.GetSpec()); err != nil {
 		return err
 	}
-	return rs.Update(context.Background(), newRes)
+	return rs.Update(context.Background(), newRes, store.UpdateWithLabels(meta.GetLabels()))
 }
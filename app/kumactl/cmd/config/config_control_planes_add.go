@@ -8,6 +8,7 @@ import (
 
 	"github.com/kumahq/kuma/pkg/util/maps"
 
+	kumactl_client "github.com/kumahq/kuma/app/kumactl/pkg/client"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/config"
 	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
@@ -22,6 +23,8 @@ type controlPlaneAddArgs struct {
 	caCertFile     string
 	skipVerify     bool
 	headers        map[string]string
+	authType       string
+	authConf       map[string]string
 }
 
 func newConfigControlPlanesAddCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
@@ -43,6 +46,8 @@ func newConfigControlPlanesAddCmd(pctx *kumactl_cmd.RootContext) *cobra.Command
 						CaCertFile:     args.caCertFile,
 						ClientCertFile: args.clientCertFile,
 						ClientKeyFile:  args.clientKeyFile,
+						AuthType:       args.authType,
+						AuthConf:       args.authConf,
 					},
 				},
 			}
@@ -88,6 +93,8 @@ func newConfigControlPlanesAddCmd(pctx *kumactl_cmd.RootContext) *cobra.Command
 	cmd.Flags().StringVar(&args.caCertFile, "ca-cert-file", "", "path to the certificate authority which will be used to verify the Control Plane certificate (kumactl stores only a reference to this file)")
 	cmd.Flags().BoolVar(&args.skipVerify, "skip-verify", false, "skip CA verification")
 	cmd.Flags().StringToStringVar(&args.headers, "headers", args.headers, "add these headers while communicating to control plane, format key=value")
+	cmd.Flags().StringVar(&args.authType, "auth-type", "", `authentication to the Control Plane. Use "tokens" to authenticate with the User Token (client certificates are used when not specified)`)
+	cmd.Flags().StringToStringVar(&args.authConf, "auth-conf", args.authConf, "configuration of the authentication, format key=value. For --auth-type=tokens provide token=<user token>")
 	return cmd
 }
 
@@ -104,5 +111,17 @@ func validateArgs(args controlPlaneAddArgs) error {
 	if (args.clientKeyFile != "" && args.clientCertFile == "") || (args.clientKeyFile == "" && args.clientCertFile != "") {
 		return errors.New("Both --client-cert-file and --client-key-file needs to be specified")
 	}
+	switch args.authType {
+	case "":
+		if len(args.authConf) > 0 {
+			return errors.New("--auth-conf can be specified only with --auth-type")
+		}
+	case kumactl_client.AuthTypeTokens:
+		if args.authConf[kumactl_client.AuthConfToken] == "" {
+			return errors.Errorf("--auth-type=%s requires --auth-conf %s=<user token>", kumactl_client.AuthTypeTokens, kumactl_client.AuthConfToken)
+		}
+	default:
+		return errors.Errorf("--auth-type has to be %q", kumactl_client.AuthTypeTokens)
+	}
 	return nil
 }
@@ -159,6 +159,19 @@ var _ = Describe("kumactl config control-planes add", func() {
 			// and
 			Expect(errbuf.Bytes()).To(BeEmpty())
 		})
+
+		It("should require the token when user tokens are used", func() {
+			// given
+			rootCmd.SetArgs([]string{"--config-file", configFile.Name(),
+				"config", "control-planes", "add",
+				"--name", "example",
+				"--address", "http://localhost:1234",
+				"--auth-type", "tokens"})
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).To(MatchError(`--auth-type=tokens requires --auth-conf token=<user token>`))
+		})
 	})
 
 	Describe("happy path", func() {
@@ -271,6 +284,16 @@ switched active Control Plane to "example"
 				overwrite: true,
 				extraArgs: []string{"--headers", "abc=xyz"},
 			}),
+			Entry("should add the example Control Plane with user token", testCase{
+				configFile: "config-control-planes-add.06.initial.yaml",
+				goldenFile: "config-control-planes-add.06.golden.yaml",
+				expectedOut: `
+added Control Plane "example"
+switched active Control Plane to "example"
+`,
+				overwrite: false,
+				extraArgs: []string{"--auth-type", "tokens", "--auth-conf", "token=user-token"},
+			}),
 		)
 	})
 })
@@ -2,6 +2,7 @@ package generate
 
 import (
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -13,9 +14,10 @@ type generateDataplaneTokenContext struct {
 	*kumactl_cmd.RootContext
 
 	args struct {
-		name   string
-		dpType string
-		tags   map[string]string
+		name     string
+		dpType   string
+		tags     map[string]string
+		validFor time.Duration
 	}
 }
 
@@ -37,6 +39,17 @@ $ kumactl generate dataplane-token --type ingress
 
 Generate token bound by tag
 $ kumactl generate dataplane-token --mesh demo --tag kuma.io/service=web,web-api
+
+Generate token that expires after 30 days
+$ kumactl generate dataplane-token --mesh demo --name demo-01 --valid-for 720h
+
+Every token has a unique ID (the "jti" claim). To revoke the token, add its ID
+to the comma separated list in the "dataplane-token-revocations-<mesh>" Secret
+$ echo "
+type: Secret
+mesh: demo
+name: dataplane-token-revocations-demo
+data: $(echo -n "<token-id>" | base64)" | kumactl apply -f -
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			client, err := pctx.CurrentDataplaneTokenClient()
@@ -49,7 +62,7 @@ $ kumactl generate dataplane-token --mesh demo --tag kuma.io/service=web,web-api
 				tags[k] = strings.Split(v, ",")
 			}
 			name := ctx.args.name
-			token, err := client.Generate(name, pctx.Args.Mesh, tags, ctx.args.dpType)
+			token, err := client.Generate(name, pctx.Args.Mesh, tags, ctx.args.dpType, ctx.args.validFor)
 			if err != nil {
 				return errors.Wrap(err, "failed to generate a dataplane token")
 			}
@@ -60,5 +73,6 @@ $ kumactl generate dataplane-token --mesh demo --tag kuma.io/service=web,web-api
 	cmd.Flags().StringVar(&ctx.args.name, "name", "", "name of the Dataplane")
 	cmd.Flags().StringVar(&ctx.args.dpType, "type", "", `type of the Dataplane ("dataplane", "ingress")`)
 	cmd.Flags().StringToStringVar(&ctx.args.tags, "tag", nil, "required tag values for dataplane (split values by comma to provide multiple values)")
+	cmd.Flags().DurationVar(&ctx.args.validFor, "valid-for", 0, `how long the token will be valid (for example "24h"). Token is valid until revoked when not specified`)
 	return cmd
 }
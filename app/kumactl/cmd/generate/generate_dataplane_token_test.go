@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -25,11 +26,11 @@ type staticDataplaneTokenGenerator struct {
 
 var _ tokens.DataplaneTokenClient = &staticDataplaneTokenGenerator{}
 
-func (s *staticDataplaneTokenGenerator) Generate(name string, mesh string, tags map[string][]string, dpType string) (string, error) {
+func (s *staticDataplaneTokenGenerator) Generate(name string, mesh string, tags map[string][]string, dpType string, validFor time.Duration) (string, error) {
 	if s.err != nil {
 		return "", s.err
 	}
-	return fmt.Sprintf("token-for-%s-%s-%s-%s", name, mesh, mesh_proto.MultiValueTagSetFrom(tags).String(), dpType), nil
+	return fmt.Sprintf("token-for-%s-%s-%s-%s-%s", name, mesh, mesh_proto.MultiValueTagSetFrom(tags).String(), dpType, validFor), nil
 }
 
 var _ = Describe("kumactl generate dataplane-token", func() {
@@ -73,11 +74,11 @@ var _ = Describe("kumactl generate dataplane-token", func() {
 		},
 		Entry("for default mesh when it is not specified", testCase{
 			args:   []string{"generate", "dataplane-token", "--name=example"},
-			result: "token-for-example-default--",
+			result: "token-for-example-default---0s",
 		}),
 		Entry("for all arguments", testCase{
-			args:   []string{"generate", "dataplane-token", "--mesh=demo", "--name=example", "--type=dataplane", "--tag", "kuma.io/service=web"},
-			result: "token-for-example-demo-kuma.io/service=web-dataplane",
+			args:   []string{"generate", "dataplane-token", "--mesh=demo", "--name=example", "--type=dataplane", "--tag", "kuma.io/service=web", "--valid-for", "24h"},
+			result: "token-for-example-demo-kuma.io/service=web-dataplane-24h0m0s",
 		}),
 	)
 
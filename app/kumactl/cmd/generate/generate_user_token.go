@@ -0,0 +1,64 @@
+package generate
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+type generateUserTokenContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		name     string
+		groups   []string
+		validFor time.Duration
+	}
+}
+
+func NewGenerateUserTokenCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &generateUserTokenContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "user-token",
+		Short: "Generate User Token",
+		Long: `Generate User Token that is used to authenticate the user in the Control Plane API Server.
+
+Only members of the admin group can generate tokens with groups they are not members of.`,
+		Example: `
+Generate token for the user that is a member of the admin group
+$ kumactl generate user-token --name john.doe@example.com --group mesh-system:admin --valid-for 24h
+
+Use the token to access the Control Plane
+$ kumactl config control-planes add --name remote --address https://kuma-cp.example.com:5682 --ca-cert-file ca.pem \
+  --auth-type tokens --auth-conf token=$(kumactl generate user-token --name ci --group ci --valid-for 720h)
+
+Every token has a unique ID (the "jti" claim). To revoke the token, add its ID
+to the comma separated list in the "user-token-revocations" GlobalSecret
+$ echo "
+type: GlobalSecret
+name: user-token-revocations
+data: $(echo -n "<token-id>" | base64)" | kumactl apply -f -
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := pctx.CurrentUserTokenClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create user token client")
+			}
+			token, err := client.Generate(ctx.args.name, ctx.args.groups, ctx.args.validFor)
+			if err != nil {
+				return errors.Wrap(err, "failed to generate a user token")
+			}
+			_, err = cmd.OutOrStdout().Write([]byte(token))
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.name, "name", "", "name of the user (required)")
+	_ = cmd.MarkFlagRequired("name")
+	cmd.Flags().StringSliceVar(&ctx.args.groups, "group", nil, "group of the user (can be repeated or separated by comma)")
+	cmd.Flags().DurationVar(&ctx.args.validFor, "valid-for", 0, `how long the token will be valid (for example "24h") (required)`)
+	_ = cmd.MarkFlagRequired("valid-for")
+	return cmd
+}
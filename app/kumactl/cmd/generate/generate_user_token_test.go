@@ -0,0 +1,89 @@
+package generate_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+)
+
+type staticUserTokenGenerator struct {
+	err error
+}
+
+var _ tokens.UserTokenClient = &staticUserTokenGenerator{}
+
+func (s *staticUserTokenGenerator) Generate(name string, groups []string, validFor time.Duration) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	return fmt.Sprintf("token-for-%s-%s-%s", name, strings.Join(groups, ","), validFor), nil
+}
+
+var _ = Describe("kumactl generate user-token", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var generator *staticUserTokenGenerator
+
+	BeforeEach(func() {
+		generator = &staticUserTokenGenerator{}
+		ctx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewUserTokenClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (tokens.UserTokenClient, error) {
+					return generator, nil
+				},
+				NewAPIServerClient: kumactl_resources.NewAPIServerClient,
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(ctx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	It("should generate token", func() {
+		// when
+		rootCmd.SetArgs([]string{"generate", "user-token", "--name=john", "--group=team-a", "--group=team-b", "--valid-for=24h"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("token-for-john-team-a,team-b-24h0m0s"))
+	})
+
+	It("should require validity of the token", func() {
+		// when
+		rootCmd.SetArgs([]string{"generate", "user-token", "--name=john"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`required flag(s) "valid-for" not set`))
+	})
+
+	It("should write error when generating token fails", func() {
+		// setup
+		generator.err = errors.New("could not connect to API")
+
+		// when
+		rootCmd.SetArgs([]string{"generate", "user-token", "--name=john", "--valid-for=24h"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(HaveOccurred())
+
+		// and
+		Expect(buf.String()).To(Equal("Error: failed to generate a user token: could not connect to API\n"))
+	})
+})
@@ -8,7 +8,10 @@ type GetContext struct {
 
 type ListContext struct {
 	Args struct {
-		Size   int
-		Offset string
+		Size         int
+		Offset       string
+		Watch        bool
+		Selector     string
+		NameContains string
 	}
 }
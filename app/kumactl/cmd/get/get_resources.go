@@ -22,11 +22,6 @@ func NewGetResourcesCmd(pctx *kumactl_cmd.RootContext, use string, resourceType
 		Short: fmt.Sprintf("Show %s", resourceType),
 		Long:  fmt.Sprintf("Show %s entities.", resourceType),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			rs, err := pctx.CurrentResourceStore()
-			if err != nil {
-				return err
-			}
-
 			resources, err := registry.Global().NewList(resourceType)
 			if err != nil {
 				return err
@@ -35,7 +30,30 @@ func NewGetResourcesCmd(pctx *kumactl_cmd.RootContext, use string, resourceType
 			if resources.NewItem().Scope() == model.ScopeGlobal {
 				currentMesh = ""
 			}
-			if err := rs.List(context.Background(), resources, core_store.ListByMesh(currentMesh), core_store.ListByPage(pctx.ListContext.Args.Size, pctx.ListContext.Args.Offset)); err != nil {
+			selector, err := core_store.ParseLabelSelector(pctx.ListContext.Args.Selector)
+			if err != nil {
+				return errors.Wrap(err, "invalid selector")
+			}
+			if pctx.ListContext.Args.Watch {
+				if len(selector) != 0 || pctx.ListContext.Args.NameContains != "" {
+					return errors.New("--selector and --name-contains cannot be used with --watch")
+				}
+				return watchResources(cmd, pctx, resourceType, currentMesh)
+			}
+
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			listOpts := []core_store.ListOptionsFunc{
+				core_store.ListByMesh(currentMesh),
+				core_store.ListByPage(pctx.ListContext.Args.Size, pctx.ListContext.Args.Offset),
+				core_store.ListByNameContains(pctx.ListContext.Args.NameContains),
+			}
+			if len(selector) != 0 {
+				listOpts = append(listOpts, core_store.ListByLabels(selector))
+			}
+			if err := rs.List(context.Background(), resources, listOpts...); err != nil {
 				return errors.Wrapf(err, "failed to list "+string(resourceType))
 			}
 
@@ -51,5 +69,8 @@ func NewGetResourcesCmd(pctx *kumactl_cmd.RootContext, use string, resourceType
 			}
 		},
 	}
+	cmd.PersistentFlags().BoolVarP(&pctx.ListContext.Args.Watch, "watch", "w", false, "after listing the resources, watch for the changes of them")
+	cmd.PersistentFlags().StringVarP(&pctx.ListContext.Args.Selector, "selector", "l", "", "label selector to filter on, supports '=', e.g. -l team=payments,env=prod")
+	cmd.PersistentFlags().StringVarP(&pctx.ListContext.Args.NameContains, "name-contains", "", "", "list only resources which name contains the value")
 	return cmd
 }
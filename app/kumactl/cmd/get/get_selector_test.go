@@ -0,0 +1,117 @@
+package get_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("kumactl get --selector --name-contains", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+
+	BeforeEach(func() {
+		store := core_store.NewPaginationStore(memory_resources.NewStore())
+		permissions := map[string]map[string]string{
+			"payments-1": {"team": "payments", "env": "prod"},
+			"payments-2": {"team": "payments", "env": "dev"},
+			"orders-1":   {"team": "orders", "env": "prod"},
+		}
+		for name, labels := range permissions {
+			tp := &mesh.TrafficPermissionResource{Spec: &mesh_proto.TrafficPermission{}}
+			err := store.Create(context.Background(), tp, core_store.CreateByKey(name, "default"), core_store.CreateWithLabels(labels))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Now: time.Now,
+				NewResourceStore: func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+					return store, nil
+				},
+				NewAPIServerClient: kumactl_resources.NewAPIServerClient,
+			},
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	run := func(args ...string) error {
+		rootCmd.SetArgs(append([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"get", "traffic-permissions"}, args...))
+		return rootCmd.Execute()
+	}
+
+	It("should list resources with the labels", func() {
+		// when
+		err := run("-l", "team=payments,env=prod")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("payments-1"))
+		Expect(buf.String()).ToNot(ContainSubstring("payments-2"))
+		Expect(buf.String()).ToNot(ContainSubstring("orders-1"))
+	})
+
+	It("should list resources which name contains the value", func() {
+		// when
+		err := run("--name-contains", "-2")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring("payments-2"))
+		Expect(buf.String()).ToNot(ContainSubstring("payments-1"))
+		Expect(buf.String()).ToNot(ContainSubstring("orders-1"))
+	})
+
+	It("should print the labels in the JSON output", func() {
+		// when
+		err := run("-l", "team=orders", "-ojson")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(MatchJSON(`
+		{
+			"total": 1,
+			"items": [
+				{
+					"type": "TrafficPermission",
+					"mesh": "default",
+					"name": "orders-1",
+					"labels": {
+						"env": "prod",
+						"team": "orders"
+					},
+					"creationTime": "0001-01-01T00:00:00Z",
+					"modificationTime": "0001-01-01T00:00:00Z"
+				}
+			],
+			"next": null
+		}`))
+	})
+
+	It("should fail on invalid selector", func() {
+		// when
+		err := run("-l", "team")
+
+		// then
+		Expect(err).To(MatchError(`invalid selector: invalid requirement "team", expected format key=value`))
+	})
+})
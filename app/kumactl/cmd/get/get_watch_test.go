@@ -0,0 +1,120 @@
+package get_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+type staticWatchClient struct {
+	events       []kumactl_resources.WatchEvent
+	resourceType core_model.ResourceType
+	mesh         string
+}
+
+func (s *staticWatchClient) Watch(_ context.Context, resourceType core_model.ResourceType, mesh string, handler func(kumactl_resources.WatchEvent) error) error {
+	s.resourceType = resourceType
+	s.mesh = mesh
+	for _, event := range s.events {
+		if err := handler(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ = Describe("kumactl get --watch", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var watchClient *staticWatchClient
+	rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+
+	BeforeEach(func() {
+		modificationTime := rootTime.Add(-time.Hour)
+		watchClient = &staticWatchClient{
+			events: []kumactl_resources.WatchEvent{
+				{
+					Type:            rest.WatchEventAdded,
+					ResourceVersion: "3",
+					Resource: &mesh.TrafficPermissionResource{
+						Meta: &test_model.ResourceMeta{Mesh: "default", Name: "tp-1", ModificationTime: modificationTime},
+						Spec: &mesh_proto.TrafficPermission{},
+					},
+				},
+				{
+					Type:            rest.WatchEventDeleted,
+					ResourceVersion: "4",
+					Resource: &mesh.TrafficPermissionResource{
+						Meta: &test_model.ResourceMeta{Mesh: "default", Name: "tp-2"},
+						Spec: &mesh_proto.TrafficPermission{},
+					},
+				},
+			},
+		}
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Now: func() time.Time { return rootTime },
+				NewResourceWatchClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ResourceWatchClient, error) {
+					return watchClient, nil
+				},
+				NewAPIServerClient: kumactl_resources.NewAPIServerClient,
+			},
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	It("should print the events as rows of the table", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"get", "traffic-permissions", "--watch"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(watchClient.resourceType).To(Equal(mesh.TrafficPermissionType))
+		Expect(watchClient.mesh).To(Equal("default"))
+		Expect(strings.TrimSpace(buf.String())).To(Equal(strings.TrimSpace(`
+EVENT      MESH                   NAME                   AGE
+ADDED      default                tp-1                   1h
+DELETED    default                tp-2                   -
+`)))
+	})
+
+	It("should print the events as JSON documents", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"get", "traffic-permissions", "-w", "-o", "json"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`"type": "ADDED"`))
+		Expect(buf.String()).To(ContainSubstring(`"resourceVersion": "4"`))
+		Expect(buf.String()).To(ContainSubstring(`"name": "tp-2"`))
+	})
+})
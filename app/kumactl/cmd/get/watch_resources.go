@@ -0,0 +1,105 @@
+package get
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+)
+
+// watchResources prints existing resources and then every change of them until the command is interrupted.
+// In the table format, every event is printed as a row. In other formats, every event is printed as a separate document.
+func watchResources(cmd *cobra.Command, pctx *kumactl_cmd.RootContext, resourceType model.ResourceType, mesh string) error {
+	client, err := pctx.CurrentResourceWatchClient()
+	if err != nil {
+		return err
+	}
+
+	var handler func(kumactl_resources.WatchEvent) error
+	switch format := output.Format(pctx.GetContext.Args.OutputFormat); format {
+	case output.TableFormat:
+		out := &streamingTable{out: cmd.OutOrStdout(), widths: []int{len(rest_types.WatchEventModified), minColumnWidth, minColumnWidth}}
+		headers := []string{"EVENT", "MESH", "NAME", "AGE"}
+		if mesh == "" {
+			out.widths = []int{len(rest_types.WatchEventModified), minColumnWidth}
+			headers = []string{"EVENT", "NAME", "AGE"}
+		}
+		if err := out.row(headers...); err != nil {
+			return err
+		}
+		handler = func(event kumactl_resources.WatchEvent) error {
+			meta := event.Resource.GetMeta()
+			age := "-"
+			if !meta.GetModificationTime().IsZero() {
+				age = table.TimeSince(meta.GetModificationTime(), pctx.Now())
+			}
+			row := []string{string(event.Type), meta.GetMesh(), meta.GetName(), age}
+			if mesh == "" {
+				row = []string{string(event.Type), meta.GetName(), age}
+			}
+			return out.row(row...)
+		}
+	default:
+		printer, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return err
+		}
+		handler = func(event kumactl_resources.WatchEvent) error {
+			if format == output.YAMLFormat {
+				if _, err := fmt.Fprintln(cmd.OutOrStdout(), "---"); err != nil {
+					return err
+				}
+			}
+			return printer.Print(rest_types.WatchEvent{
+				Type:            event.Type,
+				ResourceVersion: event.ResourceVersion,
+				Object:          rest_types.From.Resource(event.Resource),
+			}, cmd.OutOrStdout())
+		}
+	}
+
+	if err := client.Watch(cmd.Context(), resourceType, mesh, handler); err != nil {
+		return errors.Wrapf(err, "failed to watch %s", resourceType)
+	}
+	return nil
+}
+
+// minColumnWidth is a width of MESH and NAME columns, so most of the rows are aligned with the headers.
+const minColumnWidth = 20
+
+// streamingTable prints the rows immediately. The columns are padded to the widest cell printed so far,
+// so the rows are aligned unless a wider cell appears.
+type streamingTable struct {
+	out    io.Writer
+	widths []int
+}
+
+func (t *streamingTable) row(columns ...string) error {
+	var line strings.Builder
+	for i, column := range columns {
+		if i == len(columns)-1 {
+			line.WriteString(column)
+			break
+		}
+		if len(t.widths) <= i {
+			t.widths = append(t.widths, 0)
+		}
+		if len(column) > t.widths[i] {
+			t.widths[i] = len(column)
+		}
+		line.WriteString(column)
+		line.WriteString(strings.Repeat(" ", t.widths[i]-len(column)+3))
+	}
+	_, err := fmt.Fprintln(t.out, line.String())
+	return err
+}
@@ -21,5 +21,6 @@ func NewInspectCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.AddCommand(newInspectZonesCmd(pctx))
 	cmd.AddCommand(newInspectMeshesCmd(pctx))
 	cmd.AddCommand(newInspectServicesCmd(pctx))
+	cmd.AddCommand(newInspectTrafficPermissionsCmd(pctx))
 	return cmd
 }
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
@@ -60,6 +63,7 @@ func printMeshInsights(meshInsights *mesh.MeshInsightResourceList, out io.Writer
 			"TRAFFIC LOGS",
 			"PROXY TEMPLATES",
 			"RATE LIMITS",
+			"MTLS CERTIFICATES ISSUED BY",
 		},
 		NextRow: func() func() []string {
 			i := 0
@@ -124,19 +128,39 @@ func printMeshInsights(meshInsights *mesh.MeshInsightResourceList, out io.Writer
 				return []string{
 					meta.GetName(), // MESH
 					fmt.Sprintf("%d/%d", meshInsight.Dataplanes.Online, meshInsight.Dataplanes.Total), // DATAPLANES
-					table.Number(tp), // TRAFFIC PERMISSIONS
-					table.Number(tr), // TRAFFIC ROUTES
-					table.Number(cb), // CIRCUIT BREAKERS
-					table.Number(hc), // HEALTH CHECKS
-					table.Number(fi), // FAULT INJECTIONS
-					table.Number(es), // EXTERNAL SERVICES
-					table.Number(tt), // TRAFFIC TRACES
-					table.Number(tl), // TRAFFIC LOGS
-					table.Number(pt), // PROXY TEMPLATES
-					table.Number(rl), // RATE LIMITS
+					table.Number(tp),            // TRAFFIC PERMISSIONS
+					table.Number(tr),            // TRAFFIC ROUTES
+					table.Number(cb),            // CIRCUIT BREAKERS
+					table.Number(hc),            // HEALTH CHECKS
+					table.Number(fi),            // FAULT INJECTIONS
+					table.Number(es),            // EXTERNAL SERVICES
+					table.Number(tt),            // TRAFFIC TRACES
+					table.Number(tl),            // TRAFFIC LOGS
+					table.Number(pt),            // PROXY TEMPLATES
+					table.Number(rl),            // RATE LIMITS
+					issuedBackends(meshInsight), // MTLS CERTIFICATES ISSUED BY
 				}
 			}
 		}(),
 	}
 	return printers.NewTablePrinter().Print(data, out)
 }
+
+// issuedBackends shows how many Dataplanes have certificates issued by each CA backend,
+// which reflects the progress of switching the CA of the Mesh.
+func issuedBackends(meshInsight *mesh_proto.MeshInsight) string {
+	var backends []string
+	for backend := range meshInsight.GetMTLS().GetIssuedBackends() {
+		backends = append(backends, backend)
+	}
+	if len(backends) == 0 {
+		return "-"
+	}
+	sort.Strings(backends)
+	var stats []string
+	for _, backend := range backends {
+		stat := meshInsight.GetMTLS().GetIssuedBackends()[backend]
+		stats = append(stats, fmt.Sprintf("%s: %d/%d", backend, stat.GetTotal(), meshInsight.GetDataplanes().GetTotal()))
+	}
+	return strings.Join(stats, ", ")
+}
@@ -51,6 +51,16 @@ var _ = Describe("kumactl inspect meshes", func() {
 					string(mesh.ExternalServiceType):   {Total: 9},
 					string(mesh.RateLimitType):         {Total: 10},
 				},
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					IssuedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-2": {Total: 60, Online: 60},
+						"ca-1": {Total: 40, Online: 30, Offline: 10},
+					},
+					SupportedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-1": {Total: 100, Online: 90, Offline: 10},
+						"ca-2": {Total: 100, Online: 90, Offline: 10},
+					},
+				},
 			},
 		},
 		{
@@ -0,0 +1,133 @@
+package inspect
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/core/permissions"
+	"github.com/kumahq/kuma/pkg/core/policy"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+type inspectTrafficPermissionsContext struct {
+	args struct {
+		source      map[string]string
+		destination map[string]string
+	}
+}
+
+// trafficPermissionDecision is a result of resolving Traffic Permissions for a given source and destination.
+type trafficPermissionDecision struct {
+	Mesh        string            `json:"mesh"`
+	Source      map[string]string `json:"source"`
+	Destination map[string]string `json:"destination"`
+	Decision    string            `json:"decision"`
+	Policy      string            `json:"policy,omitempty"`
+	Reason      string            `json:"reason"`
+}
+
+func newInspectTrafficPermissionsCmd(pctx *cmd.RootContext) *cobra.Command {
+	ctx := inspectTrafficPermissionsContext{}
+	cmd := &cobra.Command{
+		Use:   "traffic-permissions",
+		Short: "Inspect which Traffic Permission decides about the traffic",
+		Long:  `Inspect which Traffic Permission decides whether the traffic from a source to a destination is allowed.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if len(ctx.args.source) == 0 {
+				return errors.New("--source is required")
+			}
+			if len(ctx.args.destination) == 0 {
+				return errors.New("--destination is required")
+			}
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			trafficPermissions := &mesh.TrafficPermissionResourceList{}
+			if err := rs.List(context.Background(), trafficPermissions, store.ListByMesh(pctx.CurrentMesh())); err != nil {
+				return errors.Wrap(err, "failed to list Traffic Permissions")
+			}
+
+			decision := decideTrafficPermission(pctx.CurrentMesh(), ctx.args.source, ctx.args.destination, trafficPermissions.Items)
+
+			switch format := output.Format(pctx.InspectContext.Args.OutputFormat); format {
+			case output.TableFormat:
+				return printTrafficPermissionDecision(decision, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(decision, cmd.OutOrStdout())
+			}
+		},
+	}
+	cmd.PersistentFlags().StringToStringVar(&ctx.args.source, "source", map[string]string{}, "tags of the source in format of key=value. You can provide many tags")
+	cmd.PersistentFlags().StringToStringVar(&ctx.args.destination, "destination", map[string]string{}, "tags of the destination in format of key=value. You can provide many tags")
+	return cmd
+}
+
+func decideTrafficPermission(meshName string, source, destination map[string]string, trafficPermissions []*mesh.TrafficPermissionResource) trafficPermissionDecision {
+	allow, deny := permissions.SplitByAction(trafficPermissions)
+	decision := policy.DecideInboundConnection(source, destination, allow, deny)
+
+	result := trafficPermissionDecision{
+		Mesh:        meshName,
+		Source:      source,
+		Destination: destination,
+		Decision:    "DENY",
+	}
+	if decision.Allowed {
+		result.Decision = "ALLOW"
+	}
+	switch {
+	case decision.Policy == nil:
+		result.Reason = "no Traffic Permission matches the destination"
+	case decision.Policy.(*mesh.TrafficPermissionResource).IsDeny():
+		result.Policy = decision.Policy.GetMeta().GetName()
+		result.Reason = "denied by the Traffic Permission"
+	case decision.Allowed:
+		result.Policy = decision.Policy.GetMeta().GetName()
+		result.Reason = "allowed by the Traffic Permission"
+	default:
+		result.Policy = decision.Policy.GetMeta().GetName()
+		result.Reason = "the most specific Traffic Permission of the destination does not match the source"
+	}
+	return result
+}
+
+func printTrafficPermissionDecision(decision trafficPermissionDecision, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"MESH", "SOURCE", "DESTINATION", "DECISION", "POLICY", "REASON"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if i > 0 {
+					return nil
+				}
+				policyName := decision.Policy
+				if policyName == "" {
+					policyName = "-"
+				}
+				return []string{
+					decision.Mesh, // MESH
+					mesh_proto.SingleValueTagSet(decision.Source).String(),      // SOURCE
+					mesh_proto.SingleValueTagSet(decision.Destination).String(), // DESTINATION
+					decision.Decision, // DECISION
+					policyName,        // POLICY
+					decision.Reason,   // REASON
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -0,0 +1,126 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	"github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+var _ = Describe("kumactl inspect traffic-permissions", func() {
+
+	permission := func(name string, source, destination map[string]string, action mesh_proto.TrafficPermission_Action) *mesh.TrafficPermissionResource {
+		return &mesh.TrafficPermissionResource{
+			Meta: &model.ResourceMeta{Mesh: "default", Name: name},
+			Spec: &mesh_proto.TrafficPermission{
+				Sources:      []*mesh_proto.Selector{{Match: source}},
+				Destinations: []*mesh_proto.Selector{{Match: destination}},
+				Action:       action,
+			},
+		}
+	}
+	trafficPermissionResources := []*mesh.TrafficPermissionResource{
+		permission("web-to-backend", map[string]string{"kuma.io/service": "web"}, map[string]string{"kuma.io/service": "backend"}, mesh_proto.TrafficPermission_ALLOW),
+		permission("deny-canary", map[string]string{"kuma.io/service": "web", "version": "canary"}, map[string]string{"kuma.io/service": "*"}, mesh_proto.TrafficPermission_DENY),
+	}
+
+	var rootCtx *kumactl_cmd.RootContext
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var store core_store.ResourceStore
+	rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
+
+	BeforeEach(func() {
+		rootCtx = &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				Now: func() time.Time { return rootTime },
+				NewResourceStore: func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+					return store, nil
+				},
+				NewAPIServerClient: kumactl_resources.NewAPIServerClient,
+			},
+		}
+
+		store = memory_resources.NewStore()
+		for _, tp := range trafficPermissionResources {
+			err := store.Create(context.Background(), tp, core_store.CreateBy(core_model.MetaToResourceKey(tp.GetMeta())))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		args       []string
+		goldenFile string
+	}
+
+	DescribeTable("should show which Traffic Permission decides about the traffic",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "traffic-permissions"}, given.args...))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", given.goldenFile)))
+		},
+		Entry("allowed traffic", testCase{
+			args:       []string{"--source", "kuma.io/service=web,version=v1", "--destination", "kuma.io/service=backend"},
+			goldenFile: "inspect-traffic-permissions.allowed.golden.txt",
+		}),
+		Entry("traffic denied by the denying permission", testCase{
+			args:       []string{"--source", "kuma.io/service=web,version=canary", "--destination", "kuma.io/service=backend"},
+			goldenFile: "inspect-traffic-permissions.denied.golden.txt",
+		}),
+		Entry("traffic from the source not matching the permission", testCase{
+			args:       []string{"--source", "kuma.io/service=frontend", "--destination", "kuma.io/service=backend"},
+			goldenFile: "inspect-traffic-permissions.not-matched.golden.txt",
+		}),
+		Entry("traffic to the destination without permissions", testCase{
+			args:       []string{"--source", "kuma.io/service=web", "--destination", "kuma.io/service=redis"},
+			goldenFile: "inspect-traffic-permissions.no-permission.golden.txt",
+		}),
+		Entry("should support JSON output", testCase{
+			args:       []string{"--source", "kuma.io/service=web,version=canary", "--destination", "kuma.io/service=backend", "-ojson"},
+			goldenFile: "inspect-traffic-permissions.denied.golden.json",
+		}),
+	)
+
+	It("should require source and destination", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "traffic-permissions", "--destination", "kuma.io/service=backend"})
+		rootCmd.SetErr(&bytes.Buffer{})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("--source is required"))
+	})
+})
@@ -0,0 +1,18 @@
+package retire
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewRetireCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire",
+		Short: "Retire signing keys",
+		Long:  `Retire signing keys.`,
+	}
+	// sub-commands
+	cmd.AddCommand(NewRetireDataplaneTokenSigningKeyCmd(pctx))
+	return cmd
+}
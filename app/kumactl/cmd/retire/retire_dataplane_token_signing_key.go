@@ -0,0 +1,72 @@
+package retire
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+type retireDataplaneTokenSigningKeyContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		serial int
+	}
+}
+
+func NewRetireDataplaneTokenSigningKeyCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &retireDataplaneTokenSigningKeyContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "dataplane-token-signing-key",
+		Short: "Retire Dataplane Token Signing Key",
+		Long: `Retire Dataplane Token Signing Key of the Mesh.
+
+All tokens signed with the retired key become invalid. The key that is currently used for signing cannot be retired,
+rotate the key first with "kumactl rotate dataplane-token-signing-key".`,
+		Example: `
+Retire the default key of the mesh after the rotation
+$ kumactl retire dataplane-token-signing-key --mesh demo --serial 0
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			mesh := pctx.CurrentMesh()
+
+			keys, err := tokens.ListSigningKeys(rs, mesh)
+			if err != nil {
+				return err
+			}
+			found := false
+			for _, key := range keys {
+				if key.Serial == ctx.args.serial {
+					found = true
+				}
+			}
+			if !found {
+				return errors.Errorf("there is no signing key with serial number %d in mesh %q", ctx.args.serial, mesh)
+			}
+			if keys[len(keys)-1].Serial == ctx.args.serial {
+				return errors.Errorf("signing key with serial number %d is currently used for signing tokens and cannot be retired. Rotate the key first", ctx.args.serial)
+			}
+
+			key := issuer.SigningKeySerialResourceKey(issuer.DataplaneTokenPrefix, mesh, ctx.args.serial)
+			if err := rs.Delete(context.Background(), system.NewSecretResource(), store.DeleteBy(key)); err != nil {
+				return errors.Wrap(err, "could not delete a signing key")
+			}
+
+			cmd.Printf("retired signing key %q with serial number %d\n", key.Name, ctx.args.serial)
+			return nil
+		},
+	}
+	cmd.Flags().IntVar(&ctx.args.serial, "serial", 0, "serial number of the signing key to retire")
+	return cmd
+}
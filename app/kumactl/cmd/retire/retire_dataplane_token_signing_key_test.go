@@ -0,0 +1,80 @@
+package retire_test
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("kumactl retire dataplane-token-signing-key", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var store core_store.ResourceStore
+
+	createKey := func(serial int) {
+		key, err := issuer.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		err = store.Create(context.Background(), key, core_store.CreateBy(issuer.SigningKeySerialResourceKey(issuer.DataplaneTokenPrefix, "demo", serial)))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		store = memory_resources.NewStore()
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewResourceStore = func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+			return store, nil
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		createKey(0)
+		createKey(1)
+	})
+
+	It("should retire the signing key", func() {
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "retire", "dataplane-token-signing-key", "--mesh", "demo", "--serial", "0"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("retired signing key \"dataplane-token-signing-key-demo\" with serial number 0\n"))
+
+		// and
+		keys, err := tokens.ListSigningKeys(store, "demo")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keys).To(HaveLen(1))
+		Expect(keys[0].Serial).To(Equal(1))
+	})
+
+	It("should not retire the key that is used for signing", func() {
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "retire", "dataplane-token-signing-key", "--mesh", "demo", "--serial", "1"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("signing key with serial number 1 is currently used for signing tokens and cannot be retired. Rotate the key first"))
+	})
+
+	It("should throw an error when the key does not exist", func() {
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "retire", "dataplane-token-signing-key", "--mesh", "demo", "--serial", "5"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`there is no signing key with serial number 5 in mesh "demo"`))
+	})
+})
@@ -0,0 +1,13 @@
+package retire_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRetireCmd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Retire Cmd Suite")
+}
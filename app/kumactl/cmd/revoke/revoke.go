@@ -0,0 +1,68 @@
+package revoke
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+func NewRevokeCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke Dataplane certificates",
+		Long:  `Revoke Dataplane certificates.`,
+	}
+	// sub-commands
+	cmd.AddCommand(NewRevokeDataplaneCmd(pctx))
+	cmd.AddCommand(NewRevokeCertificateCmd(pctx))
+	return cmd
+}
+
+// revoke adds serial numbers and the name of the Dataplane to the revocation list of the Mesh.
+// Empty values are not added.
+func revoke(rs store.ResourceStore, meshName string, serials []string, dataplane string) error {
+	mesh := core_mesh.NewMeshResource()
+	if err := rs.Get(context.Background(), mesh, store.GetByKey(meshName, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return errors.Errorf("there is no Mesh with name %q", meshName)
+		}
+		return errors.Wrapf(err, "failed to get Mesh %q", meshName)
+	}
+	if !mesh.MTLSEnabled() {
+		return errors.Errorf("mTLS is not enabled in Mesh %q", meshName)
+	}
+
+	revocation := mesh.Spec.Mtls.Revocation
+	if revocation == nil {
+		revocation = &mesh_proto.Mesh_Mtls_Revocation{}
+		mesh.Spec.Mtls.Revocation = revocation
+	}
+	for _, serial := range serials {
+		if serial != "" && !contains(revocation.Serials, serial) {
+			revocation.Serials = append(revocation.Serials, serial)
+		}
+	}
+	if dataplane != "" && !contains(revocation.Dataplanes, dataplane) {
+		revocation.Dataplanes = append(revocation.Dataplanes, dataplane)
+	}
+	if err := rs.Update(context.Background(), mesh); err != nil {
+		return errors.Wrapf(err, "failed to update Mesh %q", meshName)
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package revoke
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+func NewRevokeCertificateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "certificate SERIAL",
+		Short: "Revoke a Dataplane certificate by its serial number",
+		Long: `Revoke a Dataplane certificate by its serial number.
+
+Other Dataplanes stop accepting the revoked certificate as soon as they receive the updated CRL.
+The serial number is in hexadecimal format, the same as certificateSerial in "kumactl inspect dataplanes -o yaml".`,
+		Example: `
+Revoke the certificate
+$ kumactl revoke certificate 6fa459eaee8a3ca4894edb77e160355e --mesh demo
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			mesh := pctx.CurrentMesh()
+
+			parsed, err := core_mesh.ParseCertificateSerial(args[0])
+			if err != nil {
+				return errors.Errorf("serial number %q has to be in hexadecimal format", args[0])
+			}
+			// normalize the format, so the same serial number is not revoked twice
+			serial := parsed.Text(16)
+
+			if err := revoke(rs, mesh, []string{serial}, ""); err != nil {
+				return err
+			}
+
+			cmd.Printf("revoked certificate %q\n", serial)
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,78 @@
+package revoke_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("kumactl revoke certificate", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var store core_store.ResourceStore
+
+	BeforeEach(func() {
+		store = memory_resources.NewStore()
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewResourceStore = func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+			return store, nil
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		createMesh(store, "demo", true)
+		createMesh(store, "no-mtls", false)
+	})
+
+	It("should revoke the certificate", func() {
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "revoke", "certificate", "001A2B", "--mesh", "demo"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("revoked certificate \"1a2b\"\n"))
+
+		// and
+		revocation := revocationOf(store, "demo")
+		Expect(revocation.Serials).To(Equal([]string{"1a2b"}))
+		Expect(revocation.Dataplanes).To(BeEmpty())
+	})
+
+	It("should throw an error when the serial number is invalid", func() {
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "revoke", "certificate", "xyz", "--mesh", "demo"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`serial number "xyz" has to be in hexadecimal format`))
+	})
+
+	It("should throw an error when mTLS is not enabled", func() {
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "revoke", "certificate", "1a2b", "--mesh", "no-mtls"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`mTLS is not enabled in Mesh "no-mtls"`))
+	})
+
+	It("should throw an error when the mesh does not exist", func() {
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "revoke", "certificate", "1a2b", "--mesh", "unknown"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`there is no Mesh with name "unknown"`))
+	})
+})
@@ -0,0 +1,85 @@
+package revoke
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+func NewRevokeDataplaneCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dataplane NAME",
+		Short: "Revoke certificates of a Dataplane",
+		Long: `Revoke certificates of a Dataplane.
+
+All certificates of the Dataplane that have not expired yet are revoked and the Dataplane is not issued certificates anymore.
+Other Dataplanes stop accepting the revoked certificate as soon as they receive the updated CRL.`,
+		Example: `
+Revoke certificates of the Dataplane
+$ kumactl revoke dataplane backend-01 --mesh demo
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			mesh := pctx.CurrentMesh()
+			name := args[0]
+
+			if err := rs.Get(context.Background(), core_mesh.NewDataplaneResource(), store.GetByKey(name, mesh)); err != nil {
+				if store.IsResourceNotFound(err) {
+					return errors.Errorf("there is no Dataplane with name %q in mesh %q", name, mesh)
+				}
+				return errors.Wrapf(err, "failed to get Dataplane %q", name)
+			}
+			insight := core_mesh.NewDataplaneInsightResource()
+			if err := rs.Get(context.Background(), insight, store.GetByKey(name, mesh)); err != nil && !store.IsResourceNotFound(err) {
+				return errors.Wrapf(err, "failed to get insights of Dataplane %q", name)
+			}
+			serials := validSerials(insight)
+
+			if err := revoke(rs, mesh, serials, name); err != nil {
+				return err
+			}
+
+			if len(serials) == 0 {
+				cmd.Printf("revoked Dataplane %q. It has no valid certificates and will not be issued certificates anymore\n", name)
+			} else {
+				cmd.Printf("revoked certificates %s of Dataplane %q. It will not be issued certificates anymore\n", quoted(serials), name)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// validSerials returns serial numbers of all certificates of the Dataplane that have not expired yet.
+// The Dataplane is issued a new certificate on every reconnect, therefore revoking only the last one is not enough.
+func validSerials(insight *core_mesh.DataplaneInsightResource) []string {
+	var serials []string
+	for _, cert := range insight.Spec.GetValidCertificates(core.Now()) {
+		serials = append(serials, cert.Serial)
+	}
+	// Control Planes of older versions track only the last certificate
+	if len(insight.Spec.GetMTLS().GetIssuedCertificates()) == 0 && insight.Spec.GetMTLS().GetCertificateSerial() != "" {
+		serials = append(serials, insight.Spec.GetMTLS().GetCertificateSerial())
+	}
+	return serials
+}
+
+func quoted(values []string) string {
+	var quoted []string
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	return strings.Join(quoted, ", ")
+}
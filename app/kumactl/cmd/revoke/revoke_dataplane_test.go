@@ -0,0 +1,171 @@
+package revoke_test
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+func createMesh(store core_store.ResourceStore, name string, mtls bool) {
+	mesh := core_mesh.NewMeshResource()
+	if mtls {
+		mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+			EnabledBackend: "ca-1",
+			Backends: []*mesh_proto.CertificateAuthorityBackend{
+				{
+					Name: "ca-1",
+					Type: "builtin",
+				},
+			},
+		}
+	}
+	err := store.Create(context.Background(), mesh, core_store.CreateByKey(name, model.NoMesh))
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func revocationOf(store core_store.ResourceStore, name string) *mesh_proto.Mesh_Mtls_Revocation {
+	mesh := core_mesh.NewMeshResource()
+	err := store.Get(context.Background(), mesh, core_store.GetByKey(name, model.NoMesh))
+	Expect(err).ToNot(HaveOccurred())
+	return mesh.Spec.GetMtls().GetRevocation()
+}
+
+var _ = Describe("kumactl revoke dataplane", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var store core_store.ResourceStore
+
+	createDataplane := func(name string, mtls *mesh_proto.DataplaneInsight_MTLS) {
+		dp := core_mesh.NewDataplaneResource()
+		err := store.Create(context.Background(), dp, core_store.CreateByKey(name, "demo"))
+		Expect(err).ToNot(HaveOccurred())
+		if mtls == nil {
+			return
+		}
+		insight := core_mesh.NewDataplaneInsightResource()
+		insight.Spec.MTLS = mtls
+		err = store.Create(context.Background(), insight, core_store.CreateByKey(name, "demo"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	issuedCertificates := func() *mesh_proto.DataplaneInsight_MTLS {
+		return &mesh_proto.DataplaneInsight_MTLS{
+			CertificateSerial: "3c",
+			IssuedCertificates: []*mesh_proto.DataplaneInsight_MTLS_IssuedCertificate{
+				{Serial: "1a", ExpirationTime: util_proto.MustTimestampProto(time.Now().Add(-time.Hour))},
+				{Serial: "2b", ExpirationTime: util_proto.MustTimestampProto(time.Now().Add(time.Hour))},
+				{Serial: "3c", ExpirationTime: util_proto.MustTimestampProto(time.Now().Add(2 * time.Hour))},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		store = memory_resources.NewStore()
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewResourceStore = func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+			return store, nil
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		createMesh(store, "demo", true)
+		createMesh(store, "no-mtls", false)
+	})
+
+	It("should revoke all valid certificates of the dataplane", func() {
+		// given
+		createDataplane("backend-01", issuedCertificates())
+
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "revoke", "dataplane", "backend-01", "--mesh", "demo"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("revoked certificates \"2b\", \"3c\" of Dataplane \"backend-01\". It will not be issued certificates anymore\n"))
+
+		// and expired certificate is not revoked
+		revocation := revocationOf(store, "demo")
+		Expect(revocation.Serials).To(Equal([]string{"2b", "3c"}))
+		Expect(revocation.Dataplanes).To(Equal([]string{"backend-01"}))
+	})
+
+	It("should revoke the last certificate when issued certificates are not tracked", func() {
+		// given
+		createDataplane("backend-01", &mesh_proto.DataplaneInsight_MTLS{
+			CertificateSerial: "1a2b",
+		})
+
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "revoke", "dataplane", "backend-01", "--mesh", "demo"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("revoked certificates \"1a2b\" of Dataplane \"backend-01\". It will not be issued certificates anymore\n"))
+
+		// and
+		revocation := revocationOf(store, "demo")
+		Expect(revocation.Serials).To(Equal([]string{"1a2b"}))
+		Expect(revocation.Dataplanes).To(Equal([]string{"backend-01"}))
+	})
+
+	It("should revoke the dataplane without a certificate", func() {
+		// given
+		createDataplane("backend-01", nil)
+
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "revoke", "dataplane", "backend-01", "--mesh", "demo"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("revoked Dataplane \"backend-01\". It has no valid certificates and will not be issued certificates anymore\n"))
+
+		// and
+		revocation := revocationOf(store, "demo")
+		Expect(revocation.Serials).To(BeEmpty())
+		Expect(revocation.Dataplanes).To(Equal([]string{"backend-01"}))
+	})
+
+	It("should not duplicate entries when the dataplane is revoked twice", func() {
+		// given
+		createDataplane("backend-01", issuedCertificates())
+
+		// when
+		for i := 0; i < 2; i++ {
+			rootCmd.SetArgs([]string{"--no-config", "revoke", "dataplane", "backend-01", "--mesh", "demo"})
+			Expect(rootCmd.Execute()).To(Succeed())
+		}
+
+		// then
+		revocation := revocationOf(store, "demo")
+		Expect(revocation.Serials).To(Equal([]string{"2b", "3c"}))
+		Expect(revocation.Dataplanes).To(Equal([]string{"backend-01"}))
+	})
+
+	It("should throw an error when the dataplane does not exist", func() {
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "revoke", "dataplane", "backend-01", "--mesh", "demo"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`there is no Dataplane with name "backend-01" in mesh "demo"`))
+	})
+})
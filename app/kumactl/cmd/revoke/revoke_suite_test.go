@@ -0,0 +1,13 @@
+package revoke_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRevokeCmd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Revoke Cmd Suite")
+}
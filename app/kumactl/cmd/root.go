@@ -15,6 +15,9 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/cmd/get"
 	"github.com/kumahq/kuma/app/kumactl/cmd/inspect"
 	"github.com/kumahq/kuma/app/kumactl/cmd/install"
+	"github.com/kumahq/kuma/app/kumactl/cmd/retire"
+	"github.com/kumahq/kuma/app/kumactl/cmd/revoke"
+	"github.com/kumahq/kuma/app/kumactl/cmd/rotate"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	kumactl_config "github.com/kumahq/kuma/app/kumactl/pkg/config"
 	kumactl_errors "github.com/kumahq/kuma/app/kumactl/pkg/errors"
@@ -96,6 +99,9 @@ func NewRootCmd(root *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.AddCommand(get.NewGetCmd(root))
 	cmd.AddCommand(inspect.NewInspectCmd(root))
 	cmd.AddCommand(install.NewInstallCmd(root))
+	cmd.AddCommand(retire.NewRetireCmd(root))
+	cmd.AddCommand(revoke.NewRevokeCmd(root))
+	cmd.AddCommand(rotate.NewRotateCmd(root))
 	cmd.AddCommand(uninstall.NewUninstallCmd(root))
 	cmd.AddCommand(version.NewVersionCmd())
 	kumactl_cmd.WrapRunnables(cmd, kumactl_errors.FormatErrorWrapper)
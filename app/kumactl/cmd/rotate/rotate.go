@@ -0,0 +1,18 @@
+package rotate
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewRotateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Rotate signing keys",
+		Long:  `Rotate signing keys.`,
+	}
+	// sub-commands
+	cmd.AddCommand(NewRotateDataplaneTokenSigningKeyCmd(pctx))
+	return cmd
+}
@@ -0,0 +1,70 @@
+package rotate
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+type rotateDataplaneTokenSigningKeyContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		keyType string
+	}
+}
+
+func NewRotateDataplaneTokenSigningKeyCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &rotateDataplaneTokenSigningKeyContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "dataplane-token-signing-key",
+		Short: "Rotate Dataplane Token Signing Key",
+		Long: `Rotate Dataplane Token Signing Key of the Mesh.
+
+A new signing key is created and all new Dataplane Tokens are signed with it.
+Tokens signed with the previous keys stay valid until those keys are retired with "kumactl retire dataplane-token-signing-key".`,
+		Example: `
+Rotate the key of the mesh
+$ kumactl rotate dataplane-token-signing-key --mesh demo
+
+Rotate the key of the mesh to the ECDSA key required by the ES256 signing method
+$ kumactl rotate dataplane-token-signing-key --mesh demo --key-type ecdsa
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			mesh := pctx.CurrentMesh()
+
+			keys, err := tokens.ListSigningKeys(rs, mesh)
+			if err != nil {
+				return err
+			}
+			serial := 1
+			if len(keys) > 0 {
+				serial = keys[len(keys)-1].Serial + 1
+			}
+
+			signingKey, err := issuer.CreateSigningKeyOfType(ctx.args.keyType)
+			if err != nil {
+				return errors.Wrap(err, "could not create a signing key")
+			}
+			key := issuer.SigningKeySerialResourceKey(issuer.DataplaneTokenPrefix, mesh, serial)
+			if err := rs.Create(context.Background(), signingKey, store.CreateBy(key)); err != nil {
+				return errors.Wrap(err, "could not store a signing key")
+			}
+
+			cmd.Printf("created signing key %q with serial number %d. New tokens are signed with it\n", key.Name, serial)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.keyType, "key-type", issuer.KeyTypeRSA, `type of the key: one of rsa|ecdsa. RS256 signing method requires the rsa key, ES256 signing method requires the ecdsa key`)
+	return cmd
+}
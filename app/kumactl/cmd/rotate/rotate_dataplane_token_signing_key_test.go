@@ -0,0 +1,64 @@
+package rotate_test
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("kumactl rotate dataplane-token-signing-key", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var store core_store.ResourceStore
+
+	BeforeEach(func() {
+		store = memory_resources.NewStore()
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewResourceStore = func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+			return store, nil
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		key, err := issuer.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		err = store.Create(context.Background(), key, core_store.CreateBy(issuer.SigningKeyResourceKey(issuer.DataplaneTokenPrefix, "demo")))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should create a signing key with the next serial number", func() {
+		// when
+		rootCmd.SetArgs([]string{"--no-config", "rotate", "dataplane-token-signing-key", "--mesh", "demo"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("created signing key \"dataplane-token-rotated-signing-key-demo-1\" with serial number 1. New tokens are signed with it\n"))
+
+		// when rotated again
+		buf.Reset()
+		err = rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("created signing key \"dataplane-token-rotated-signing-key-demo-2\" with serial number 2. New tokens are signed with it\n"))
+
+		// and
+		keys, err := tokens.ListSigningKeys(store, "demo")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keys).To(HaveLen(3))
+	})
+})
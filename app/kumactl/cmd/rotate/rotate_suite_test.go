@@ -0,0 +1,13 @@
+package rotate_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRotateCmd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rotate Cmd Suite")
+}
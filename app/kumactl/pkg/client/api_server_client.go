@@ -16,14 +16,30 @@ const (
 	Timeout = 60 * time.Second
 )
 
+const (
+	// AuthTypeTokens authenticates requests with the User Token sent in the Authorization header.
+	AuthTypeTokens = "tokens"
+	// AuthConfToken is a key of the User Token in the auth configuration.
+	AuthConfToken = "token"
+)
+
 func ApiServerClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (util_http.Client, error) {
+	return apiServerClient(coordinates, Timeout)
+}
+
+// ApiServerStreamingClient returns the client without the time limit, so it can receive long-running streams like watches.
+func ApiServerStreamingClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (util_http.Client, error) {
+	return apiServerClient(coordinates, 0)
+}
+
+func apiServerClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer, timeout time.Duration) (util_http.Client, error) {
 	headers := make(map[string]string)
 	baseURL, err := url.Parse(coordinates.Url)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to parse API Server URL")
 	}
 	client := &http.Client{
-		Timeout: Timeout,
+		Timeout: timeout,
 	}
 	if err := util_http.ConfigureMTLS(client, coordinates.CaCertFile, coordinates.ClientCertFile, coordinates.ClientKeyFile); err != nil {
 		return nil, errors.Wrap(err, "could not configure HTTP client with TLS")
@@ -31,5 +47,16 @@ func ApiServerClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer
 	for _, h := range coordinates.Headers {
 		headers[h.Key] = h.Value
 	}
+	switch coordinates.AuthType {
+	case "":
+	case AuthTypeTokens:
+		token := coordinates.AuthConf[AuthConfToken]
+		if token == "" {
+			return nil, errors.Errorf("auth type %q requires %q in the auth configuration", AuthTypeTokens, AuthConfToken)
+		}
+		headers["Authorization"] = "Bearer " + token
+	default:
+		return nil, errors.Errorf("unsupported auth type %q", coordinates.AuthType)
+	}
 	return util_http.ClientWithBaseURL(client, baseURL, headers), nil
 }
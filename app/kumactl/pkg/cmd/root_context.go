@@ -32,7 +32,9 @@ type RootRuntime struct {
 	NewZoneOverviewClient      func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ZoneOverviewClient, error)
 	NewServiceOverviewClient   func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ServiceOverviewClient, error)
 	NewDataplaneTokenClient    func(*config_proto.ControlPlaneCoordinates_ApiServer) (tokens.DataplaneTokenClient, error)
+	NewUserTokenClient         func(*config_proto.ControlPlaneCoordinates_ApiServer) (tokens.UserTokenClient, error)
 	NewAPIServerClient         func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ApiServerClient, error)
+	NewResourceWatchClient     func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.ResourceWatchClient, error)
 }
 
 // RootContext contains variables, functions and components that can be overridden when extending kumactl or running the test.
@@ -66,7 +68,9 @@ func DefaultRootContext() *RootContext {
 			NewZoneOverviewClient:      kumactl_resources.NewZoneOverviewClient,
 			NewServiceOverviewClient:   kumactl_resources.NewServiceOverviewClient,
 			NewDataplaneTokenClient:    tokens.NewDataplaneTokenClient,
+			NewUserTokenClient:         tokens.NewUserTokenClient,
 			NewAPIServerClient:         kumactl_resources.NewAPIServerClient,
+			NewResourceWatchClient:     kumactl_resources.NewResourceWatchClient,
 		},
 		TypeArgs: map[string]core_model.ResourceType{
 			"circuit-breaker":    core_mesh.CircuitBreakerType,
@@ -83,6 +87,7 @@ func DefaultRootContext() *RootContext {
 			"traffic-permission": core_mesh.TrafficPermissionType,
 			"traffic-route":      core_mesh.TrafficRouteType,
 			"traffic-trace":      core_mesh.TrafficTraceType,
+			"access-role":        system.AccessRoleType,
 			"global-secret":      system.GlobalSecretType,
 			"secret":             system.SecretType,
 			"zone":               system.ZoneType,
@@ -166,6 +171,14 @@ func (rc *RootContext) CurrentResourceStore() (core_store.ResourceStore, error)
 	return rs, nil
 }
 
+func (rc *RootContext) CurrentResourceWatchClient() (kumactl_resources.ResourceWatchClient, error) {
+	controlPlane, err := rc.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewResourceWatchClient(controlPlane.Coordinates.ApiServer)
+}
+
 func (rc *RootContext) CurrentDataplaneOverviewClient() (kumactl_resources.DataplaneOverviewClient, error) {
 	controlPlane, err := rc.CurrentControlPlane()
 	if err != nil {
@@ -198,6 +211,14 @@ func (rc *RootContext) CurrentDataplaneTokenClient() (tokens.DataplaneTokenClien
 	return rc.Runtime.NewDataplaneTokenClient(controlPlane.Coordinates.ApiServer)
 }
 
+func (rc *RootContext) CurrentUserTokenClient() (tokens.UserTokenClient, error) {
+	controlPlane, err := rc.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewUserTokenClient(controlPlane.Coordinates.ApiServer)
+}
+
 func (rc *RootContext) IsFirstTimeUsage() bool {
 	if rc.Args.ConfigFile != "" {
 		return !util_files.FileExists(rc.Args.ConfigFile)
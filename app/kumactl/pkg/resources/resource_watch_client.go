@@ -0,0 +1,172 @@
+package resources
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kumactl_client "github.com/kumahq/kuma/app/kumactl/pkg/client"
+	kuma_rest "github.com/kumahq/kuma/pkg/api-server/definitions"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	"github.com/kumahq/kuma/pkg/plugins/resources/remote"
+	kuma_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+// WatchEvent is a change of the resource. The resource of the DELETED event has only the meta.
+type WatchEvent struct {
+	Type            rest.WatchEventType
+	ResourceVersion string
+	Resource        model.Resource
+}
+
+type ResourceWatchClient interface {
+	// Watch streams existing resources as ADDED events followed by the changes of the resources.
+	// It blocks until the context is cancelled or the handler returns an error.
+	// When the connection is closed by the server, the watch is resumed from the last received resource version.
+	Watch(ctx context.Context, resourceType model.ResourceType, mesh string, handler func(WatchEvent) error) error
+}
+
+func NewResourceWatchClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (ResourceWatchClient, error) {
+	client, err := kumactl_client.ApiServerStreamingClient(coordinates)
+	if err != nil {
+		return nil, err
+	}
+	return &httpResourceWatchClient{
+		Client: client,
+		api:    kuma_rest.AllApis(),
+	}, nil
+}
+
+// reconnectBackoff is a time after which the watch is resumed when the server closed the connection.
+var reconnectBackoff = time.Second
+
+type httpResourceWatchClient struct {
+	Client kuma_http.Client
+	api    rest.Api
+}
+
+func (c *httpResourceWatchClient) Watch(ctx context.Context, resourceType model.ResourceType, mesh string, handler func(WatchEvent) error) error {
+	resourceApi, err := c.api.GetResourceApi(resourceType)
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct URI to watch %q", resourceType)
+	}
+	resourceVersion := ""
+	for {
+		req, err := http.NewRequest("GET", resourceApi.List(mesh), nil)
+		if err != nil {
+			return err
+		}
+		query := req.URL.Query()
+		query.Add("watch", "true")
+		if resourceVersion != "" {
+			query.Add("resourceVersion", resourceVersion)
+		}
+		req.URL.RawQuery = query.Encode()
+		req.Header.Set("Accept", "text/event-stream")
+
+		resp, err := c.Client.Do(req.WithContext(ctx))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return readError(resp)
+		}
+		err = readEvents(resp.Body, resourceType, func(event WatchEvent) error {
+			resourceVersion = event.ResourceVersion
+			return handler(event)
+		})
+		resp.Body.Close()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(reconnectBackoff):
+		}
+	}
+}
+
+type rawWatchEvent struct {
+	Type            rest.WatchEventType `json:"type"`
+	ResourceVersion string              `json:"resourceVersion"`
+	Object          json.RawMessage     `json:"object"`
+	Message         string              `json:"message"`
+}
+
+// readEvents reads the server-sent events until the end of the stream.
+func readEvents(body io.Reader, resourceType model.ResourceType, handler func(WatchEvent) error) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		case line == "" && data.Len() > 0:
+			event, err := parseEvent(data.String(), resourceType)
+			if err != nil {
+				return err
+			}
+			data.Reset()
+			if err := handler(event); err != nil {
+				return err
+			}
+		}
+		// other fields like "id" and "event" are repeated in the data, comments (heartbeats) are ignored
+	}
+	return scanner.Err()
+}
+
+func parseEvent(data string, resourceType model.ResourceType) (WatchEvent, error) {
+	raw := rawWatchEvent{}
+	if err := json.Unmarshal([]byte(data), &raw); err != nil {
+		return WatchEvent{}, errors.Wrap(err, "could not parse the watch event")
+	}
+	if raw.Type == rest.WatchEventError {
+		return WatchEvent{}, errors.Errorf("watch failed: %s", raw.Message)
+	}
+	res, err := registry.Global().NewObject(resourceType)
+	if err != nil {
+		return WatchEvent{}, err
+	}
+	if err := remote.Unmarshal(raw.Object, res); err != nil {
+		return WatchEvent{}, errors.Wrap(err, "could not parse the resource of the watch event")
+	}
+	return WatchEvent{
+		Type:            raw.Type,
+		ResourceVersion: raw.ResourceVersion,
+		Resource:        res,
+	}, nil
+}
+
+func readError(resp *http.Response) error {
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	kumaErr := types.Error{}
+	if err := json.Unmarshal(b, &kumaErr); err == nil && kumaErr.Title != "" && kumaErr.Details != "" {
+		return &kumaErr
+	}
+	return errors.Errorf("(%d): %s", resp.StatusCode, string(b))
+}
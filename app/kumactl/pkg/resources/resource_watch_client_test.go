@@ -0,0 +1,105 @@
+package resources
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	kuma_rest "github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+)
+
+var _ = Describe("httpResourceWatchClient", func() {
+
+	var backupReconnectBackoff time.Duration
+
+	BeforeEach(func() {
+		backupReconnectBackoff = reconnectBackoff
+		reconnectBackoff = 0
+	})
+
+	AfterEach(func() {
+		reconnectBackoff = backupReconnectBackoff
+	})
+
+	stream := func(events ...string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(strings.Join(events, ""))),
+		}
+	}
+
+	It("should parse the events and resume the watch after the connection is closed", func() {
+		// given
+		var queries []string
+		client := httpResourceWatchClient{
+			Client: &http.Client{
+				Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					Expect(req.URL.Path).To(Equal("/meshes/default/traffic-permissions"))
+					queries = append(queries, req.URL.RawQuery)
+					if len(queries) == 1 {
+						return stream(
+							"id: 3\nevent: ADDED\n",
+							`data: {"type":"ADDED","resourceVersion":"3","object":{"type":"TrafficPermission","mesh":"default","name":"tp-1","creationTime":"0001-01-01T00:00:00Z","modificationTime":"0001-01-01T00:00:00Z","sources":[{"match":{"kuma.io/service":"web"}}]}}`+"\n\n",
+							": heartbeat\n\n",
+						), nil
+					}
+					return stream(
+						"id: 4\nevent: DELETED\n",
+						`data: {"type":"DELETED","resourceVersion":"4","object":{"type":"TrafficPermission","mesh":"default","name":"tp-1","creationTime":"0001-01-01T00:00:00Z","modificationTime":"0001-01-01T00:00:00Z"}}`+"\n\n",
+						"event: ERROR\n",
+						`data: {"type":"ERROR","resourceVersion":"4","message":"resourceVersion is too old"}`+"\n\n",
+					), nil
+				}),
+			},
+			api: kuma_rest.AllApis(),
+		}
+
+		// when
+		var events []WatchEvent
+		err := client.Watch(context.Background(), mesh.TrafficPermissionType, "default", func(event WatchEvent) error {
+			events = append(events, event)
+			return nil
+		})
+
+		// then
+		Expect(err).To(MatchError("watch failed: resourceVersion is too old"))
+		Expect(queries).To(Equal([]string{"watch=true", "resourceVersion=3&watch=true"}))
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Type).To(Equal(rest.WatchEventAdded))
+		Expect(events[0].ResourceVersion).To(Equal("3"))
+		Expect(events[0].Resource.GetMeta().GetName()).To(Equal("tp-1"))
+		Expect(events[0].Resource.(*mesh.TrafficPermissionResource).Spec.Sources[0].Match["kuma.io/service"]).To(Equal("web"))
+		Expect(events[1].Type).To(Equal(rest.WatchEventDeleted))
+		Expect(events[1].Resource.GetMeta().GetMesh()).To(Equal("default"))
+	})
+
+	It("should return error from the server", func() {
+		// given
+		client := httpResourceWatchClient{
+			Client: &http.Client{
+				Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusGone,
+						Body:       ioutil.NopCloser(strings.NewReader(`{"title": "Could not watch resources", "details": "resourceVersion is too old"}`)),
+					}, nil
+				}),
+			},
+			api: kuma_rest.AllApis(),
+		}
+
+		// when
+		err := client.Watch(context.Background(), mesh.MeshType, "", func(WatchEvent) error {
+			return nil
+		})
+
+		// then
+		Expect(err).To(MatchError("Could not watch resources (resourceVersion is too old)"))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -26,7 +27,7 @@ func NewDataplaneTokenClient(config *kumactl_config.ControlPlaneCoordinates_ApiS
 }
 
 type DataplaneTokenClient interface {
-	Generate(name string, mesh string, tags map[string][]string, dpType string) (string, error)
+	Generate(name string, mesh string, tags map[string][]string, dpType string, validFor time.Duration) (string, error)
 }
 
 type httpDataplaneTokenClient struct {
@@ -35,23 +36,30 @@ type httpDataplaneTokenClient struct {
 
 var _ DataplaneTokenClient = &httpDataplaneTokenClient{}
 
-func (h *httpDataplaneTokenClient) Generate(name string, mesh string, tags map[string][]string, dpType string) (string, error) {
+func (h *httpDataplaneTokenClient) Generate(name string, mesh string, tags map[string][]string, dpType string, validFor time.Duration) (string, error) {
 	tokenReq := &types.DataplaneTokenRequest{
 		Name: name,
 		Mesh: mesh,
 		Tags: tags,
 		Type: dpType,
 	}
+	if validFor > 0 {
+		tokenReq.ValidFor = validFor.String()
+	}
+	return generateToken(h.client, "/tokens", tokenReq)
+}
+
+func generateToken(client util_http.Client, path string, tokenReq interface{}) (string, error) {
 	reqBytes, err := json.Marshal(tokenReq)
 	if err != nil {
 		return "", errors.Wrap(err, "could not marshal token request to json")
 	}
-	req, err := http.NewRequest("POST", "/tokens", bytes.NewReader(reqBytes))
+	req, err := http.NewRequest("POST", path, bytes.NewReader(reqBytes))
 	if err != nil {
 		return "", errors.Wrap(err, "could not construct the request")
 	}
 	req.Header.Set("content-type", "application/json")
-	resp, err := h.client.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return "", errors.Wrap(err, "could not execute the request")
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"github.com/emicklei/go-restful"
 	. "github.com/onsi/ginkgo"
@@ -11,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	"github.com/kumahq/kuma/pkg/api-server/authz"
 	config_kumactl "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 	tokens_server "github.com/kumahq/kuma/pkg/tokens/builtin/server"
@@ -21,7 +23,7 @@ type staticTokenIssuer struct {
 
 var _ issuer.DataplaneTokenIssuer = &staticTokenIssuer{}
 
-func (s *staticTokenIssuer) Generate(identity issuer.DataplaneIdentity) (issuer.Token, error) {
+func (s *staticTokenIssuer) Generate(identity issuer.DataplaneIdentity, validFor time.Duration) (issuer.Token, error) {
 	return fmt.Sprintf("token-for-%s-%s", identity.Name, identity.Mesh), nil
 }
 
@@ -35,7 +37,7 @@ var _ = Describe("Tokens Client", func() {
 
 	BeforeEach(func() {
 		container := restful.NewContainer()
-		container.Add(tokens_server.NewWebservice(&staticTokenIssuer{}))
+		container.Add(tokens_server.NewWebservice(&staticTokenIssuer{}, authz.NewAdminAccessControl(authz.AdminAuth{AllowFromLocalhost: true})))
 		server = httptest.NewServer(container.ServeMux)
 	})
 
@@ -52,12 +54,12 @@ var _ = Describe("Tokens Client", func() {
 
 		// wait for server
 		Eventually(func() error {
-			_, err := client.Generate("example", "default", nil, "dataplane")
+			_, err := client.Generate("example", "default", nil, "dataplane", 0)
 			return err
 		}, "5s", "100ms").ShouldNot(HaveOccurred())
 
 		// when
-		token, err := client.Generate("example", "default", nil, "dataplane")
+		token, err := client.Generate("example", "default", nil, "dataplane", 0)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -81,7 +83,7 @@ var _ = Describe("Tokens Client", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// when
-		_, err = client.Generate("example", "default", nil, "dataplane")
+		_, err = client.Generate("example", "default", nil, "dataplane", 0)
 
 		// then
 		Expect(err).To(MatchError("(500): Internal Server Error"))
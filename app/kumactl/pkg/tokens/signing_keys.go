@@ -0,0 +1,20 @@
+package tokens
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+// ListSigningKeys returns Dataplane Token Signing Keys of the Mesh sorted by the serial number.
+func ListSigningKeys(rs store.ResourceStore, mesh string) ([]issuer.SigningKey, error) {
+	secrets := &system.SecretResourceList{}
+	if err := rs.List(context.Background(), secrets, store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "could not list signing keys")
+	}
+	return issuer.SigningKeysFromSecrets(secrets.Items, issuer.DataplaneTokenPrefix, mesh), nil
+}
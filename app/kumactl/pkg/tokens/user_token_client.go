@@ -0,0 +1,39 @@
+package tokens
+
+import (
+	"time"
+
+	kumactl_client "github.com/kumahq/kuma/app/kumactl/pkg/client"
+	kumactl_config "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+func NewUserTokenClient(config *kumactl_config.ControlPlaneCoordinates_ApiServer) (UserTokenClient, error) {
+	client, err := kumactl_client.ApiServerClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &httpUserTokenClient{
+		client: client,
+	}, nil
+}
+
+type UserTokenClient interface {
+	Generate(name string, groups []string, validFor time.Duration) (string, error)
+}
+
+type httpUserTokenClient struct {
+	client util_http.Client
+}
+
+var _ UserTokenClient = &httpUserTokenClient{}
+
+func (h *httpUserTokenClient) Generate(name string, groups []string, validFor time.Duration) (string, error) {
+	tokenReq := &types.UserTokenRequest{
+		Name:     name,
+		Groups:   groups,
+		ValidFor: validFor.String(),
+	}
+	return generateToken(h.client, "/tokens/user", tokenReq)
+}
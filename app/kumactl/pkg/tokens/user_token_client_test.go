@@ -0,0 +1,89 @@
+package tokens_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	config_kumactl "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+)
+
+var _ = Describe("User Tokens Client", func() {
+
+	var server *httptest.Server
+	var authHeader string
+	var tokenReq types.UserTokenRequest
+
+	BeforeEach(func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/tokens/user", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			authHeader = req.Header.Get("Authorization")
+			Expect(json.NewDecoder(req.Body).Decode(&tokenReq)).To(Succeed())
+			_, err := writer.Write([]byte("user-token"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+		server = httptest.NewServer(mux)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should return a token", func() {
+		// given
+		client, err := tokens.NewUserTokenClient(&config_kumactl.ControlPlaneCoordinates_ApiServer{
+			Url: server.URL,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		token, err := client.Generate("john", []string{"team-a"}, 24*time.Hour)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token).To(Equal("user-token"))
+		Expect(tokenReq).To(Equal(types.UserTokenRequest{
+			Name:     "john",
+			Groups:   []string{"team-a"},
+			ValidFor: "24h0m0s",
+		}))
+		Expect(authHeader).To(BeEmpty())
+	})
+
+	It("should authenticate with the user token from the configuration", func() {
+		// given
+		client, err := tokens.NewUserTokenClient(&config_kumactl.ControlPlaneCoordinates_ApiServer{
+			Url:      server.URL,
+			AuthType: "tokens",
+			AuthConf: map[string]string{
+				"token": "admin-token",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = client.Generate("john", nil, time.Hour)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(authHeader).To(Equal("Bearer admin-token"))
+	})
+
+	It("should fail when the token is not configured", func() {
+		// when
+		_, err := tokens.NewUserTokenClient(&config_kumactl.ControlPlaneCoordinates_ApiServer{
+			Url:      server.URL,
+			AuthType: "tokens",
+		})
+
+		// then
+		Expect(err).To(MatchError(`auth type "tokens" requires "token" in the auth configuration`))
+	})
+})
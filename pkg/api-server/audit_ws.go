@@ -0,0 +1,58 @@
+package api_server
+
+import (
+	"net"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/api-server/authz"
+	"github.com/kumahq/kuma/pkg/audit"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+// AuditLogType is a type used to authorize access to the audit log.
+const AuditLogType model.ResourceType = "AuditLog"
+
+func auditWs(auditLog audit.Log, access authz.AccessControl) *restful.WebService {
+	ws := new(restful.WebService).
+		Path("/audit").
+		Produces(restful.MIME_JSON)
+	return ws.Route(ws.GET("").Filter(access.Filter(system.AccessRoleActionList, AuditLogType, true)).To(func(request *restful.Request, response *restful.Response) {
+		entries := auditLog.Entries()
+		if entries == nil {
+			entries = []audit.Entry{}
+		}
+		if err := response.WriteAsJson(entries); err != nil {
+			log.Error(err, "failed marshaling response")
+		}
+	}))
+}
+
+// resourceAuditor records the changes of the resources done through the API Server.
+type resourceAuditor struct {
+	authenticator authz.Authenticator
+	auditLog      audit.Log
+}
+
+func (a *resourceAuditor) record(request *restful.Request, op audit.Operation, resType model.ResourceType, key model.ResourceKey, before, after model.ResourceSpec) {
+	if !audit.IsAudited(resType) {
+		return
+	}
+	// the request has already been authenticated by AccessControl, therefore the error is not expected
+	subject, err := a.authenticator.Authenticate(request)
+	if err != nil {
+		subject = authz.Anonymous
+	}
+	entry := audit.NewEntry(audit.ApiServer, audit.Actor{Name: subject.Name, Groups: subject.Groups}, op, resType, key, before, after)
+	entry.SourceIP = sourceIP(request)
+	a.auditLog.Record(entry)
+}
+
+func sourceIP(request *restful.Request) string {
+	host, _, err := net.SplitHostPort(request.Request.RemoteAddr)
+	if err != nil {
+		return request.Request.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,100 @@
+package api_server_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/api/mesh/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	"github.com/kumahq/kuma/pkg/api-server/authz"
+	"github.com/kumahq/kuma/pkg/audit"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Audit WS", func() {
+	var apiServer *api_server.ApiServer
+	var client resourceApiClient
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = createTestApiServer(store.NewPaginationStore(memory.NewStore()), config.DefaultApiServerConfig(), true, metrics)
+		client = resourceApiClient{
+			apiServer.Address(),
+			"/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	auditEntries := func() []audit.Entry {
+		resp, err := http.Get(fmt.Sprintf("http://%s/audit", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(200))
+		var entries []audit.Entry
+		Expect(json.NewDecoder(resp.Body).Decode(&entries)).To(Succeed())
+		return entries
+	}
+
+	It("should record create, update and delete of the resource", func() {
+		// when
+		res := rest.Resource{
+			Meta: rest.ResourceMeta{Name: "mesh-1", Type: string(mesh.MeshType)},
+			Spec: &v1alpha1.Mesh{},
+		}
+		Expect(client.put(res).StatusCode).To(Equal(201))
+		res.Spec = &v1alpha1.Mesh{
+			Mtls: &v1alpha1.Mesh_Mtls{EnabledBackend: "ca-1", Backends: []*v1alpha1.CertificateAuthorityBackend{{Name: "ca-1", Type: "builtin"}}},
+		}
+		Expect(client.put(res).StatusCode).To(Equal(200))
+		Expect(client.delete("mesh-1").StatusCode).To(Equal(200))
+
+		// then
+		entries := auditEntries()
+		Expect(entries).To(HaveLen(3))
+		for _, entry := range entries {
+			Expect(entry.Origin).To(Equal(audit.ApiServer))
+			Expect(entry.Actor.Name).To(Equal(authz.LocalhostUser))
+			Expect(entry.SourceIP).To(Equal("127.0.0.1"))
+			Expect(entry.ResourceType).To(Equal(mesh.MeshType))
+			Expect(entry.Name).To(Equal("mesh-1"))
+		}
+		Expect(entries[0].Operation).To(Equal(audit.Create))
+		Expect(entries[0].Changes).To(BeEmpty())
+		Expect(entries[1].Operation).To(Equal(audit.Update))
+		Expect(entries[1].Changes).To(Equal([]audit.Change{
+			{Path: "mtls.backends[0].name", After: "ca-1"},
+			{Path: "mtls.backends[0].type", After: "builtin"},
+			{Path: "mtls.enabledBackend", After: "ca-1"},
+		}))
+		Expect(entries[2].Operation).To(Equal(audit.Delete))
+		Expect(entries[2].Changes).To(HaveLen(3))
+	})
+
+	It("should not record the failed change", func() {
+		// when
+		Expect(client.delete("non-existing").StatusCode).To(Equal(404))
+
+		// then
+		Expect(auditEntries()).To(BeEmpty())
+	})
+})
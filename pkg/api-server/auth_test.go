@@ -1,19 +1,26 @@
 package api_server_test
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"path/filepath"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/kumahq/kuma/pkg/api-server/authz"
 	config "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	"github.com/kumahq/kuma/pkg/tls"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 	http2 "github.com/kumahq/kuma/pkg/util/http"
 )
 
@@ -25,6 +32,7 @@ var _ = Describe("Auth test", func() {
 	var httpsPort uint32
 	var stop chan struct{}
 	var externalIP string
+	var userTokenIssuer issuer.UserTokenIssuer
 
 	BeforeEach(func() {
 		externalIP = getExternalIP()
@@ -32,6 +40,10 @@ var _ = Describe("Auth test", func() {
 		certPath, keyPath := createCertsForIP(externalIP)
 
 		resourceStore := memory.NewStore()
+		userTokenIssuer = issuer.NewUserTokenIssuer(manager.NewResourceManager(resourceStore))
+		signingKey, err := issuer.CreateUserTokenSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resourceStore.Create(context.Background(), signingKey, store.CreateBy(issuer.UserTokenSigningKeyResourceKey))).To(Succeed())
 		metrics, err := metrics.NewMetrics("Standalone")
 		Expect(err).ToNot(HaveOccurred())
 
@@ -126,6 +138,75 @@ var _ = Describe("Auth test", func() {
 		Expect(resp.Body.Close()).To(Succeed())
 		Expect(string(body)).To(Equal("Access Denied. To access this endpoint you need to do it either from the same machine or by configuring HTTPS on API Server and providing valid certificates"))
 	})
+
+	It("should be able to access admin endpoints from other machine using user token of the admin and HTTPS", func() {
+		// given
+		token, err := userTokenIssuer.Generate(issuer.UserIdentity{Name: "john", Groups: []string{authz.AdminGroup}}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequest("GET", fmt.Sprintf("https://%s:%d/secrets", externalIP, httpsPort), nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// when
+		resp, err := httpsClientWithoutCerts.Do(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(200))
+	})
+
+	It("should be able to generate user token using user token of the admin", func() {
+		// given
+		token, err := userTokenIssuer.Generate(issuer.UserIdentity{Name: "john", Groups: []string{authz.AdminGroup}}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequest("POST", fmt.Sprintf("https://%s:%d/tokens/user", externalIP, httpsPort), strings.NewReader(`{"name": "ci", "validFor": "1h"}`))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("content-type", "application/json")
+
+		// when
+		resp, err := httpsClientWithoutCerts.Do(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(200))
+	})
+
+	It("should block an access to admin endpoints using user token of the user that is not an admin", func() {
+		// given
+		token, err := userTokenIssuer.Generate(issuer.UserIdentity{Name: "john", Groups: []string{"team-a"}}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequest("GET", fmt.Sprintf("https://%s:%d/secrets", externalIP, httpsPort), nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// when
+		resp, err := httpsClientWithoutCerts.Do(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(403))
+	})
+
+	It("should block an access to admin endpoints from other machine using user token and HTTP", func() {
+		// given
+		token, err := userTokenIssuer.Generate(issuer.UserIdentity{Name: "john", Groups: []string{authz.AdminGroup}}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequest("GET", fmt.Sprintf("http://%s:%d/secrets", externalIP, httpPort), nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		// when
+		resp, err := http.DefaultClient.Do(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(401))
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+		Expect(string(body)).To(Equal("User Token can be sent only over HTTPS"))
+	})
 })
 
 // we need to autogenerate cert dynamically for the external IP so the HTTPS client can validate san
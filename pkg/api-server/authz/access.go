@@ -0,0 +1,164 @@
+package authz
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+)
+
+// AccessControl protects endpoints of the API Server.
+type AccessControl interface {
+	// Filter validates access to the endpoint before it is executed. The mesh is taken from the "mesh" path parameter.
+	Filter(action string, resourceType model.ResourceType, admin bool) restful.FilterFunction
+	// Validate validates access inside the endpoint. It is used when the action or the mesh is known only after reading the request.
+	// It returns AccessDeniedError when the access is not granted.
+	Validate(request *restful.Request, action string, resourceType model.ResourceType, mesh string, admin bool) error
+	// Subject returns the Subject of the request as seen by the AccessControl.
+	Subject(request *restful.Request) (Subject, error)
+}
+
+type AccessDeniedError struct {
+	Reason string
+}
+
+func (a *AccessDeniedError) Error() string {
+	return a.Reason
+}
+
+func IsAccessDeniedError(err error) bool {
+	_, ok := err.(*AccessDeniedError)
+	return ok
+}
+
+// UnauthenticatedError is returned when the credentials of the user are invalid.
+type UnauthenticatedError struct {
+	Reason string
+}
+
+func (u *UnauthenticatedError) Error() string {
+	return u.Reason
+}
+
+func IsUnauthenticatedError(err error) bool {
+	_, ok := err.(*UnauthenticatedError)
+	return ok
+}
+
+// NewAdminAccessControl returns AccessControl that protects only admin endpoints with AdminAuth. Other endpoints are not protected.
+func NewAdminAccessControl(adminAuth AdminAuth) AccessControl {
+	return &adminAccessControl{
+		adminAuth: adminAuth,
+	}
+}
+
+type adminAccessControl struct {
+	adminAuth AdminAuth
+}
+
+func (a *adminAccessControl) Filter(_ string, _ model.ResourceType, admin bool) restful.FilterFunction {
+	if admin {
+		return a.adminAuth.Validate
+	}
+	return NoAuth
+}
+
+func (a *adminAccessControl) Validate(request *restful.Request, _ string, _ model.ResourceType, _ string, admin bool) error {
+	if admin {
+		return a.adminAuth.validate(request)
+	}
+	return nil
+}
+
+// Subject returns the Subject of the User Token if it is sent. Otherwise, the request that passes AdminAuth is a request of the admin.
+func (a *adminAccessControl) Subject(request *restful.Request) (Subject, error) {
+	if token, ok := bearerToken(request); ok {
+		return authenticateUserToken(request, a.adminAuth.UserTokenValidator, token)
+	}
+	if err := a.adminAuth.validate(request); err != nil {
+		return Anonymous, nil
+	}
+	name := LocalhostUser
+	if request.Request.TLS != nil && len(request.Request.TLS.PeerCertificates) > 0 {
+		name = request.Request.TLS.PeerCertificates[0].Subject.CommonName
+	}
+	return Subject{
+		Name:   name,
+		Groups: []string{AdminGroup, AuthenticatedGroup},
+	}, nil
+}
+
+// NewRBACAccessControl returns AccessControl that authorizes every request with the Authorizer.
+func NewRBACAccessControl(authorizer Authorizer, authenticator Authenticator) AccessControl {
+	return &rbacAccessControl{
+		authorizer:    authorizer,
+		authenticator: authenticator,
+	}
+}
+
+type rbacAccessControl struct {
+	authorizer    Authorizer
+	authenticator Authenticator
+}
+
+func (r *rbacAccessControl) Filter(action string, resourceType model.ResourceType, admin bool) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		if err := r.Validate(request, action, resourceType, request.PathParameter("mesh"), admin); err != nil {
+			WriteAccessDenied(response, err)
+			return
+		}
+		chain.ProcessFilter(request, response)
+	}
+}
+
+func (r *rbacAccessControl) Validate(request *restful.Request, action string, resourceType model.ResourceType, mesh string, _ bool) error {
+	subject, err := r.authenticator.Authenticate(request)
+	if err != nil {
+		return err
+	}
+	allowed, err := r.authorizer.Authorize(request.Request.Context(), subject, action, resourceType, mesh)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		log.V(1).Info("access denied", "user", subject.Name, "groups", subject.Groups, "action", action, "type", resourceType, "mesh", mesh)
+		return &AccessDeniedError{Reason: accessDeniedReason(subject, action, resourceType, mesh)}
+	}
+	return nil
+}
+
+func (r *rbacAccessControl) Subject(request *restful.Request) (Subject, error) {
+	return r.authenticator.Authenticate(request)
+}
+
+func accessDeniedReason(subject Subject, action string, resourceType model.ResourceType, mesh string) string {
+	if mesh == "" {
+		return fmt.Sprintf("user %q cannot %s %s", subject.Name, action, resourceType)
+	}
+	return fmt.Sprintf("user %q cannot %s %s in mesh %q", subject.Name, action, resourceType, mesh)
+}
+
+// WriteAccessDenied writes the error returned by AccessControl.
+func WriteAccessDenied(response *restful.Response, err error) {
+	status := http.StatusForbidden
+	kumaErr := types.Error{
+		Title:   "Access Denied",
+		Details: err.Error(),
+	}
+	switch {
+	case IsAccessDeniedError(err):
+	case IsUnauthenticatedError(err):
+		status = http.StatusUnauthorized
+		kumaErr.Title = "Unauthenticated"
+	default:
+		log.Error(err, "could not authorize the request")
+		status = http.StatusInternalServerError
+		kumaErr.Details = "Internal Server Error"
+	}
+	if err := response.WriteHeaderAndJson(status, kumaErr, "application/json"); err != nil {
+		log.Error(err, "could not write the response")
+	}
+}
@@ -1,46 +1,67 @@
 package authz
 
 import (
-	"net"
+	"net/http"
 
 	"github.com/emicklei/go-restful"
 
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
 var log = core.Log.WithName("api-server").WithName("auth")
 
+const adminAccessDeniedMessage = "Access Denied. To access this endpoint you need to do it either from the same machine or by configuring HTTPS on API Server and providing valid certificates"
+
 // AdminAuth validates that the client can access admin endpoints (like Secrets or Dataplane Token)
 // You can access the endpoint in two cases
 // 1) Request originates from localhost. We assume that if someone has an access to VM/Pod with server, they can do whatever they want. This is also for better UX
 // 2) Request originates from outside of localhost but client certs are configured for HTTPS.
 //    Client certs are essentially self signed CAs (generated by kumactl generate tls-certificate). For now we do not support SAN validation with the same CA that was used to sign server cert
+//
+// The request can be also authenticated with the User Token of a member of the admin group.
 type AdminAuth struct {
 	AllowFromLocalhost bool
+	// UserTokenValidator validates User Tokens. User Tokens are rejected when it's not set.
+	UserTokenValidator issuer.UserTokenValidator
 }
 
 func (a *AdminAuth) Validate(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
-	host, _, err := net.SplitHostPort(request.Request.RemoteAddr)
-	if err != nil {
-		log.Error(err, "could not parse Remote Address from the Request")
-		if err := response.WriteErrorString(500, "Internal Server Error"); err != nil {
+	if err := a.validate(request); err != nil {
+		status := http.StatusForbidden
+		if IsUnauthenticatedError(err) {
+			status = http.StatusUnauthorized
+		}
+		if err := response.WriteErrorString(status, err.Error()); err != nil {
 			log.Error(err, "could not write the response")
-			return
 		}
+		return
 	}
-	if host == "127.0.0.1" || host == "::1" {
+	chain.ProcessFilter(request, response)
+}
+
+func (a *AdminAuth) validate(request *restful.Request) error {
+	if token, ok := bearerToken(request); ok {
+		subject, err := authenticateUserToken(request, a.UserTokenValidator, token)
+		if err != nil {
+			return err
+		}
+		if subject.InGroup(AdminGroup) {
+			log.V(1).Info("passing the request because it was authenticated via user token of the admin", "user", subject.Name)
+			return nil
+		}
+		log.Info("attempt to access admin endpoints with user token of the user that is not an admin", "user", subject.Name)
+		return &AccessDeniedError{Reason: adminAccessDeniedMessage}
+	}
+	if isFromLocalhost(request) {
 		log.V(1).Info("passing the request because it originates from the same machine")
-		chain.ProcessFilter(request, response)
-		return
+		return nil
 	}
 	// Server uses tls.VerifyClientCertIfGiven therefore the verification of certs are done by server, here we only need to check if handshake if completed and there are any client certificates
 	if request.Request.TLS != nil && request.Request.TLS.HandshakeComplete && len(request.Request.TLS.PeerCertificates) > 0 {
 		log.V(1).Info("passing the request because it was authenticated via certificate")
-		chain.ProcessFilter(request, response)
-		return
+		return nil
 	}
 	log.Info("attempt to access admin endpoints from the outside of the same machine without allowed certificates")
-	if err := response.WriteErrorString(403, "Access Denied. To access this endpoint you need to do it either from the same machine or by configuring HTTPS on API Server and providing valid certificates"); err != nil {
-		log.Error(err, "could not write the response")
-	}
+	return &AccessDeniedError{Reason: adminAccessDeniedMessage}
 }
@@ -0,0 +1,127 @@
+package authz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+const (
+	// DataplaneTokenType is a type used in AccessRole to grant generating Dataplane Tokens.
+	DataplaneTokenType model.ResourceType = "DataplaneToken"
+	// UserTokenType is a type used in AccessRole to grant generating User Tokens.
+	UserTokenType model.ResourceType = "UserToken"
+	// ConfigType is a type used in AccessRole to grant reading the configuration of the Control Plane.
+	ConfigType model.ResourceType = "Config"
+)
+
+// Authorizer decides whether the subject is allowed to execute the action on the resource type in the mesh.
+// Empty mesh means all meshes or a global resource.
+type Authorizer interface {
+	Authorize(ctx context.Context, subject Subject, action string, resourceType model.ResourceType, mesh string) (bool, error)
+}
+
+// NewRoleAuthorizer returns Authorizer that grants access based on AccessRoles.
+// AccessRoles are cached for the given time, so changes of them take effect after at most this time.
+func NewRoleAuthorizer(resManager manager.ReadOnlyResourceManager, cacheExpirationTime time.Duration) Authorizer {
+	return &roleAuthorizer{
+		resManager:          resManager,
+		cacheExpirationTime: cacheExpirationTime,
+	}
+}
+
+type roleAuthorizer struct {
+	resManager          manager.ReadOnlyResourceManager
+	cacheExpirationTime time.Duration
+
+	sync.Mutex
+	roles      []*system.AccessRoleResource
+	expiration time.Time
+}
+
+func (r *roleAuthorizer) Authorize(ctx context.Context, subject Subject, action string, resourceType model.ResourceType, mesh string) (bool, error) {
+	roles, err := r.accessRoles(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		if !boundTo(role.Spec, subject) {
+			continue
+		}
+		for _, rule := range role.Spec.GetRules() {
+			if grants(rule, action, resourceType, mesh) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// accessRoles returns cached AccessRoles. Only one request lists them when the cache expires, the rest waits for the result.
+func (r *roleAuthorizer) accessRoles(ctx context.Context) ([]*system.AccessRoleResource, error) {
+	r.Lock()
+	defer r.Unlock()
+	now := core.Now()
+	if now.Before(r.expiration) {
+		return r.roles, nil
+	}
+	roles := &system.AccessRoleResourceList{}
+	if err := r.resManager.List(ctx, roles); err != nil {
+		return nil, err
+	}
+	r.roles = roles.Items
+	r.expiration = now.Add(r.cacheExpirationTime)
+	return r.roles, nil
+}
+
+func boundTo(role *system_proto.AccessRole, subject Subject) bool {
+	for _, s := range role.GetSubjects() {
+		if s.GetUser() != "" && s.GetUser() == subject.Name {
+			return true
+		}
+		for _, group := range subject.Groups {
+			if s.GetGroup() != "" && s.GetGroup() == group {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func grants(rule *system_proto.AccessRole_Rule, action string, resourceType model.ResourceType, mesh string) bool {
+	return matches(rule.GetActions(), action) &&
+		matches(rule.GetTypes(), string(resourceType)) &&
+		matchesMesh(rule.GetMeshes(), mesh)
+}
+
+func matches(values []string, value string) bool {
+	for _, v := range values {
+		if v == system.AccessRoleWildcard || v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesMesh checks if the rule applies to the mesh. Rule without meshes applies to all meshes.
+// Access to global resources or to resources in all meshes (empty mesh) is granted only by rules that apply to all meshes.
+func matchesMesh(meshes []string, mesh string) bool {
+	if len(meshes) == 0 {
+		return true
+	}
+	for _, m := range meshes {
+		if m == system.AccessRoleWildcard {
+			return true
+		}
+		if mesh != "" && m == mesh {
+			return true
+		}
+	}
+	return false
+}
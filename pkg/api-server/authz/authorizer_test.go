@@ -0,0 +1,162 @@
+package authz_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/authz"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Role Authorizer", func() {
+
+	var authorizer authz.Authorizer
+	var resManager manager.ResourceManager
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		roles := map[string]*system_proto.AccessRole{
+			"admin": {
+				Rules: []*system_proto.AccessRole_Rule{
+					{Actions: []string{"*"}, Types: []string{"*"}},
+				},
+				Subjects: []*system_proto.AccessRole_Subject{
+					{Group: authz.AdminGroup},
+				},
+			},
+			"demo-editor": {
+				Rules: []*system_proto.AccessRole_Rule{
+					{Actions: []string{"get", "list", "create", "update"}, Types: []string{"TrafficPermission"}, Meshes: []string{"demo"}},
+					{Actions: []string{"get", "list"}, Types: []string{"Mesh", "Dataplane"}},
+				},
+				Subjects: []*system_proto.AccessRole_Subject{
+					{User: "john"},
+					{Group: "editors"},
+				},
+			},
+		}
+		for name, spec := range roles {
+			err := resManager.Create(context.Background(), &system.AccessRoleResource{Spec: spec}, core_store.CreateByKey(name, model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		authorizer = authz.NewRoleAuthorizer(resManager, 0)
+	})
+
+	type testCase struct {
+		subject      authz.Subject
+		action       string
+		resourceType model.ResourceType
+		mesh         string
+		allowed      bool
+	}
+
+	table.DescribeTable("should authorize",
+		func(given testCase) {
+			// when
+			allowed, err := authorizer.Authorize(context.Background(), given.subject, given.action, given.resourceType, given.mesh)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(Equal(given.allowed))
+		},
+		table.Entry("admin group can do anything", testCase{
+			subject:      authz.Subject{Name: "alice", Groups: []string{authz.AdminGroup}},
+			action:       system.AccessRoleActionDelete,
+			resourceType: system.SecretType,
+			mesh:         "default",
+			allowed:      true,
+		}),
+		table.Entry("user can update the type in the granted mesh", testCase{
+			subject:      authz.Subject{Name: "john"},
+			action:       system.AccessRoleActionUpdate,
+			resourceType: core_mesh.TrafficPermissionType,
+			mesh:         "demo",
+			allowed:      true,
+		}),
+		table.Entry("group member can update the type in the granted mesh", testCase{
+			subject:      authz.Subject{Name: "bob", Groups: []string{"editors"}},
+			action:       system.AccessRoleActionCreate,
+			resourceType: core_mesh.TrafficPermissionType,
+			mesh:         "demo",
+			allowed:      true,
+		}),
+		table.Entry("user cannot update the type in other mesh", testCase{
+			subject:      authz.Subject{Name: "john"},
+			action:       system.AccessRoleActionUpdate,
+			resourceType: core_mesh.TrafficPermissionType,
+			mesh:         "default",
+			allowed:      false,
+		}),
+		table.Entry("user cannot list the type in all meshes when granted only one mesh", testCase{
+			subject:      authz.Subject{Name: "john"},
+			action:       system.AccessRoleActionList,
+			resourceType: core_mesh.TrafficPermissionType,
+			mesh:         "",
+			allowed:      false,
+		}),
+		table.Entry("user cannot execute not granted action", testCase{
+			subject:      authz.Subject{Name: "john"},
+			action:       system.AccessRoleActionDelete,
+			resourceType: core_mesh.TrafficPermissionType,
+			mesh:         "demo",
+			allowed:      false,
+		}),
+		table.Entry("user can list the type in all meshes", testCase{
+			subject:      authz.Subject{Name: "john"},
+			action:       system.AccessRoleActionList,
+			resourceType: core_mesh.DataplaneType,
+			mesh:         "",
+			allowed:      true,
+		}),
+		table.Entry("user without role is denied", testCase{
+			subject:      authz.Anonymous,
+			action:       system.AccessRoleActionGet,
+			resourceType: core_mesh.MeshType,
+			mesh:         "",
+			allowed:      false,
+		}),
+	)
+
+	It("should authorize with cached roles until the cache expires", func() {
+		// given
+		now := time.Now()
+		core.Now = func() time.Time {
+			return now
+		}
+		defer func() {
+			core.Now = time.Now
+		}()
+		authorizer = authz.NewRoleAuthorizer(resManager, time.Minute)
+		allowed, err := authorizer.Authorize(context.Background(), authz.Subject{Name: "john"}, system.AccessRoleActionGet, core_mesh.MeshType, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+
+		// when the role is removed
+		err = resManager.Delete(context.Background(), system.NewAccessRoleResource(), core_store.DeleteByKey("demo-editor", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then cached role is still used
+		allowed, err = authorizer.Authorize(context.Background(), authz.Subject{Name: "john"}, system.AccessRoleActionGet, core_mesh.MeshType, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+
+		// when the cache expires
+		now = now.Add(time.Minute)
+
+		// then the role is not used anymore
+		allowed, err = authorizer.Authorize(context.Background(), authz.Subject{Name: "john"}, system.AccessRoleActionGet, core_mesh.MeshType, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+})
@@ -0,0 +1,113 @@
+package authz
+
+import (
+	"net"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+const (
+	// AdminGroup is a group of users that are granted the default "admin" AccessRole.
+	// Requests originating from localhost belong to this group when they are allowed.
+	AdminGroup = "mesh-system:admin"
+	// AuthenticatedGroup is a group of all authenticated users.
+	AuthenticatedGroup = "mesh-system:authenticated"
+	// UnauthenticatedGroup is a group of users that are not authenticated.
+	UnauthenticatedGroup = "mesh-system:unauthenticated"
+	// AnonymousUser is a name of the user that is not authenticated.
+	AnonymousUser = "mesh-system:anonymous"
+	// LocalhostUser is a name of the user that sends requests from the same machine.
+	LocalhostUser = "mesh-system:localhost"
+)
+
+const bearerPrefix = "Bearer "
+
+// Subject is a user that sends a request to the API Server.
+type Subject struct {
+	Name   string
+	Groups []string
+}
+
+func (s Subject) InGroup(group string) bool {
+	for _, g := range s.Groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
+var Anonymous = Subject{
+	Name:   AnonymousUser,
+	Groups: []string{UnauthenticatedGroup},
+}
+
+// Authenticator returns a Subject of the request.
+// The user is identified by the User Token sent in the Authorization header. Otherwise, the user is identified
+// by Common Name of a client certificate and the groups by its Organizations.
+type Authenticator struct {
+	AllowFromLocalhost bool
+	// UserTokenValidator validates User Tokens. User Tokens are rejected when it's not set.
+	UserTokenValidator issuer.UserTokenValidator
+}
+
+func (a *Authenticator) Authenticate(request *restful.Request) (Subject, error) {
+	if token, ok := bearerToken(request); ok {
+		return authenticateUserToken(request, a.UserTokenValidator, token)
+	}
+	if a.AllowFromLocalhost && isFromLocalhost(request) {
+		return Subject{
+			Name:   LocalhostUser,
+			Groups: []string{AdminGroup, AuthenticatedGroup},
+		}, nil
+	}
+	// Server uses tls.VerifyClientCertIfGiven therefore the verification of certs are done by server
+	if request.Request.TLS != nil && request.Request.TLS.HandshakeComplete && len(request.Request.TLS.PeerCertificates) > 0 {
+		cert := request.Request.TLS.PeerCertificates[0]
+		groups := append([]string{}, cert.Subject.Organization...)
+		return Subject{
+			Name:   cert.Subject.CommonName,
+			Groups: append(groups, AuthenticatedGroup),
+		}, nil
+	}
+	return Anonymous, nil
+}
+
+func bearerToken(request *restful.Request) (string, bool) {
+	header := request.HeaderParameter("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix)), true
+}
+
+func authenticateUserToken(request *restful.Request, validator issuer.UserTokenValidator, token string) (Subject, error) {
+	if validator == nil {
+		return Subject{}, &UnauthenticatedError{Reason: "User Tokens are not supported"}
+	}
+	// the token is a credential therefore we don't accept it when it could have been sent in plain text over the network
+	if request.Request.TLS == nil && !isFromLocalhost(request) {
+		return Subject{}, &UnauthenticatedError{Reason: "User Token can be sent only over HTTPS"}
+	}
+	identity, err := validator.Validate(token)
+	if err != nil {
+		log.V(1).Info("invalid user token", "err", err)
+		return Subject{}, &UnauthenticatedError{Reason: "invalid User Token: " + err.Error()}
+	}
+	groups := append([]string{}, identity.Groups...)
+	return Subject{
+		Name:   identity.Name,
+		Groups: append(groups, AuthenticatedGroup),
+	}, nil
+}
+
+func isFromLocalhost(request *restful.Request) bool {
+	host, _, err := net.SplitHostPort(request.Request.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	return host == "127.0.0.1" || host == "::1"
+}
@@ -3,10 +3,12 @@ package api_server
 import (
 	"github.com/emicklei/go-restful"
 
+	"github.com/kumahq/kuma/pkg/api-server/authz"
 	"github.com/kumahq/kuma/pkg/config"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 )
 
-func configWs(cfg config.Config) (*restful.WebService, error) {
+func configWs(cfg config.Config, access authz.AccessControl) (*restful.WebService, error) {
 	cfgForDisplay, err := config.ConfigForDisplay(cfg)
 	if err != nil {
 		return nil, err
@@ -16,7 +18,7 @@ func configWs(cfg config.Config) (*restful.WebService, error) {
 		return nil, err
 	}
 	ws := new(restful.WebService).Path("/config")
-	ws.Route(ws.GET("").To(func(req *restful.Request, resp *restful.Response) {
+	ws.Route(ws.GET("").Filter(access.Filter(system.AccessRoleActionGet, authz.ConfigType, false)).To(func(req *restful.Request, resp *restful.Response) {
 		resp.AddHeader("content-type", "application/json")
 		if _, err := resp.Write(json); err != nil {
 			log.Error(err, "Could not write the index response")
@@ -53,6 +53,7 @@ var _ = Describe("Config WS", func() {
 		  "apiServer": {
 			"auth": {
 			  "allowFromLocalhost": true,
+			  "authorization": "admin",
 			  "clientCertsDir": "../../test/certs/client"
 			},
 			"corsAllowedDomains": [
@@ -72,6 +73,15 @@ var _ = Describe("Config WS", func() {
 			},
 			"readOnly": false
 		  },
+		  "audit": {
+			"bufferSize": 1000,
+			"enabled": true,
+			"file": ""
+		  },
+		  "caLifecycle": {
+			"expirationWarningThreshold": "720h0m0s",
+			"pollingInterval": "1h0m0s"
+		  },
 		  "bootstrapServer": {
 			"apiVersion": "v3",
 			"params": {
@@ -97,6 +107,7 @@ var _ = Describe("Config WS", func() {
 		  },
 		  "dpServer": {
 			"auth": {
+			  "dpTokenSigningMethod": "HS256",
 			  "type": ""
 			},
 			"hds": {
@@ -256,6 +267,7 @@ var _ = Describe("Config WS", func() {
             "tlsCertFile": "",
             "tlsKeyFile": "",
             "auth": {
+              "dpTokenSigningMethod": "HS256",
               "type": ""
             },
             "hds": {
@@ -297,6 +309,21 @@ var _ = Describe("Config WS", func() {
               "enabled": true,
               "expirationTime": "1s"
             },
+            "secretsEncryption": {
+              "keysFile": "",
+              "keyProvider": {
+                "type": "",
+                "dataKeyCacheTTL": "5m0s",
+                "plugin": {
+                  "url": "",
+                  "tokenFile": "",
+                  "caCertFile": "",
+                  "clientCertFile": "",
+                  "clientKeyFile": "",
+                  "requestTimeout": "5s"
+                }
+              }
+            },
             "upsert": {
               "conflictRetryBaseBackoff": "100ms",
               "conflictRetryMaxTimes": 5
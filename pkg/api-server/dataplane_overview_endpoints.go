@@ -12,7 +12,9 @@ import (
 	"github.com/emicklei/go-restful"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/authz"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
@@ -21,10 +23,12 @@ import (
 
 type dataplaneOverviewEndpoints struct {
 	resManager manager.ResourceManager
+	access     authz.AccessControl
 }
 
 func (r *dataplaneOverviewEndpoints) addFindEndpoint(ws *restful.WebService, pathPrefix string) {
 	ws.Route(ws.GET(pathPrefix+"/dataplanes+insights/{name}").To(r.inspectDataplane).
+		Filter(r.access.Filter(system.AccessRoleActionGet, mesh.DataplaneType, false)).
 		Doc("Inspect a dataplane").
 		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
 		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
@@ -34,6 +38,7 @@ func (r *dataplaneOverviewEndpoints) addFindEndpoint(ws *restful.WebService, pat
 
 func (r *dataplaneOverviewEndpoints) addListEndpoint(ws *restful.WebService, pathPrefix string) {
 	ws.Route(ws.GET(pathPrefix+"/dataplanes+insights").To(r.inspectDataplanes).
+		Filter(r.access.Filter(system.AccessRoleActionList, mesh.DataplaneType, false)).
 		Doc("Inspect all dataplanes").
 		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
 		Param(ws.QueryParameter("tag", "Tag to filter in key:value format").DataType("string")).
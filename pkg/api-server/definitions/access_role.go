@@ -0,0 +1,18 @@
+package definitions
+
+import (
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+var AccessRoleWsDefinition = ResourceWsDefinition{
+	Name: "AccessRole",
+	Path: "access-roles",
+	ResourceFactory: func() model.Resource {
+		return system.NewAccessRoleResource()
+	},
+	ResourceListFactory: func() model.ResourceList {
+		return &system.AccessRoleResourceList{}
+	},
+	Admin: true,
+}
@@ -24,6 +24,7 @@ var DefaultCRUDLEndpoints = []ResourceWsDefinition{
 	ZoneInsightWsDefinition,
 	SecretWsDefinition,
 	GlobalSecretWsDefinition,
+	AccessRoleWsDefinition,
 	RetryWsDefinition,
 	TimeoutWsDefinition,
 }
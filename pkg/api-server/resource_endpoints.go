@@ -2,23 +2,31 @@ package api_server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/kumahq/kuma/pkg/api-server/authz"
+	"github.com/kumahq/kuma/pkg/audit"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 
 	"github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/api-server/definitions"
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	rest_types "github.com/kumahq/kuma/pkg/core/rest/errors/types"
 	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/events"
 )
 
 const (
@@ -31,23 +39,25 @@ const (
 		" You can still use 'kumactl' or the HTTP API to modify the rest of the resource on the global control plane.\n"
 )
 
+// watchHeartbeatInterval is an interval of the comments sent on the idle watch, so proxies don't close the connection.
+const watchHeartbeatInterval = 30 * time.Second
+
 type resourceEndpoints struct {
 	mode       config_core.CpMode
 	resManager manager.ResourceManager
 	definitions.ResourceWsDefinition
-	adminAuth authz.AdminAuth
+	access  authz.AccessControl
+	auditor *resourceAuditor
+	watcher *resourceWatcher
 }
 
-func (r *resourceEndpoints) auth() restful.FilterFunction {
-	if r.ResourceWsDefinition.Admin {
-		return r.adminAuth.Validate
-	}
-	return authz.NoAuth
+func (r *resourceEndpoints) auth(action string) restful.FilterFunction {
+	return r.access.Filter(action, r.ResourceFactory().GetType(), r.ResourceWsDefinition.Admin)
 }
 
 func (r *resourceEndpoints) addFindEndpoint(ws *restful.WebService, pathPrefix string) {
 	ws.Route(ws.GET(pathPrefix+"/{name}").To(r.findResource).
-		Filter(r.auth()).
+		Filter(r.auth(system.AccessRoleActionGet)).
 		Doc(fmt.Sprintf("Get a %s", r.Name)).
 		Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.Name)).DataType("string")).
 		Returns(200, "OK", nil).
@@ -72,16 +82,37 @@ func (r *resourceEndpoints) findResource(request *restful.Request, response *res
 
 func (r *resourceEndpoints) addListEndpoint(ws *restful.WebService, pathPrefix string) {
 	ws.Route(ws.GET(pathPrefix).To(r.listResources).
-		Filter(r.auth()).
+		Filter(r.auth(system.AccessRoleActionList)).
 		Doc(fmt.Sprintf("List of %s", r.Name)).
 		Param(ws.PathParameter("size", "size of page").DataType("int")).
 		Param(ws.PathParameter("offset", "offset of page to list").DataType("string")).
+		Param(ws.QueryParameter("labelSelector", "list only resources with the labels, in the format key1=value1,key2=value2").DataType("string")).
+		Param(ws.QueryParameter("nameContains", "list only resources which name contains the value").DataType("string")).
+		Param(ws.QueryParameter("watch", "stream the changes of the resources as server-sent events").DataType("boolean")).
+		Param(ws.QueryParameter("resourceVersion", "resource version from which the watch is resumed").DataType("string")).
 		Returns(200, "OK", nil))
 }
 
 func (r *resourceEndpoints) listResources(request *restful.Request, response *restful.Response) {
 	meshName := r.meshFromRequest(request)
 
+	filters, err := listFilters(request)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve resources")
+		return
+	}
+
+	if request.QueryParameter("watch") == "true" {
+		if len(filters) != 0 {
+			var verr validators.ValidationError
+			verr.AddViolation("watch", "labelSelector and nameContains are not supported when watching resources")
+			rest_errors.HandleError(response, verr.OrNil(), "Could not watch resources")
+			return
+		}
+		r.watchResources(request, response, meshName)
+		return
+	}
+
 	page, err := pagination(request)
 	if err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve resources")
@@ -89,7 +120,8 @@ func (r *resourceEndpoints) listResources(request *restful.Request, response *re
 	}
 
 	list := r.ResourceListFactory()
-	if err := r.resManager.List(request.Request.Context(), list, store.ListByMesh(meshName), store.ListByPage(page.size, page.offset)); err != nil {
+	listOpts := append([]store.ListOptionsFunc{store.ListByMesh(meshName), store.ListByPage(page.size, page.offset)}, filters...)
+	if err := r.resManager.List(request.Request.Context(), list, listOpts...); err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve resources")
 	} else {
 		restList := rest.From.ResourceList(list)
@@ -106,8 +138,8 @@ func (r *resourceEndpoints) addCreateOrUpdateEndpoint(ws *restful.WebService, pa
 			Doc("Not allowed in read-only mode.").
 			Returns(http.StatusMethodNotAllowed, "Not allowed in read-only mode.", restful.ServiceError{}))
 	} else {
+		// access is validated in the endpoint, because the action depends on whether the resource exists
 		ws.Route(ws.PUT(pathPrefix+"/{name}").To(r.createOrUpdateResource).
-			Filter(r.auth()).
 			Doc(fmt.Sprintf("Updates a %s", r.Name)).
 			Param(ws.PathParameter("name", fmt.Sprintf("Name of the %s", r.Name)).DataType("string")).
 			Returns(200, "OK", nil).
@@ -136,30 +168,42 @@ func (r *resourceEndpoints) createOrUpdateResource(request *restful.Request, res
 	resource := r.ResourceFactory()
 	if err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName)); err != nil {
 		if store.IsResourceNotFound(err) {
-			r.createResource(request.Request.Context(), name, meshName, resourceRes.Spec, response)
+			if err := r.access.Validate(request, system.AccessRoleActionCreate, resource.GetType(), meshName, r.Admin); err != nil {
+				rest_errors.HandleError(response, err, "Could not create a resource")
+				return
+			}
+			r.createResource(request, name, meshName, resourceRes.Spec, resourceRes.Meta.Labels, response)
 		} else {
 			rest_errors.HandleError(response, err, "Could not find a resource")
 		}
 	} else {
-		r.updateResource(request.Request.Context(), resource, resourceRes, response)
+		if err := r.access.Validate(request, system.AccessRoleActionUpdate, resource.GetType(), meshName, r.Admin); err != nil {
+			rest_errors.HandleError(response, err, "Could not update a resource")
+			return
+		}
+		r.updateResource(request, resource, resourceRes, response)
 	}
 }
 
-func (r *resourceEndpoints) createResource(ctx context.Context, name string, meshName string, spec model.ResourceSpec, response *restful.Response) {
+func (r *resourceEndpoints) createResource(request *restful.Request, name string, meshName string, spec model.ResourceSpec, labels map[string]string, response *restful.Response) {
 	res := r.ResourceFactory()
 	_ = res.SetSpec(spec)
-	if err := r.resManager.Create(ctx, res, store.CreateByKey(name, meshName)); err != nil {
+	if err := r.resManager.Create(request.Request.Context(), res, store.CreateByKey(name, meshName), store.CreateWithLabels(labels)); err != nil {
 		rest_errors.HandleError(response, err, "Could not create a resource")
 	} else {
+		r.auditor.record(request, audit.Create, res.GetType(), model.ResourceKey{Name: name, Mesh: meshName}, nil, spec)
 		response.WriteHeader(201)
 	}
 }
 
-func (r *resourceEndpoints) updateResource(ctx context.Context, res model.Resource, restRes rest.Resource, response *restful.Response) {
+func (r *resourceEndpoints) updateResource(request *restful.Request, res model.Resource, restRes rest.Resource, response *restful.Response) {
+	before := res.GetSpec()
 	_ = res.SetSpec(restRes.Spec)
-	if err := r.resManager.Update(ctx, res); err != nil {
+	// labels are declarative like the spec, the labels missing in the request are removed
+	if err := r.resManager.Update(request.Request.Context(), res, store.UpdateWithLabels(restRes.Meta.Labels)); err != nil {
 		rest_errors.HandleError(response, err, "Could not update a resource")
 	} else {
+		r.auditor.record(request, audit.Update, res.GetType(), model.MetaToResourceKey(res.GetMeta()), before, restRes.Spec)
 		response.WriteHeader(200)
 	}
 }
@@ -178,7 +222,7 @@ func (r *resourceEndpoints) addDeleteEndpoint(ws *restful.WebService, pathPrefix
 			Returns(http.StatusMethodNotAllowed, "Not allowed in read-only mode.", restful.ServiceError{}))
 	} else {
 		ws.Route(ws.DELETE(pathPrefix+"/{name}").To(r.deleteResource).
-			Filter(r.auth()).
+			Filter(r.auth(system.AccessRoleActionDelete)).
 			Doc(fmt.Sprintf("Deletes a %s", r.Name)).
 			Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.Name)).DataType("string")).
 			Returns(200, "OK", nil))
@@ -189,10 +233,17 @@ func (r *resourceEndpoints) deleteResource(request *restful.Request, response *r
 	name := request.PathParameter("name")
 	meshName := r.meshFromRequest(request)
 
+	// the resource is retrieved first to record its spec in the audit log
 	resource := r.ResourceFactory()
+	if err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName)); err != nil {
+		rest_errors.HandleError(response, err, "Could not delete a resource")
+		return
+	}
 	if err := r.resManager.Delete(request.Request.Context(), resource, store.DeleteByKey(name, meshName)); err != nil {
 		rest_errors.HandleError(response, err, "Could not delete a resource")
+		return
 	}
+	r.auditor.record(request, audit.Delete, resource.GetType(), model.ResourceKey{Name: name, Mesh: meshName}, resource.GetSpec(), nil)
 }
 
 func (r *resourceEndpoints) deleteResourceReadOnly(request *restful.Request, response *restful.Response) {
@@ -217,9 +268,27 @@ func (r *resourceEndpoints) validateResourceRequest(request *restful.Request, re
 		err.AddViolation("mesh", "mesh from the URL has to be the same as in body")
 	}
 	err.AddError("", mesh.ValidateMeta(name, meshName, r.ResourceFactory().Scope()))
+	err.AddError("", mesh.ValidateLabels(resource.Meta.Labels))
 	return err.OrNil()
 }
 
+func listFilters(request *restful.Request) ([]store.ListOptionsFunc, error) {
+	var filters []store.ListOptionsFunc
+	if labelSelector := request.QueryParameter("labelSelector"); labelSelector != "" {
+		selector, err := store.ParseLabelSelector(labelSelector)
+		if err != nil {
+			var verr validators.ValidationError
+			verr.AddViolation("labelSelector", err.Error())
+			return nil, verr.OrNil()
+		}
+		filters = append(filters, store.ListByLabels(selector))
+	}
+	if nameContains := request.QueryParameter("nameContains"); nameContains != "" {
+		filters = append(filters, store.ListByNameContains(nameContains))
+	}
+	return filters, nil
+}
+
 func (r *resourceEndpoints) meshFromRequest(request *restful.Request) string {
 	if r.ResourceFactory().Scope() == model.ScopeMesh {
 		return request.PathParameter("mesh")
@@ -237,3 +306,158 @@ func (r *resourceEndpoints) readOnlyMessage() string {
 		return k8sReadOnlyMessage
 	}
 }
+
+// watchResources streams the changes of the resources as server-sent events.
+// Without resourceVersion, the stream starts with ADDED events for all existing resources.
+// The stream can be resumed with resourceVersion query parameter or Last-Event-ID header.
+func (r *resourceEndpoints) watchResources(request *restful.Request, response *restful.Response, meshName string) {
+	flusher, ok := response.ResponseWriter.(http.Flusher)
+	if !ok {
+		rest_errors.HandleError(response, errors.New("streaming is not supported"), "Could not watch resources")
+		return
+	}
+
+	resourceVersion := request.QueryParameter("resourceVersion")
+	if resourceVersion == "" {
+		resourceVersion = request.HeaderParameter("Last-Event-ID")
+	}
+	var version uint64
+	initialList := resourceVersion == ""
+	if initialList {
+		// the version is taken before listing, so no change is missed. The change done in between may be sent twice.
+		version = r.watcher.currentVersion()
+	} else {
+		v, err := strconv.ParseUint(resourceVersion, 10, 64)
+		if err != nil {
+			var verr validators.ValidationError
+			verr.AddViolation("resourceVersion", "must be a valid resource version")
+			rest_errors.HandleError(response, verr.OrNil(), "Could not watch resources")
+			return
+		}
+		version = v
+	}
+	changes, notify, err := r.watcher.since(r.ResourceFactory().GetType(), version)
+	if err != nil {
+		writeGone(response, err)
+		return
+	}
+
+	response.Header().Set("Content-Type", "text/event-stream")
+	response.Header().Set("Cache-Control", "no-cache")
+	response.WriteHeader(http.StatusOK)
+
+	ctx := request.Request.Context()
+	if initialList {
+		list := r.ResourceListFactory()
+		if err := r.resManager.List(ctx, list, store.ListByMesh(meshName)); err != nil {
+			writeWatchError(response, version, err)
+			return
+		}
+		for _, res := range list.GetItems() {
+			if err := writeWatchEvent(response, rest.WatchEvent{
+				Type:            rest.WatchEventAdded,
+				ResourceVersion: strconv.FormatUint(version, 10),
+				Object:          rest.From.Resource(res),
+			}); err != nil {
+				return
+			}
+		}
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(watchHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		for _, change := range changes {
+			version = change.version
+			if meshName != "" && change.key.Mesh != meshName {
+				continue
+			}
+			event, ok := r.watchEvent(ctx, change)
+			if !ok {
+				continue
+			}
+			if err := writeWatchEvent(response, event); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+
+		select {
+		case <-notify:
+		case <-heartbeat.C:
+			if _, err := response.Write([]byte(": heartbeat\n\n")); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-ctx.Done():
+			return
+		case <-r.watcher.done:
+			return
+		}
+		changes, notify, err = r.watcher.since(r.ResourceFactory().GetType(), version)
+		if err != nil {
+			writeWatchError(response, version, err)
+			return
+		}
+	}
+}
+
+func (r *resourceEndpoints) watchEvent(ctx context.Context, change resourceChange) (rest.WatchEvent, bool) {
+	event := rest.WatchEvent{
+		ResourceVersion: strconv.FormatUint(change.version, 10),
+	}
+	if change.operation == events.Delete {
+		event.Type = rest.WatchEventDeleted
+		event.Object = &rest.Resource{
+			Meta: rest.ResourceMeta{
+				Type: string(change.resType),
+				Mesh: change.key.Mesh,
+				Name: change.key.Name,
+			},
+		}
+		return event, true
+	}
+	event.Type = rest.WatchEventModified
+	if change.operation == events.Create {
+		event.Type = rest.WatchEventAdded
+	}
+	res := r.ResourceFactory()
+	if err := r.resManager.Get(ctx, res, store.GetBy(change.key)); err != nil {
+		// the resource was deleted in the meantime, DELETED event follows
+		if !store.IsResourceNotFound(err) {
+			log.Error(err, "could not retrieve the changed resource", "type", change.resType, "name", change.key.Name, "mesh", change.key.Mesh)
+		}
+		return rest.WatchEvent{}, false
+	}
+	event.Object = rest.From.Resource(res)
+	return event, true
+}
+
+func writeWatchEvent(response *restful.Response, event rest.WatchEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Error(err, "could not marshal the watch event")
+		return err
+	}
+	_, err = fmt.Fprintf(response, "id: %s\nevent: %s\ndata: %s\n\n", event.ResourceVersion, event.Type, data)
+	return err
+}
+
+func writeWatchError(response *restful.Response, version uint64, err error) {
+	_ = writeWatchEvent(response, rest.WatchEvent{
+		Type:            rest.WatchEventError,
+		ResourceVersion: strconv.FormatUint(version, 10),
+		Message:         err.Error(),
+	})
+}
+
+func writeGone(response *restful.Response, err error) {
+	kumaErr := rest_types.Error{
+		Title:   "Could not watch resources",
+		Details: err.Error(),
+	}
+	if err := response.WriteHeaderAndJson(http.StatusGone, kumaErr, "application/json"); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
@@ -331,6 +331,69 @@ var _ = Describe("Resource Endpoints", func() {
 			}
 			`))
 		})
+
+		It("should list resources by labels and name", func() {
+			// given
+			for name, team := range map[string]string{"payments-1": "payments", "payments-2": "payments", "orders-1": "orders"} {
+				resource := sample_model.TrafficRouteResource{
+					Spec: &sample_proto.TrafficRoute{
+						Path: "/sample-path",
+					},
+				}
+				err := resourceStore.Create(context.Background(), &resource, store.CreateByKey(name, mesh), store.CreateWithLabels(map[string]string{"team": team}))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// when
+			client.path = "/meshes/" + mesh + "/sample-traffic-routes?labelSelector=team%3Dpayments&nameContains=-2"
+			response := client.list()
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"total": 1,
+				"items": [
+					{
+						"type": "SampleTrafficRoute",
+						"name": "payments-2",
+						"mesh": "default",
+						"labels": {
+							"team": "payments"
+						},
+						"creationTime": "0001-01-01T00:00:00Z",
+						"modificationTime": "0001-01-01T00:00:00Z",
+						"path": "/sample-path"
+					}
+				],
+				"next": null
+			}`))
+		})
+
+		It("should return 400 on invalid label selector", func() {
+			// when
+			client.path = "/meshes/" + mesh + "/sample-traffic-routes?labelSelector=team"
+			response := client.list()
+
+			// then
+			Expect(response.StatusCode).To(Equal(400))
+			bytes, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(MatchJSON(`
+			{
+				"title": "Could not retrieve resources",
+				"details": "Resource is not valid",
+				"causes": [
+					{
+						"field": "labelSelector",
+						"message": "invalid requirement \"team\", expected format key=value"
+					}
+				]
+			}
+			`))
+		})
 	})
 
 	Describe("On PUT", func() {
@@ -380,6 +443,58 @@ var _ = Describe("Resource Endpoints", func() {
 			Expect(resource.Spec.Path).To(Equal("/update-sample-path"))
 		})
 
+		It("should replace labels of the resource", func() {
+			// given
+			name := "tr-1"
+			res := rest.Resource{
+				Meta: rest.ResourceMeta{
+					Name:   name,
+					Mesh:   mesh,
+					Type:   string(sample_model.TrafficRouteType),
+					Labels: map[string]string{"team": "payments", "env": "prod"},
+				},
+				Spec: &sample_proto.TrafficRoute{
+					Path: "/sample-path",
+				},
+			}
+			Expect(client.put(res).StatusCode).To(Equal(201))
+
+			// when
+			res.Meta.Labels = map[string]string{"team": "orders"}
+			response := client.put(res)
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			resource := sample_model.NewTrafficRouteResource()
+			err := resourceStore.Get(context.Background(), resource, store.GetByKey(name, mesh))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.Meta.GetLabels()).To(Equal(map[string]string{"team": "orders"}))
+		})
+
+		It("should return 400 on invalid labels", func() {
+			// given
+			json := `
+			{
+				"type": "SampleTrafficRoute",
+				"name": "tr-1",
+				"mesh": "default",
+				"labels": {
+					"team/": "payments"
+				},
+				"path": "/sample-path"
+			}
+			`
+
+			// when
+			response := client.putJson("tr-1", []byte(json))
+
+			// then
+			Expect(response.StatusCode).To(Equal(400))
+			respBytes, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(respBytes)).To(ContainSubstring(`"field": "labels[\"team/\"]"`))
+		})
+
 		It("should return 400 on the type in url that is different from request", func() {
 			// given
 			json := `
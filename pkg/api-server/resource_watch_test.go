@@ -0,0 +1,247 @@
+package api_server_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
+	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+type watchedEvent struct {
+	id        string
+	eventType string
+	event     rest.WatchEvent
+}
+
+var _ = Describe("Watch resources", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop chan struct{}
+	var cancels []context.CancelFunc
+
+	BeforeEach(func() {
+		cancels = nil
+		eventBus := events.NewEventBus()
+		resourceStore = memory.NewStore()
+		resourceStore.(interface{ SetEventWriter(events.Emitter) }).SetEventWriter(eventBus)
+		metrics, err := metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		apiServer = createTestApiServerWithEvents(resourceStore, config.DefaultApiServerConfig(), true, metrics, eventBus)
+		client := resourceApiClient{
+			apiServer.Address(),
+			"/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+		close(stop)
+	})
+
+	watch := func(path string) (*http.Response, <-chan watchedEvent) {
+		ctx, cancel := context.WithCancel(context.Background())
+		req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("http://%s%s", apiServer.Address(), path), nil)
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		cancels = append(cancels, cancel)
+
+		ch := make(chan watchedEvent, 100)
+		go func() {
+			defer close(ch)
+			scanner := bufio.NewScanner(resp.Body)
+			current := watchedEvent{}
+			for scanner.Scan() {
+				line := scanner.Text()
+				switch {
+				case strings.HasPrefix(line, "id: "):
+					current.id = strings.TrimPrefix(line, "id: ")
+				case strings.HasPrefix(line, "event: "):
+					current.eventType = strings.TrimPrefix(line, "event: ")
+				case strings.HasPrefix(line, "data: "):
+					event := rest.WatchEvent{}
+					if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event); err == nil {
+						current.event = event
+					}
+				case line == "" && current.eventType != "":
+					ch <- current
+					current = watchedEvent{}
+				}
+			}
+		}()
+		return resp, ch
+	}
+
+	next := func(ch <-chan watchedEvent) watchedEvent {
+		var event watchedEvent
+		Eventually(ch, "5s").Should(Receive(&event))
+		return event
+	}
+
+	createMesh := func(name string) {
+		err := resourceStore.Create(context.Background(), &mesh.MeshResource{Spec: &mesh_proto.Mesh{}}, store.CreateByKey(name, ""))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("should stream existing resources and the changes", func() {
+		// given
+		createMesh("mesh-1")
+
+		// when
+		resp, ch := watch("/meshes?watch=true")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(200))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+		event := next(ch)
+		Expect(event.eventType).To(Equal("ADDED"))
+		Expect(event.event.Object.Meta.Name).To(Equal("mesh-1"))
+
+		// when
+		createMesh("mesh-2")
+
+		// then
+		event = next(ch)
+		Expect(event.eventType).To(Equal("ADDED"))
+		Expect(event.event.Object.Meta.Name).To(Equal("mesh-2"))
+		Expect(event.id).To(Equal(event.event.ResourceVersion))
+
+		// when
+		res := mesh.NewMeshResource()
+		Expect(resourceStore.Get(context.Background(), res, store.GetByKey("mesh-2", ""))).To(Succeed())
+		res.Spec.Mtls = &mesh_proto.Mesh_Mtls{EnabledBackend: "ca-1"}
+		Expect(resourceStore.Update(context.Background(), res)).To(Succeed())
+
+		// then
+		event = next(ch)
+		Expect(event.eventType).To(Equal("MODIFIED"))
+		Expect(event.event.Object.Meta.Name).To(Equal("mesh-2"))
+
+		// when
+		Expect(resourceStore.Delete(context.Background(), mesh.NewMeshResource(), store.DeleteByKey("mesh-1", ""))).To(Succeed())
+
+		// then
+		event = next(ch)
+		Expect(event.eventType).To(Equal("DELETED"))
+		Expect(event.event.Object.Meta.Name).To(Equal("mesh-1"))
+	})
+
+	It("should resume the watch from the resource version", func() {
+		// given
+		_, ch := watch("/meshes?watch=true")
+		createMesh("mesh-1")
+		first := next(ch)
+		createMesh("mesh-2")
+		Expect(next(ch).event.Object.Meta.Name).To(Equal("mesh-2"))
+
+		// when
+		_, resumed := watch("/meshes?watch=true&resourceVersion=" + first.event.ResourceVersion)
+
+		// then
+		event := next(resumed)
+		Expect(event.eventType).To(Equal("ADDED"))
+		Expect(event.event.Object.Meta.Name).To(Equal("mesh-2"))
+		Consistently(resumed, "100ms").ShouldNot(Receive())
+	})
+
+	It("should resume the watch after many changes of other resource types", func() {
+		// given
+		_, ch := watch("/meshes?watch=true")
+		createMesh("mesh-1")
+		first := next(ch)
+
+		// when resources of other type change more often than the size of the history
+		for i := 0; i < 2001; i++ {
+			err := resourceStore.Create(context.Background(), &mesh.TrafficRouteResource{
+				Spec: &mesh_proto.TrafficRoute{},
+			}, store.CreateByKey(fmt.Sprintf("route-%d", i), "mesh-1"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		createMesh("mesh-2")
+		Expect(next(ch).event.Object.Meta.Name).To(Equal("mesh-2"))
+
+		// and the watch is resumed
+		resp, resumed := watch("/meshes?watch=true&resourceVersion=" + first.event.ResourceVersion)
+
+		// then
+		Expect(resp.StatusCode).To(Equal(200))
+		event := next(resumed)
+		Expect(event.eventType).To(Equal("ADDED"))
+		Expect(event.event.Object.Meta.Name).To(Equal("mesh-2"))
+		Consistently(resumed, "100ms").ShouldNot(Receive())
+	})
+
+	It("should stream only the resources of the mesh", func() {
+		// given
+		createMesh("mesh-1")
+		createMesh("mesh-2")
+		_, ch := watch("/meshes/mesh-1/traffic-routes?watch=true")
+
+		// when
+		for _, meshName := range []string{"mesh-2", "mesh-1"} {
+			err := resourceStore.Create(context.Background(), &mesh.TrafficRouteResource{
+				Spec: &mesh_proto.TrafficRoute{},
+			}, store.CreateByKey("route-1", meshName))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// then
+		event := next(ch)
+		Expect(event.event.Object.Meta.Mesh).To(Equal("mesh-1"))
+		Consistently(ch, "100ms").ShouldNot(Receive())
+	})
+
+	It("should return 410 when the resource version is unknown", func() {
+		// when
+		resp, _ := watch("/meshes?watch=true&resourceVersion=100")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(410))
+	})
+
+	It("should return 400 when the resource version is invalid", func() {
+		// when
+		resp, _ := watch("/meshes?watch=true&resourceVersion=abc")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(400))
+	})
+
+	It("should close the watch when the server stops", func() {
+		// given
+		_, ch := watch("/meshes?watch=true")
+
+		// when
+		time.Sleep(50 * time.Millisecond)
+		close(stop)
+		stop = make(chan struct{})
+
+		// then
+		Eventually(ch, "5s").Should(BeClosed())
+	})
+})
@@ -0,0 +1,127 @@
+package api_server
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/events"
+)
+
+// watchHistorySize is a number of the latest changes of a single resource type kept in memory, so the watch can be resumed from them.
+// The history is kept per resource type, so frequent changes of one type (e.g. DataplaneInsights) do not push out changes of others.
+const watchHistorySize = 1000
+
+var errResourceVersionTooOld = errors.New("resourceVersion is too old or was issued by other instance of the Control Plane. List the resources and start the watch again")
+
+type resourceChange struct {
+	version   uint64
+	operation events.Op
+	resType   model.ResourceType
+	key       model.ResourceKey
+}
+
+// resourceWatcher keeps the history of the changes of resources received from the event bus and numbers them
+// with the increasing version. The version is shared by all resource types and is local to the instance of the Control Plane.
+//
+// Watches don't subscribe to the event bus directly, so a slow client cannot block the delivery of the events to other components.
+type resourceWatcher struct {
+	sync.RWMutex
+	listenerFactory events.ListenerFactory
+	histories       map[model.ResourceType]*resourceHistory
+	version         uint64
+	done            chan struct{}
+}
+
+// resourceHistory is the history of the changes of a single resource type
+type resourceHistory struct {
+	changes []resourceChange
+	// truncatedVersion is the version of the latest change removed from the history.
+	// The watch can be resumed only from this version or a newer one.
+	truncatedVersion uint64
+	// notify is closed and replaced on every change of the resource type to wake up its watches
+	notify chan struct{}
+}
+
+func newResourceWatcher(listenerFactory events.ListenerFactory) *resourceWatcher {
+	return &resourceWatcher{
+		listenerFactory: listenerFactory,
+		histories:       map[model.ResourceType]*resourceHistory{},
+		done:            make(chan struct{}),
+	}
+}
+
+func (w *resourceWatcher) run(stop <-chan struct{}) {
+	defer close(w.done)
+	listener := w.listenerFactory.New()
+	for {
+		event, err := listener.Recv(stop)
+		if err != nil {
+			if err != events.ListenerStoppedErr {
+				log.Error(err, "could not receive the event, stopping the watches")
+			}
+			return
+		}
+		if changed, ok := event.(events.ResourceChangedEvent); ok {
+			w.add(changed)
+		}
+	}
+}
+
+func (w *resourceWatcher) add(event events.ResourceChangedEvent) {
+	w.Lock()
+	defer w.Unlock()
+	w.version++
+	history := w.history(event.Type)
+	history.changes = append(history.changes, resourceChange{
+		version:   w.version,
+		operation: event.Operation,
+		resType:   event.Type,
+		key:       event.Key,
+	})
+	if len(history.changes) > 2*watchHistorySize {
+		truncated := len(history.changes) - watchHistorySize
+		history.truncatedVersion = history.changes[truncated-1].version
+		history.changes = append([]resourceChange{}, history.changes[truncated:]...)
+	}
+	close(history.notify)
+	history.notify = make(chan struct{})
+}
+
+// history returns the history of the resource type. It has to be called with the lock held.
+func (w *resourceWatcher) history(resType model.ResourceType) *resourceHistory {
+	history, ok := w.histories[resType]
+	if !ok {
+		history = &resourceHistory{
+			notify: make(chan struct{}),
+		}
+		w.histories[resType] = history
+	}
+	return history
+}
+
+func (w *resourceWatcher) currentVersion() uint64 {
+	w.RLock()
+	defer w.RUnlock()
+	return w.version
+}
+
+// since returns the changes of the resource type after the version and the channel that is closed on the next change of the resource type.
+func (w *resourceWatcher) since(resType model.ResourceType, version uint64) ([]resourceChange, <-chan struct{}, error) {
+	w.Lock() // history of the resource type may be created
+	defer w.Unlock()
+	if version > w.version {
+		return nil, nil, errResourceVersionTooOld
+	}
+	history := w.history(resType)
+	if version < history.truncatedVersion {
+		return nil, nil, errResourceVersionTooOld
+	}
+	first := sort.Search(len(history.changes), func(i int) bool {
+		return history.changes[i].version > version
+	})
+	changes := append([]resourceChange{}, history.changes[first:]...)
+	return changes, history.notify, nil
+}
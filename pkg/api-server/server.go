@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kumahq/kuma/pkg/api-server/customization"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/kumahq/kuma/app/kuma-ui/pkg/resources"
 	"github.com/kumahq/kuma/pkg/api-server/authz"
 	"github.com/kumahq/kuma/pkg/api-server/definitions"
+	"github.com/kumahq/kuma/pkg/audit"
 	api_server "github.com/kumahq/kuma/pkg/config/api-server"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
@@ -33,6 +35,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/events"
 	"github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/tokens/builtin"
 	tokens_server "github.com/kumahq/kuma/pkg/tokens/builtin/server"
@@ -44,8 +47,9 @@ var (
 )
 
 type ApiServer struct {
-	mux    *http.ServeMux
-	config api_server.ApiServerConfig
+	mux     *http.ServeMux
+	config  api_server.ApiServerConfig
+	watcher *resourceWatcher
 }
 
 func (a *ApiServer) NeedLeaderElection() bool {
@@ -74,7 +78,7 @@ func init() {
 	}
 }
 
-func NewApiServer(resManager manager.ResourceManager, wsManager customization.APIInstaller, defs []definitions.ResourceWsDefinition, cfg *kuma_cp.Config, enableGUI bool, metrics metrics.Metrics) (*ApiServer, error) {
+func NewApiServer(resManager manager.ResourceManager, wsManager customization.APIInstaller, defs []definitions.ResourceWsDefinition, cfg *kuma_cp.Config, enableGUI bool, metrics metrics.Metrics, auditLog audit.Log, eventFactory events.ListenerFactory) (*ApiServer, error) {
 	serverConfig := cfg.ApiServer
 	container := restful.NewContainer()
 
@@ -101,13 +105,36 @@ func NewApiServer(resManager manager.ResourceManager, wsManager customization.AP
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
 
-	addResourcesEndpoints(ws, defs, resManager, cfg)
+	userTokenIssuer := builtin.NewUserTokenIssuer(resManager)
+	authenticator := authz.Authenticator{
+		AllowFromLocalhost: serverConfig.Auth.AllowFromLocalhost,
+		UserTokenValidator: userTokenIssuer,
+	}
+	var access authz.AccessControl
+	switch serverConfig.Auth.Authorization {
+	case api_server.RBACAuthorization:
+		access = authz.NewRBACAccessControl(authz.NewRoleAuthorizer(resManager, roleCacheExpirationTime(cfg)), authenticator)
+	default:
+		access = authz.NewAdminAccessControl(authz.AdminAuth{
+			AllowFromLocalhost: serverConfig.Auth.AllowFromLocalhost,
+			UserTokenValidator: userTokenIssuer,
+		})
+	}
+
+	auditor := &resourceAuditor{
+		authenticator: authenticator,
+		auditLog:      auditLog,
+	}
+	watcher := newResourceWatcher(eventFactory)
+	addResourcesEndpoints(ws, defs, resManager, cfg, access, auditor, watcher)
 	container.Add(ws)
 
+	// Index and versions endpoints are not protected, because they expose only versions of Kuma
+	// which clients (like kumactl) need to know before they authenticate.
 	if err := addIndexWsEndpoints(ws); err != nil {
 		return nil, errors.Wrap(err, "could not create index webservice")
 	}
-	configWs, err := configWs(cfg)
+	configWs, err := configWs(cfg, access)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create configuration webservice")
 	}
@@ -115,23 +142,27 @@ func NewApiServer(resManager manager.ResourceManager, wsManager customization.AP
 
 	container.Add(versionsWs())
 
-	zonesWs := zonesWs(resManager)
+	zonesWs := zonesWs(resManager, access)
 	container.Add(zonesWs)
 
+	container.Add(auditWs(auditLog, access))
+
 	container.Filter(cors.Filter)
 
 	newApiServer := &ApiServer{
-		mux:    container.ServeMux,
-		config: *serverConfig,
+		mux:     container.ServeMux,
+		config:  *serverConfig,
+		watcher: watcher,
 	}
 
-	dpWs, err := dataplaneTokenWs(resManager, cfg)
+	dpWs, err := dataplaneTokenWs(resManager, cfg, access)
 	if err != nil {
 		return nil, err
 	}
 	if dpWs != nil {
 		container.Add(dpWs)
 	}
+	container.Add(tokens_server.NewUserTokenWebservice(userTokenIssuer, access))
 
 	// Handle the GUI
 	if enableGUI {
@@ -145,10 +176,19 @@ func NewApiServer(resManager manager.ResourceManager, wsManager customization.AP
 	return newApiServer, nil
 }
 
-func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWsDefinition, resManager manager.ResourceManager, cfg *kuma_cp.Config) {
+// roleCacheExpirationTime returns for how long AccessRoles are cached. They are not cached when the store cache is disabled.
+func roleCacheExpirationTime(cfg *kuma_cp.Config) time.Duration {
+	if !cfg.Store.Cache.Enabled {
+		return 0
+	}
+	return cfg.Store.Cache.ExpirationTime
+}
+
+func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWsDefinition, resManager manager.ResourceManager, cfg *kuma_cp.Config, access authz.AccessControl, auditor *resourceAuditor, watcher *resourceWatcher) {
 	config := cfg.ApiServer
 	dpOverviewEndpoints := dataplaneOverviewEndpoints{
 		resManager: resManager,
+		access:     access,
 	}
 	dpOverviewEndpoints.addListEndpoint(ws, "/meshes/{mesh}")
 	dpOverviewEndpoints.addFindEndpoint(ws, "/meshes/{mesh}")
@@ -156,6 +196,7 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 
 	zoneOverviewEndpoints := zoneOverviewEndpoints{
 		resManager: resManager,
+		access:     access,
 	}
 	zoneOverviewEndpoints.addFindEndpoint(ws)
 	zoneOverviewEndpoints.addListEndpoint(ws)
@@ -165,9 +206,9 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 			mode:                 cfg.Mode,
 			resManager:           resManager,
 			ResourceWsDefinition: definitions.ServiceInsightWsDefinition,
-			adminAuth: authz.AdminAuth{
-				AllowFromLocalhost: cfg.ApiServer.Auth.AllowFromLocalhost,
-			},
+			access:               access,
+			auditor:              auditor,
+			watcher:              watcher,
 		},
 	}
 	serviceInsightEndpoints.addCreateOrUpdateEndpoint(ws, "/meshes/{mesh}/"+definitions.ServiceInsightWsDefinition.Path)
@@ -184,9 +225,9 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 			mode:                 cfg.Mode,
 			resManager:           resManager,
 			ResourceWsDefinition: definition,
-			adminAuth: authz.AdminAuth{
-				AllowFromLocalhost: cfg.ApiServer.Auth.AllowFromLocalhost,
-			},
+			access:               access,
+			auditor:              auditor,
+			watcher:              watcher,
 		}
 		switch definition.ResourceFactory().Scope() {
 		case model.ScopeMesh:
@@ -204,18 +245,19 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 	}
 }
 
-func dataplaneTokenWs(resManager manager.ResourceManager, cfg *kuma_cp.Config) (*restful.WebService, error) {
-	generator, err := builtin.NewDataplaneTokenIssuer(resManager)
+func dataplaneTokenWs(resManager manager.ResourceManager, cfg *kuma_cp.Config, access authz.AccessControl) (*restful.WebService, error) {
+	generator, err := builtin.NewDataplaneTokenIssuer(resManager, cfg.DpServer.Auth.DpTokenSigningMethod)
 	if err != nil {
 		return nil, err
 	}
-	adminAuth := authz.AdminAuth{AllowFromLocalhost: cfg.ApiServer.Auth.AllowFromLocalhost}
-	return tokens_server.NewWebservice(generator).Filter(adminAuth.Validate), nil
+	return tokens_server.NewWebservice(generator, access), nil
 }
 
 func (a *ApiServer) Start(stop <-chan struct{}) error {
 	errChan := make(chan error)
 
+	go a.watcher.run(stop)
+
 	var httpServer, httpsServer *http.Server
 	if a.config.HTTP.Enabled {
 		httpServer = a.startHttpServer(errChan)
@@ -350,7 +392,7 @@ func SetupServer(rt runtime.Runtime) error {
 			}
 		}
 	}
-	apiServer, err := NewApiServer(rt.ResourceManager(), rt.APIInstaller(), definitions.DefaultCRUDLEndpoints, &cfg, enableGUI, rt.Metrics())
+	apiServer, err := NewApiServer(rt.ResourceManager(), rt.APIInstaller(), definitions.DefaultCRUDLEndpoints, &cfg, enableGUI, rt.Metrics(), rt.AuditLog(), rt.EventReaderFactory())
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
@@ -22,7 +23,7 @@ type serviceInsightEndpoints struct {
 
 func (s *serviceInsightEndpoints) addFindEndpoint(ws *restful.WebService, pathPrefix string) {
 	ws.Route(ws.GET(pathPrefix+"/{service}").To(s.findResource).
-		Filter(s.auth()).
+		Filter(s.auth(system.AccessRoleActionGet)).
 		Doc(fmt.Sprintf("Get a %s", s.Name)).
 		Param(ws.PathParameter("service", fmt.Sprintf("Name of a %s", s.Name)).DataType("string")).
 		Returns(200, "OK", nil).
@@ -55,7 +56,7 @@ func (s *serviceInsightEndpoints) findResource(request *restful.Request, respons
 
 func (s *serviceInsightEndpoints) addListEndpoint(ws *restful.WebService, pathPrefix string) {
 	ws.Route(ws.GET(pathPrefix).To(s.listResources).
-		Filter(s.auth()).
+		Filter(s.auth(system.AccessRoleActionList)).
 		Doc(fmt.Sprintf("List of %s", s.Name)).
 		Param(ws.PathParameter("size", "size of page").DataType("int")).
 		Param(ws.PathParameter("offset", "offset of page to list").DataType("string")).
@@ -6,6 +6,7 @@ import (
 	"github.com/emicklei/go-restful"
 
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/authz"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
@@ -16,10 +17,12 @@ import (
 
 type zoneOverviewEndpoints struct {
 	resManager manager.ResourceManager
+	access     authz.AccessControl
 }
 
 func (r *zoneOverviewEndpoints) addFindEndpoint(ws *restful.WebService) {
 	ws.Route(ws.GET("/zones+insights/{name}").To(r.inspectZone).
+		Filter(r.access.Filter(system.AccessRoleActionGet, system.ZoneType, false)).
 		Doc("Inspect a zone").
 		Param(ws.PathParameter("name", "Name of a zone").DataType("string")).
 		Returns(200, "OK", nil).
@@ -28,6 +31,7 @@ func (r *zoneOverviewEndpoints) addFindEndpoint(ws *restful.WebService) {
 
 func (r *zoneOverviewEndpoints) addListEndpoint(ws *restful.WebService) {
 	ws.Route(ws.GET("/zones+insights").To(r.inspectZones).
+		Filter(r.access.Filter(system.AccessRoleActionList, system.ZoneType, false)).
 		Doc("Inspect all zones").
 		Returns(200, "OK", nil))
 }
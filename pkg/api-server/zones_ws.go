@@ -5,6 +5,7 @@ import (
 
 	"github.com/emicklei/go-restful"
 
+	"github.com/kumahq/kuma/pkg/api-server/authz"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
@@ -17,9 +18,9 @@ type Zone struct {
 
 type Zones []Zone
 
-func zonesWs(resManager manager.ResourceManager) *restful.WebService {
+func zonesWs(resManager manager.ResourceManager, access authz.AccessControl) *restful.WebService {
 	ws := new(restful.WebService).Path("/status/zones")
-	return ws.Route(ws.GET("").To(func(request *restful.Request, response *restful.Response) {
+	return ws.Route(ws.GET("").Filter(access.Filter(system.AccessRoleActionList, system.ZoneType, false)).To(func(request *restful.Request, response *restful.Response) {
 		zoneOverviews, err := fetchOverviews(resManager, request.Request.Context())
 		if err != nil {
 			rest_errors.HandleError(response, err, "Could not retrieve a zone overview")
@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+var log = core.Log.WithName("audit")
+
+type Operation string
+
+const (
+	Create Operation = "create"
+	Update Operation = "update"
+	Delete Operation = "delete"
+)
+
+// Origin is a component of the Control Plane that changed the resource.
+type Origin string
+
+const (
+	ApiServer Origin = "api-server"
+	KDS       Origin = "kds"
+)
+
+// Actor is a user or a zone that changed the resource.
+type Actor struct {
+	Name   string   `json:"name"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// Entry is a record of a single change of the resource.
+type Entry struct {
+	Time         time.Time          `json:"time"`
+	Origin       Origin             `json:"origin"`
+	Actor        Actor              `json:"actor"`
+	SourceIP     string             `json:"sourceIP,omitempty"`
+	Operation    Operation          `json:"operation"`
+	ResourceType model.ResourceType `json:"type"`
+	Mesh         string             `json:"mesh,omitempty"`
+	Name         string             `json:"name"`
+	Changes      []Change           `json:"changes,omitempty"`
+}
+
+// Log records the changes of the resources.
+type Log interface {
+	Record(entry Entry)
+	// Entries returns the latest entries kept in memory, the oldest first.
+	Entries() []Entry
+}
+
+// ignoredTypes are the types that are changed by the Control Plane itself on every status update of dataplanes and zones.
+// Recording them would flood the audit log without telling anything about the changes done by the users.
+var ignoredTypes = map[model.ResourceType]bool{
+	mesh.DataplaneInsightType: true,
+	mesh.ServiceInsightType:   true,
+	mesh.MeshInsightType:      true,
+	system.ZoneInsightType:    true,
+}
+
+func IsAudited(resType model.ResourceType) bool {
+	return !ignoredTypes[resType]
+}
+
+// NewEntry returns the Entry of the change of the resource identified by the key.
+// The before spec is nil on create and the after spec is nil on delete.
+func NewEntry(origin Origin, actor Actor, op Operation, resType model.ResourceType, key model.ResourceKey, before, after model.ResourceSpec) Entry {
+	changes, err := Diff(before, after)
+	if err != nil {
+		log.Error(err, "could not compute the changes of the resource", "type", resType, "name", key.Name, "mesh", key.Mesh)
+	}
+	if resType == system.SecretType || resType == system.GlobalSecretType {
+		redact(changes)
+	}
+	return Entry{
+		Time:         core.Now(),
+		Origin:       origin,
+		Actor:        actor,
+		Operation:    op,
+		ResourceType: resType,
+		Mesh:         key.Mesh,
+		Name:         key.Name,
+		Changes:      changes,
+	}
+}
@@ -0,0 +1,18 @@
+package audit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	util_test "github.com/kumahq/kuma/pkg/util/test"
+)
+
+func TestAudit(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecsWithDefaultAndCustomReporters(t,
+		"Audit Suite",
+		[]Reporter{util_test.NewlineReporter{}})
+}
@@ -0,0 +1,167 @@
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/audit"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+var _ = Describe("Audit", func() {
+
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		core.Now = func() time.Time {
+			return now
+		}
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	Describe("NewEntry()", func() {
+		It("should compute the changes of the spec", func() {
+			// given
+			before := &mesh_proto.TrafficPermission{
+				Sources: []*mesh_proto.Selector{{
+					Match: map[string]string{"kuma.io/service": "web"},
+				}},
+				Destinations: []*mesh_proto.Selector{{
+					Match: map[string]string{"kuma.io/service": "backend"},
+				}},
+			}
+			after := &mesh_proto.TrafficPermission{
+				Sources: []*mesh_proto.Selector{{
+					Match: map[string]string{"kuma.io/service": "frontend"},
+				}},
+				Destinations: []*mesh_proto.Selector{{
+					Match: map[string]string{"kuma.io/service": "backend", "version": "2"},
+				}},
+			}
+
+			// when
+			entry := audit.NewEntry(audit.ApiServer, audit.Actor{Name: "john"}, audit.Update, "TrafficPermission", model.ResourceKey{Mesh: "default", Name: "tp-1"}, before, after)
+
+			// then
+			Expect(entry).To(Equal(audit.Entry{
+				Time:         now,
+				Origin:       audit.ApiServer,
+				Actor:        audit.Actor{Name: "john"},
+				Operation:    audit.Update,
+				ResourceType: "TrafficPermission",
+				Mesh:         "default",
+				Name:         "tp-1",
+				Changes: []audit.Change{
+					{Path: "destinations[0].match.version", After: "2"},
+					{Path: "sources[0].match.kuma.io/service", Before: "web", After: "frontend"},
+				},
+			}))
+		})
+
+		It("should compute the changes on create", func() {
+			// when
+			entry := audit.NewEntry(audit.KDS, audit.Actor{Name: "global"}, audit.Create, "Mesh", model.ResourceKey{Name: "default"}, nil, &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{EnabledBackend: "ca-1"},
+			})
+
+			// then
+			Expect(entry.Changes).To(Equal([]audit.Change{
+				{Path: "mtls.enabledBackend", After: "ca-1"},
+			}))
+		})
+
+		It("should redact the data of secrets", func() {
+			// given
+			before := &system_proto.Secret{Data: &wrappers.BytesValue{Value: []byte("old")}}
+			after := &system_proto.Secret{Data: &wrappers.BytesValue{Value: []byte("new")}}
+
+			// when
+			entry := audit.NewEntry(audit.ApiServer, audit.Actor{Name: "john"}, audit.Update, system.SecretType, model.ResourceKey{Mesh: "default", Name: "sec-1"}, before, after)
+
+			// then
+			Expect(entry.Changes).To(Equal([]audit.Change{
+				{Path: "data", Before: "*****", After: "*****"},
+			}))
+		})
+	})
+
+	Describe("Log", func() {
+		entry := func(name string) audit.Entry {
+			return audit.Entry{
+				Time:         time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				Origin:       audit.ApiServer,
+				Actor:        audit.Actor{Name: "john", Groups: []string{"team-a"}},
+				SourceIP:     "192.168.0.1",
+				Operation:    audit.Delete,
+				ResourceType: "Mesh",
+				Name:         name,
+			}
+		}
+
+		It("should keep only the latest entries", func() {
+			// given
+			log := audit.NewLog(2, nil)
+
+			// when
+			for i := 0; i < 5; i++ {
+				log.Record(entry(fmt.Sprintf("mesh-%d", i)))
+			}
+
+			// then
+			Expect(log.Entries()).To(Equal([]audit.Entry{entry("mesh-3"), entry("mesh-4")}))
+		})
+
+		It("should return all entries when the buffer is not full", func() {
+			// given
+			log := audit.NewLog(3, nil)
+
+			// when
+			log.Record(entry("mesh-1"))
+			log.Record(entry("mesh-2"))
+
+			// then
+			Expect(log.Entries()).To(Equal([]audit.Entry{entry("mesh-1"), entry("mesh-2")}))
+		})
+
+		It("should write the entries as JSON lines", func() {
+			// given
+			buf := &bytes.Buffer{}
+			log := audit.NewLog(0, buf)
+
+			// when
+			log.Record(entry("mesh-1"))
+			log.Record(entry("mesh-2"))
+
+			// then
+			lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+			Expect(lines).To(HaveLen(2))
+			Expect(string(lines[0])).To(MatchJSON(`{
+				"time": "2020-01-01T00:00:00Z",
+				"origin": "api-server",
+				"actor": {"name": "john", "groups": ["team-a"]},
+				"sourceIP": "192.168.0.1",
+				"operation": "delete",
+				"type": "Mesh",
+				"name": "mesh-1"
+			}`))
+			read := audit.Entry{}
+			Expect(json.Unmarshal(lines[1], &read)).To(Succeed())
+			Expect(read).To(Equal(entry("mesh-2")))
+			Expect(log.Entries()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const redactedValue = "*****"
+
+// Change is a change of a single field of the spec. The path is in the JSON form, e.g. "sources[0].match.kuma.io/service".
+type Change struct {
+	Path   string      `json:"path"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// Diff returns the changes of the fields between the specs sorted by the path. Any spec can be nil.
+func Diff(before, after model.ResourceSpec) ([]Change, error) {
+	beforeFields, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	for path, value := range beforeFields {
+		if afterValue, ok := afterFields[path]; !ok || !reflect.DeepEqual(value, afterValue) {
+			changes = append(changes, Change{Path: path, Before: value, After: afterValue})
+		}
+	}
+	for path, value := range afterFields {
+		if _, ok := beforeFields[path]; !ok {
+			changes = append(changes, Change{Path: path, After: value})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+func fields(spec model.ResourceSpec) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	if spec == nil || reflect.ValueOf(spec).IsNil() {
+		return result, nil
+	}
+	m, err := util_proto.ToMap(spec)
+	if err != nil {
+		return nil, err
+	}
+	flatten("", m, result)
+	return result, nil
+}
+
+func flatten(path string, value interface{}, result map[string]interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			nestedPath := key
+			if path != "" {
+				nestedPath = path + "." + key
+			}
+			flatten(nestedPath, nested, result)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			flatten(fmt.Sprintf("%s[%d]", path, i), nested, result)
+		}
+	default:
+		result[path] = v
+	}
+}
+
+func redact(changes []Change) {
+	for i := range changes {
+		if changes[i].Before != nil {
+			changes[i].Before = redactedValue
+		}
+		if changes[i].After != nil {
+			changes[i].After = redactedValue
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	audit_config "github.com/kumahq/kuma/pkg/config/audit"
+)
+
+// NewLog returns the Log that keeps the latest entries in memory and appends every entry to the writer as a JSON line.
+// The writer is optional.
+func NewLog(bufferSize int, writer io.Writer) Log {
+	return &bufferedLog{
+		buffer: make([]Entry, 0, bufferSize),
+		size:   bufferSize,
+		writer: writer,
+	}
+}
+
+// NewLogFromConfig returns the Log configured by AuditConfig. The file is opened for appending and stays open for
+// the lifetime of the Control Plane.
+func NewLogFromConfig(cfg *audit_config.AuditConfig) (Log, error) {
+	if !cfg.Enabled {
+		return NewNoopLog(), nil
+	}
+	var writer io.Writer
+	if cfg.File != "" {
+		file, err := os.OpenFile(cfg.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not open the audit log file %q", cfg.File)
+		}
+		writer = file
+	}
+	return NewLog(cfg.BufferSize, writer), nil
+}
+
+type bufferedLog struct {
+	sync.Mutex
+	buffer []Entry
+	size   int
+	next   int
+	writer io.Writer
+}
+
+func (l *bufferedLog) Record(entry Entry) {
+	l.Lock()
+	defer l.Unlock()
+	if l.size > 0 {
+		if len(l.buffer) < l.size {
+			l.buffer = append(l.buffer, entry)
+		} else {
+			l.buffer[l.next] = entry
+		}
+		l.next = (l.next + 1) % l.size
+	}
+	if l.writer != nil {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			log.Error(err, "could not marshal the audit log entry")
+			return
+		}
+		if _, err := l.writer.Write(append(line, '\n')); err != nil {
+			log.Error(err, "could not write the audit log entry")
+		}
+	}
+}
+
+func (l *bufferedLog) Entries() []Entry {
+	l.Lock()
+	defer l.Unlock()
+	entries := make([]Entry, 0, len(l.buffer))
+	if len(l.buffer) < l.size {
+		return append(entries, l.buffer...)
+	}
+	entries = append(entries, l.buffer[l.next:]...)
+	return append(entries, l.buffer[:l.next]...)
+}
+
+func NewNoopLog() Log {
+	return noopLog{}
+}
+
+type noopLog struct{}
+
+func (noopLog) Record(Entry) {
+}
+
+func (noopLog) Entries() []Entry {
+	return nil
+}
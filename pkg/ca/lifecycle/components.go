@@ -0,0 +1,24 @@
+package lifecycle
+
+import (
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime"
+)
+
+var (
+	log = core.Log.WithName("ca-lifecycle")
+)
+
+func Setup(rt runtime.Runtime) error {
+	caLifecycle, err := NewCaLifecycle(&Config{
+		ResourceManager:  rt.ResourceManager(),
+		CaManagers:       rt.CaManagers(),
+		Metrics:          rt.Metrics(),
+		Polling:          rt.Config().CaLifecycle.PollingInterval,
+		WarningThreshold: rt.Config().CaLifecycle.ExpirationWarningThreshold,
+	})
+	if err != nil {
+		return err
+	}
+	return rt.Add(caLifecycle)
+}
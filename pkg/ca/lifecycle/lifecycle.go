@@ -0,0 +1,442 @@
+package lifecycle
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/multierr"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const (
+	conflictRetryBaseBackoff = 100 * time.Millisecond
+	conflictRetryMaxTimes    = 5
+)
+
+var backendNameSuffixRE = regexp.MustCompile(`^(.*)-(\d+)$`)
+
+type Config struct {
+	ResourceManager  manager.ResourceManager
+	CaManagers       core_ca.Managers
+	Metrics          core_metrics.Metrics
+	Polling          time.Duration
+	WarningThreshold time.Duration
+}
+
+type caLifecycle struct {
+	rm               manager.ResourceManager
+	caManagers       core_ca.Managers
+	polling          time.Duration
+	warningThreshold time.Duration
+	expiration       *prometheus.GaugeVec
+}
+
+// NewCaLifecycle creates a new Component that periodically checks expiration of CA backends of all Meshes.
+//
+// Expiration times are exposed as metrics and stored in MeshInsight together with warnings about the enabled backend
+// which is about to expire. If the enabled backend supports automatic rotation, a successor backend is added to the Mesh
+// and enabled ahead of the expiration, the rest of the rotation is performed by the SDS server in the same way
+// as when the enabled backend is switched manually. The successor of the backend is recorded in MeshInsight.
+// Once no online Dataplane has a certificate issued by the previous backend, the previous backend is removed from the Mesh
+// together with its Secrets. Backends switched manually are never removed.
+func NewCaLifecycle(config *Config) (component.Component, error) {
+	expiration := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ca_cert_expiration_timestamp_seconds",
+		Help: "Expiration time of certificates of CA backends in seconds since the epoch",
+	}, []string{"mesh", "backend", "cert"})
+	if err := config.Metrics.Register(expiration); err != nil {
+		return nil, err
+	}
+	return &caLifecycle{
+		rm:               config.ResourceManager,
+		caManagers:       config.CaManagers,
+		polling:          config.Polling,
+		warningThreshold: config.WarningThreshold,
+		expiration:       expiration,
+	}, nil
+}
+
+func (l *caLifecycle) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(l.polling)
+	defer ticker.Stop()
+	log.Info("started")
+	for {
+		if err := l.check(context.Background()); err != nil {
+			log.Error(err, "unable to check CA backends")
+		}
+		select {
+		case <-ticker.C:
+		case <-stop:
+			log.Info("stopped")
+			return nil
+		}
+	}
+}
+
+func (l *caLifecycle) NeedLeaderElection() bool {
+	return true
+}
+
+// check checks CA backends of all Meshes
+func (l *caLifecycle) check(ctx context.Context) error {
+	meshes := &core_mesh.MeshResourceList{}
+	if err := l.rm.List(ctx, meshes); err != nil {
+		return err
+	}
+	// metrics of deleted Meshes and backends should not be reported anymore
+	l.expiration.Reset()
+	for _, mesh := range meshes.Items {
+		if err := l.checkMesh(ctx, mesh); err != nil {
+			log.Error(err, "unable to check CA backends", "mesh", mesh.GetMeta().GetName())
+			continue
+		}
+	}
+	return nil
+}
+
+func (l *caLifecycle) checkMesh(ctx context.Context, mesh *core_mesh.MeshResource) error {
+	meshName := mesh.GetMeta().GetName()
+	caBackends := map[string]*mesh_proto.MeshInsight_MTLS_CaBackend{}
+	if mesh.MTLSEnabled() {
+		successors, err := l.successors(ctx, meshName)
+		if err != nil {
+			return err
+		}
+		if err := l.removePredecessors(ctx, mesh, successors); err != nil {
+			log.Error(err, "unable to remove rotated CA backends", "mesh", meshName)
+		}
+		for _, backend := range mesh.Spec.GetMtls().GetBackends() {
+			caBackends[backend.Name] = l.checkBackend(ctx, meshName, backend)
+		}
+
+		// previous backends are replaced by the enabled one, therefore their expiration is not a concern
+		enabledBackend := mesh.GetEnabledCertificateAuthorityBackend()
+		if enabledBackend != nil {
+			state := caBackends[enabledBackend.Name]
+			state.Warnings = append(state.Warnings, l.expirationWarnings("root", state.GetRootExpirationTime())...)
+			state.Warnings = append(state.Warnings, l.expirationWarnings("intermediate", state.GetIntermediateExpirationTime())...)
+			rotated, err := l.autoRotate(ctx, mesh, enabledBackend, state)
+			if err != nil {
+				state.Warnings = append(state.Warnings, fmt.Sprintf("automatic rotation failed: %s", err))
+				log.Error(err, "unable to rotate CA", "mesh", meshName, "backend", enabledBackend.Name)
+			}
+			if rotated {
+				// state of the successor is reported in the next check
+				return nil
+			}
+		}
+	}
+
+	err := manager.Upsert(l.rm, model.ResourceKey{Mesh: model.NoMesh, Name: meshName}, core_mesh.NewMeshInsightResource(), func(resource model.Resource) {
+		insight := resource.(*core_mesh.MeshInsightResource)
+		if insight.Spec.MTLS == nil {
+			insight.Spec.MTLS = &mesh_proto.MeshInsight_MTLS{}
+		}
+		// successors are recorded only by the automatic rotation, so they have to be carried over
+		for name, state := range caBackends {
+			state.Successor = insight.Spec.MTLS.GetCaBackends()[name].GetSuccessor()
+		}
+		insight.Spec.MTLS.CaBackends = caBackends
+	}, manager.WithConflictRetry(conflictRetryBaseBackoff, conflictRetryMaxTimes)) // retry because MeshInsight is also updated by the resyncer
+	if err != nil && !manager.IsMeshNotFound(err) { // Mesh could have been deleted in the meantime
+		return err
+	}
+	return nil
+}
+
+func (l *caLifecycle) checkBackend(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) *mesh_proto.MeshInsight_MTLS_CaBackend {
+	state := &mesh_proto.MeshInsight_MTLS_CaBackend{}
+	caManager, exist := l.caManagers[backend.Type]
+	if !exist {
+		state.Warnings = append(state.Warnings, fmt.Sprintf("CA manager of type %q does not exist", backend.Type))
+		return state
+	}
+
+	rootCerts, err := caManager.GetRootCert(ctx, mesh, backend)
+	if err != nil {
+		state.Warnings = append(state.Warnings, fmt.Sprintf("could not load root certificate: %s", err))
+		return state
+	}
+	rootExpiration, err := earliestExpiration(rootCerts...)
+	if err != nil {
+		state.Warnings = append(state.Warnings, fmt.Sprintf("could not parse root certificate: %s", err))
+		return state
+	}
+	state.RootExpirationTime = util_proto.MustTimestampProto(rootExpiration)
+	l.expiration.WithLabelValues(mesh, backend.Name, "root").Set(float64(rootExpiration.Unix()))
+
+	getter, ok := caManager.(core_ca.IntermediateCertGetter)
+	if !ok {
+		return state
+	}
+	intermediateCert, err := getter.GetIntermediateCert(ctx, mesh, backend)
+	if err != nil {
+		state.Warnings = append(state.Warnings, fmt.Sprintf("could not load intermediate certificate: %s", err))
+		return state
+	}
+	if intermediateCert == nil {
+		return state
+	}
+	intermediateExpiration, err := earliestExpiration(intermediateCert)
+	if err != nil {
+		state.Warnings = append(state.Warnings, fmt.Sprintf("could not parse intermediate certificate: %s", err))
+		return state
+	}
+	state.IntermediateExpirationTime = util_proto.MustTimestampProto(intermediateExpiration)
+	l.expiration.WithLabelValues(mesh, backend.Name, "intermediate").Set(float64(intermediateExpiration.Unix()))
+	return state
+}
+
+func (l *caLifecycle) expirationWarnings(cert string, expirationTime *timestamp.Timestamp) []string {
+	if expirationTime == nil {
+		return nil
+	}
+	expiration, err := ptypes.Timestamp(expirationTime)
+	if err != nil {
+		return nil
+	}
+	now := core.Now()
+	switch {
+	case !now.Before(expiration):
+		return []string{fmt.Sprintf("%s certificate expired at %s", cert, expiration.UTC().Format(time.RFC3339))}
+	case expiration.Sub(now) < l.warningThreshold:
+		return []string{fmt.Sprintf("%s certificate expires at %s", cert, expiration.UTC().Format(time.RFC3339))}
+	default:
+		return nil
+	}
+}
+
+// autoRotate adds a successor of the backend to the Mesh and enables it if the backend is about to expire
+func (l *caLifecycle) autoRotate(ctx context.Context, mesh *core_mesh.MeshResource, backend *mesh_proto.CertificateAuthorityBackend, state *mesh_proto.MeshInsight_MTLS_CaBackend) (bool, error) {
+	rotator, ok := l.caManagers[backend.Type].(core_ca.AutoRotator)
+	if !ok || state.GetRootExpirationTime() == nil {
+		return false, nil
+	}
+	before, err := rotator.AutoRotationBefore(backend)
+	if err != nil {
+		return false, err
+	}
+	if before == 0 {
+		return false, nil
+	}
+	expiration := state.GetRootExpirationTime().AsTime()
+	if state.GetIntermediateExpirationTime() != nil && state.GetIntermediateExpirationTime().AsTime().Before(expiration) {
+		expiration = state.GetIntermediateExpirationTime().AsTime()
+	}
+	if expiration.Sub(core.Now()) >= before {
+		return false, nil
+	}
+
+	successor := proto.Clone(backend).(*mesh_proto.CertificateAuthorityBackend)
+	successor.Name = successorName(mesh.Spec.GetMtls().GetBackends(), backend.Name)
+	// the previous backend stays in the Mesh, so Dataplanes keep trusting it until all of them are switched to the successor
+	mesh.Spec.Mtls.Backends = append(mesh.Spec.Mtls.Backends, successor)
+	mesh.Spec.Mtls.EnabledBackend = successor.Name
+	if err := l.rm.Update(ctx, mesh); err != nil {
+		return false, errors.Wrapf(err, "could not enable successor backend %q", successor.Name)
+	}
+	log.Info("CA is about to expire, enabled a successor backend", "mesh", mesh.GetMeta().GetName(), "backend", backend.Name, "successor", successor.Name, "expiration", expiration)
+	if err := l.recordSuccessor(mesh.GetMeta().GetName(), backend.Name, successor.Name); err != nil {
+		// the previous backend is kept in the Mesh, which is safe, it just has to be removed manually
+		return true, errors.Wrapf(err, "could not record successor backend %q", successor.Name)
+	}
+	return true, nil
+}
+
+// recordSuccessor stores in MeshInsight that the backend was replaced by the successor during the automatic rotation
+func (l *caLifecycle) recordSuccessor(meshName string, backend string, successor string) error {
+	return manager.Upsert(l.rm, model.ResourceKey{Mesh: model.NoMesh, Name: meshName}, core_mesh.NewMeshInsightResource(), func(resource model.Resource) {
+		insight := resource.(*core_mesh.MeshInsightResource)
+		if insight.Spec.MTLS == nil {
+			insight.Spec.MTLS = &mesh_proto.MeshInsight_MTLS{}
+		}
+		if insight.Spec.MTLS.CaBackends == nil {
+			insight.Spec.MTLS.CaBackends = map[string]*mesh_proto.MeshInsight_MTLS_CaBackend{}
+		}
+		state, ok := insight.Spec.MTLS.CaBackends[backend]
+		if !ok {
+			state = &mesh_proto.MeshInsight_MTLS_CaBackend{}
+			insight.Spec.MTLS.CaBackends[backend] = state
+		}
+		state.Successor = successor
+	}, manager.WithConflictRetry(conflictRetryBaseBackoff, conflictRetryMaxTimes))
+}
+
+// successors returns successors of backends recorded in MeshInsight by the automatic rotation
+func (l *caLifecycle) successors(ctx context.Context, meshName string) (map[string]string, error) {
+	insight := core_mesh.NewMeshInsightResource()
+	if err := l.rm.Get(ctx, insight, store.GetByKey(meshName, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "could not retrieve mesh insight")
+	}
+	successors := map[string]string{}
+	for name, state := range insight.Spec.GetMTLS().GetCaBackends() {
+		if state.GetSuccessor() != "" {
+			successors[name] = state.GetSuccessor()
+		}
+	}
+	return successors, nil
+}
+
+// removePredecessors removes backends replaced by the automatic rotation of the enabled backend from the Mesh
+// together with their Secrets once no online Dataplane has a certificate issued by them
+func (l *caLifecycle) removePredecessors(ctx context.Context, mesh *core_mesh.MeshResource, successors map[string]string) error {
+	enabledBackend := mesh.GetEnabledCertificateAuthorityBackend()
+	if enabledBackend == nil {
+		return nil
+	}
+	var predecessors []*mesh_proto.CertificateAuthorityBackend
+	for _, backend := range mesh.Spec.GetMtls().GetBackends() {
+		if isPredecessor(backend.Name, enabledBackend.Name, successors) {
+			predecessors = append(predecessors, backend)
+		}
+	}
+	if len(predecessors) == 0 {
+		return nil
+	}
+
+	meshName := mesh.GetMeta().GetName()
+	insights := &core_mesh.DataplaneInsightResourceList{}
+	if err := l.rm.List(ctx, insights, store.ListByMesh(meshName)); err != nil {
+		return errors.Wrap(err, "could not retrieve dataplane insights")
+	}
+	// the same as in the SDS server, offline Dataplanes do not take part in the rotation
+	usedBackends := map[string]bool{}
+	for _, insight := range insights.Items {
+		if insight.Spec.IsOnline() {
+			usedBackends[insight.Spec.GetMTLS().GetIssuedBackend()] = true
+		}
+	}
+	var unused []*mesh_proto.CertificateAuthorityBackend
+	for _, backend := range predecessors {
+		if !usedBackends[backend.Name] {
+			unused = append(unused, backend)
+		}
+	}
+	if len(unused) == 0 {
+		return nil
+	}
+
+	var backends []*mesh_proto.CertificateAuthorityBackend
+	for _, backend := range mesh.Spec.GetMtls().GetBackends() {
+		if !containsBackend(unused, backend.Name) {
+			backends = append(backends, backend)
+		}
+	}
+	mesh.Spec.Mtls.Backends = backends
+	if err := l.rm.Update(ctx, mesh); err != nil {
+		return errors.Wrap(err, "could not remove rotated backends")
+	}
+
+	var errs error
+	for _, backend := range unused {
+		secrets, err := l.caManagers[backend.Type].UsedSecrets(meshName, backend)
+		if err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "could not get secrets of backend %q", backend.Name))
+			continue
+		}
+		for _, secret := range secrets {
+			err := l.rm.Delete(ctx, system.NewSecretResource(), store.DeleteByKey(secret, meshName))
+			if err != nil && !store.IsResourceNotFound(err) {
+				errs = multierr.Append(errs, errors.Wrapf(err, "could not delete secret %q of backend %q", secret, backend.Name))
+			}
+		}
+		log.Info("removed rotated CA backend that is no longer used by Dataplanes", "mesh", meshName, "backend", backend.Name, "enabledBackend", enabledBackend.Name)
+	}
+	return errs
+}
+
+// isPredecessor checks whether the backend was replaced by the enabled backend during the automatic rotation,
+// possibly through a chain of rotations, e.g. ca-1 -> ca-2 -> ca-3
+func isPredecessor(backend string, enabledBackend string, successors map[string]string) bool {
+	visited := map[string]bool{}
+	for current := backend; !visited[current]; current = successors[current] {
+		visited[current] = true
+		successor, ok := successors[current]
+		if !ok {
+			return false
+		}
+		if successor == enabledBackend {
+			return true
+		}
+	}
+	return false
+}
+
+func containsBackend(backends []*mesh_proto.CertificateAuthorityBackend, name string) bool {
+	for _, backend := range backends {
+		if backend.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// successorName generates a name of the successor of the backend by incrementing its numeric suffix, e.g. ca-1 -> ca-2
+func successorName(backends []*mesh_proto.CertificateAuthorityBackend, name string) string {
+	existing := map[string]bool{}
+	for _, backend := range backends {
+		existing[backend.Name] = true
+	}
+	prefix, n := name, 1
+	if matches := backendNameSuffixRE.FindStringSubmatch(name); matches != nil {
+		if suffix, err := strconv.Atoi(matches[2]); err == nil {
+			prefix, n = matches[1], suffix
+		}
+	}
+	for {
+		n++
+		if candidate := fmt.Sprintf("%s-%d", prefix, n); !existing[candidate] {
+			return candidate
+		}
+	}
+}
+
+// earliestExpiration returns the earliest expiration time of PEM encoded certificates
+func earliestExpiration(certs ...core_ca.Cert) (time.Time, error) {
+	var expiration time.Time
+	for _, cert := range certs {
+		rest := cert
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			parsed, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return time.Time{}, err
+			}
+			if expiration.IsZero() || parsed.NotAfter.Before(expiration) {
+				expiration = parsed.NotAfter
+			}
+		}
+	}
+	if expiration.IsZero() {
+		return time.Time{}, errors.New("no PEM encoded certificate found")
+	}
+	return expiration, nil
+}
@@ -0,0 +1,13 @@
+package lifecycle_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCaLifecycle(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CA Lifecycle Suite")
+}
@@ -0,0 +1,286 @@
+package lifecycle_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/ca/lifecycle"
+	"github.com/kumahq/kuma/pkg/core"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	mesh_managers "github.com/kumahq/kuma/pkg/core/managers/apis/mesh"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	secrets_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
+	secrets_store "github.com/kumahq/kuma/pkg/core/secrets/store"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	ca_builtin "github.com/kumahq/kuma/pkg/plugins/ca/builtin"
+	"github.com/kumahq/kuma/pkg/plugins/ca/builtin/config"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_metrics "github.com/kumahq/kuma/pkg/test/metrics"
+	test_resources "github.com/kumahq/kuma/pkg/test/resources"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("CA Lifecycle", func() {
+	var rm manager.ResourceManager
+	var caManagers core_ca.Managers
+	var metrics core_metrics.Metrics
+	var stop chan struct{}
+
+	now := time.Now()
+
+	BeforeEach(func() {
+		core.Now = time.Now
+
+		resStore := memory.NewStore()
+		caManagers = core_ca.Managers{}
+		secretManager := secrets_manager.NewSecretManager(secrets_store.NewSecretStore(resStore), cipher.None(), secrets_manager.NewSecretValidator(caManagers, resStore))
+		caManagers["builtin"] = ca_builtin.NewBuiltinCaManager(secretManager)
+		validator := mesh_managers.MeshValidator{CaManagers: caManagers, Store: resStore}
+		defaultManager := manager.NewResourceManager(resStore)
+		meshManager := mesh_managers.NewMeshManager(resStore, defaultManager, caManagers, test_resources.Global(), validator, issuer.SigningMethodHS256)
+		rm = manager.NewCustomizableResourceManager(defaultManager, map[model.ResourceType]manager.ResourceManager{
+			core_mesh.MeshType: meshManager,
+			system.SecretType:  secretManager,
+		})
+
+		var err error
+		metrics, err = core_metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		stop = make(chan struct{})
+	})
+
+	AfterEach(func() {
+		close(stop)
+		core.Now = time.Now
+	})
+
+	createMesh := func(cfg *config.BuiltinCertificateAuthorityConfig) {
+		mesh := &core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					EnabledBackend: "ca-1",
+					Backends: []*mesh_proto.CertificateAuthorityBackend{
+						{
+							Name: "ca-1",
+							Type: "builtin",
+							Conf: util_proto.MustToStruct(cfg),
+						},
+					},
+				},
+			},
+		}
+		err := rm.Create(context.Background(), mesh, store.CreateByKey("default", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	startLifecycle := func() {
+		caLifecycle, err := lifecycle.NewCaLifecycle(&lifecycle.Config{
+			ResourceManager:  rm,
+			CaManagers:       caManagers,
+			Metrics:          metrics,
+			Polling:          100 * time.Millisecond,
+			WarningThreshold: 30 * 24 * time.Hour,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			_ = caLifecycle.Start(stop)
+		}()
+	}
+
+	caBackendsOfInsight := func() map[string]*mesh_proto.MeshInsight_MTLS_CaBackend {
+		insight := core_mesh.NewMeshInsightResource()
+		if err := rm.Get(context.Background(), insight, store.GetByKey("default", model.NoMesh)); err != nil {
+			return nil
+		}
+		return insight.Spec.GetMTLS().GetCaBackends()
+	}
+
+	It("should report expiration of CA backends", func() {
+		// given
+		createMesh(&config.BuiltinCertificateAuthorityConfig{
+			CaCert: &config.BuiltinCertificateAuthorityConfig_CaCert{
+				Expiration: "1y",
+			},
+			IntermediateCert: &config.BuiltinCertificateAuthorityConfig_IntermediateCert{
+				Expiration: "60d",
+			},
+		})
+
+		// when
+		startLifecycle()
+
+		// then
+		Eventually(caBackendsOfInsight).Should(HaveKey("ca-1"))
+		state := caBackendsOfInsight()["ca-1"]
+		rootExpiration := state.RootExpirationTime.AsTime()
+		Expect(rootExpiration).To(BeTemporally("~", now.Add(365*24*time.Hour), time.Minute))
+		intermediateExpiration := state.IntermediateExpirationTime.AsTime()
+		Expect(intermediateExpiration).To(BeTemporally("~", now.Add(60*24*time.Hour), time.Minute))
+		Expect(state.Warnings).To(BeEmpty())
+
+		// and metrics are exposed
+		metric := test_metrics.FindMetric(metrics, "ca_cert_expiration_timestamp_seconds", "mesh", "default", "backend", "ca-1", "cert", "root")
+		Expect(metric).ToNot(BeNil())
+		Expect(metric.GetGauge().GetValue()).To(Equal(float64(rootExpiration.Unix())))
+		metric = test_metrics.FindMetric(metrics, "ca_cert_expiration_timestamp_seconds", "mesh", "default", "backend", "ca-1", "cert", "intermediate")
+		Expect(metric).ToNot(BeNil())
+		Expect(metric.GetGauge().GetValue()).To(Equal(float64(intermediateExpiration.Unix())))
+	})
+
+	It("should warn when the enabled backend is about to expire", func() {
+		// given
+		createMesh(&config.BuiltinCertificateAuthorityConfig{
+			CaCert: &config.BuiltinCertificateAuthorityConfig_CaCert{
+				Expiration: "20d",
+			},
+		})
+
+		// when
+		startLifecycle()
+
+		// then
+		Eventually(caBackendsOfInsight).Should(HaveKey("ca-1"))
+		Expect(caBackendsOfInsight()["ca-1"].Warnings).To(ConsistOf(HavePrefix("root certificate expires at ")))
+
+		// and the backend is not rotated when automatic rotation is disabled
+		mesh := core_mesh.NewMeshResource()
+		err := rm.Get(context.Background(), mesh, store.GetByKey("default", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mesh.Spec.Mtls.EnabledBackend).To(Equal("ca-1"))
+	})
+
+	setIssuedBackend := func(dataplane string, backend string) {
+		err := manager.Upsert(rm, model.ResourceKey{Mesh: "default", Name: dataplane}, core_mesh.NewDataplaneInsightResource(), func(resource model.Resource) {
+			insight := resource.(*core_mesh.DataplaneInsightResource)
+			insight.Spec.Subscriptions = []*mesh_proto.DiscoverySubscription{{
+				Id:          "1",
+				ConnectTime: util_proto.MustTimestampProto(now),
+			}}
+			insight.Spec.MTLS = &mesh_proto.DataplaneInsight_MTLS{
+				IssuedBackend:     backend,
+				SupportedBackends: []string{backend},
+			}
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	secretExists := func(name string) bool {
+		err := rm.Get(context.Background(), system.NewSecretResource(), store.GetByKey(name, "default"))
+		if store.IsResourceNotFound(err) {
+			return false
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return true
+	}
+
+	It("should rotate the enabled backend before it expires", func() {
+		// given
+		createMesh(&config.BuiltinCertificateAuthorityConfig{
+			IntermediateCert: &config.BuiltinCertificateAuthorityConfig_IntermediateCert{
+				Expiration: "60d",
+			},
+			AutoRotation: &config.BuiltinCertificateAuthorityConfig_AutoRotation{
+				Enabled: true,
+				Before:  "30d",
+			},
+		})
+		// and a Dataplane with a certificate issued by the backend
+		setIssuedBackend("dp-1", "ca-1")
+
+		// when intermediate CA expires in less than 30 days
+		core.Now = func() time.Time {
+			return now.Add(40 * 24 * time.Hour)
+		}
+		startLifecycle()
+
+		// then
+		mesh := core_mesh.NewMeshResource()
+		Eventually(func() (string, error) {
+			err := rm.Get(context.Background(), mesh, store.GetByKey("default", model.NoMesh))
+			return mesh.Spec.GetMtls().GetEnabledBackend(), err
+		}).Should(Equal("ca-2"))
+
+		// and the previous backend is still in the Mesh
+		Expect(mesh.Spec.Mtls.Backends).To(HaveLen(2))
+		Expect(mesh.Spec.Mtls.Backends[0].Name).To(Equal("ca-1"))
+		Expect(mesh.Spec.Mtls.Backends[1].Name).To(Equal("ca-2"))
+		Expect(mesh.Spec.Mtls.Backends[1].Conf).To(Equal(mesh.Spec.Mtls.Backends[0].Conf))
+
+		// and the successor is created and not expiring
+		Eventually(caBackendsOfInsight).Should(HaveKey("ca-2"))
+		Expect(caBackendsOfInsight()["ca-2"].Warnings).To(BeEmpty())
+		// and the successor is recorded
+		Expect(caBackendsOfInsight()["ca-1"].Successor).To(Equal("ca-2"))
+		Expect(secretExists("default.ca-builtin-cert-ca-1")).To(BeTrue())
+		Expect(secretExists("default.ca-builtin-intermediate-key-ca-1")).To(BeTrue())
+
+		// when the Dataplane is issued a certificate by the successor
+		setIssuedBackend("dp-1", "ca-2")
+
+		// then the previous backend is removed
+		Eventually(func() ([]*mesh_proto.CertificateAuthorityBackend, error) {
+			err := rm.Get(context.Background(), mesh, store.GetByKey("default", model.NoMesh))
+			return mesh.Spec.GetMtls().GetBackends(), err
+		}).Should(HaveLen(1))
+		Expect(mesh.Spec.Mtls.Backends[0].Name).To(Equal("ca-2"))
+		Eventually(caBackendsOfInsight).ShouldNot(HaveKey("ca-1"))
+
+		// and its secrets are deleted
+		Expect(secretExists("default.ca-builtin-cert-ca-1")).To(BeFalse())
+		Expect(secretExists("default.ca-builtin-intermediate-key-ca-1")).To(BeFalse())
+		Expect(secretExists("default.ca-builtin-cert-ca-2")).To(BeTrue())
+	})
+	It("should not remove backends that were switched manually", func() {
+		// given two user-defined backends with automatic rotation enabled
+		cfg := util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+			AutoRotation: &config.BuiltinCertificateAuthorityConfig_AutoRotation{
+				Enabled: true,
+				Before:  "30d",
+			},
+		})
+		mesh := &core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					EnabledBackend: "ca-1",
+					Backends: []*mesh_proto.CertificateAuthorityBackend{
+						{Name: "ca-1", Type: "builtin", Conf: cfg},
+						{Name: "ca-2", Type: "builtin", Conf: cfg},
+					},
+				},
+			},
+		}
+		err := rm.Create(context.Background(), mesh, store.CreateByKey("default", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		setIssuedBackend("dp-1", "ca-1")
+		startLifecycle()
+		Eventually(caBackendsOfInsight).Should(HaveKey("ca-2"))
+
+		// when the enabled backend is switched manually
+		Expect(rm.Get(context.Background(), mesh, store.GetByKey("default", model.NoMesh))).To(Succeed())
+		mesh.Spec.Mtls.EnabledBackend = "ca-2"
+		Expect(rm.Update(context.Background(), mesh)).To(Succeed())
+		// and the Dataplane is issued a certificate by the new backend
+		setIssuedBackend("dp-1", "ca-2")
+
+		// then the previous backend is not removed, because it was not replaced by the automatic rotation
+		Consistently(func() ([]*mesh_proto.CertificateAuthorityBackend, error) {
+			err := rm.Get(context.Background(), mesh, store.GetByKey("default", model.NoMesh))
+			return mesh.Spec.GetMtls().GetBackends(), err
+		}, "500ms", "50ms").Should(HaveLen(2))
+		Expect(caBackendsOfInsight()["ca-1"].Successor).To(BeEmpty())
+
+		// and its secrets are kept
+		Expect(secretExists("default.ca-builtin-cert-ca-1")).To(BeTrue())
+		Expect(secretExists("default.ca-builtin-key-ca-1")).To(BeTrue())
+	})
+})
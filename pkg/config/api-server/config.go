@@ -75,6 +75,23 @@ type ApiServerAuth struct {
 	ClientCertsDir string `yaml:"clientCertsDir" envconfig:"kuma_api_server_auth_client_certs_dir"`
 	// Allow requests that are originating from localhost
 	AllowFromLocalhost bool `yaml:"allowFromLocalhost" envconfig:"kuma_api_server_auth_allow_from_localhost"`
+	// Authorization mode. Either "admin" (only admin endpoints like Secrets or Dataplane Token are protected) or "rbac" (every request is authorized by AccessRoles).
+	// In both modes the index and versions endpoints are not protected, because they expose only versions of Kuma.
+	Authorization AuthorizationMode `yaml:"authorization" envconfig:"kuma_api_server_auth_authorization"`
+}
+
+type AuthorizationMode = string
+
+const (
+	AdminAuthorization AuthorizationMode = "admin"
+	RBACAuthorization  AuthorizationMode = "rbac"
+)
+
+func (a *ApiServerAuth) Validate() error {
+	if a.Authorization != AdminAuthorization && a.Authorization != RBACAuthorization {
+		return errors.Errorf("Authorization should be either %q or %q", AdminAuthorization, RBACAuthorization)
+	}
+	return nil
 }
 
 func (a *ApiServerConfig) Sanitize() {
@@ -87,6 +104,9 @@ func (a *ApiServerConfig) Validate() error {
 	if err := a.HTTPS.Validate(); err != nil {
 		return errors.Wrap(err, ".HTTP not valid")
 	}
+	if err := a.Auth.Validate(); err != nil {
+		return errors.Wrap(err, ".Auth not valid")
+	}
 	return nil
 }
 
@@ -109,6 +129,7 @@ func DefaultApiServerConfig() *ApiServerConfig {
 		Auth: ApiServerAuth{
 			ClientCertsDir:     "",
 			AllowFromLocalhost: true,
+			Authorization:      AdminAuthorization,
 		},
 	}
 }
@@ -7,6 +7,8 @@ import (
 
 	"github.com/kumahq/kuma/pkg/config"
 	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/config/audit"
+	ca_lifecycle "github.com/kumahq/kuma/pkg/config/ca-lifecycle"
 	"github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
 	"github.com/kumahq/kuma/pkg/config/diagnostics"
@@ -141,6 +143,10 @@ type Config struct {
 	Diagnostics *diagnostics.DiagnosticsConfig `yaml:"diagnostics,omitempty"`
 	// Dataplane Server configuration
 	DpServer *dp_server.DpServerConfig `yaml:"dpServer"`
+	// Audit log configuration
+	Audit *audit.AuditConfig `yaml:"audit,omitempty"`
+	// CA lifecycle configuration
+	CaLifecycle *ca_lifecycle.CaLifecycleConfig `yaml:"caLifecycle,omitempty"`
 }
 
 func (c *Config) Sanitize() {
@@ -158,6 +164,8 @@ func (c *Config) Sanitize() {
 	c.DNSServer.Sanitize()
 	c.Multizone.Sanitize()
 	c.Diagnostics.Sanitize()
+	c.Audit.Sanitize()
+	c.CaLifecycle.Sanitize()
 }
 
 func DefaultConfig() Config {
@@ -197,6 +205,8 @@ func DefaultConfig() Config {
 		Multizone:   multizone.DefaultMultizoneConfig(),
 		Diagnostics: diagnostics.DefaultDiagnosticsConfig(),
 		DpServer:    dp_server.DefaultDpServerConfig(),
+		Audit:       audit.DefaultAuditConfig(),
+		CaLifecycle: ca_lifecycle.DefaultCaLifecycleConfig(),
 	}
 }
 
@@ -278,6 +288,12 @@ func (c *Config) Validate() error {
 	if err := c.Diagnostics.Validate(); err != nil {
 		return errors.Wrap(err, "Diagnostics validation failed")
 	}
+	if err := c.Audit.Validate(); err != nil {
+		return errors.Wrap(err, "Audit validation failed")
+	}
+	if err := c.CaLifecycle.Validate(); err != nil {
+		return errors.Wrap(err, "CaLifecycle validation failed")
+	}
 	return nil
 }
 
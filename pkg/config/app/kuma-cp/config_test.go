@@ -0,0 +1,42 @@
+package kuma_cp_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/config/core/resources/store"
+)
+
+var _ = Describe("Config", func() {
+
+	Describe("Validate()", func() {
+		It("should reject keysFile with Kubernetes store", func() {
+			// given
+			cfg := kuma_cp.DefaultConfig()
+			cfg.Mode = config_core.Global
+			cfg.Store.Type = store.KubernetesStore
+			cfg.Store.SecretsEncryption.KeysFile = "/tmp/keys.yaml"
+
+			// when
+			err := cfg.Validate()
+
+			// then
+			Expect(err).To(MatchError("Store validation failed: SecretsEncryption.KeysFile cannot be used with Kubernetes store, Secrets are stored as Kubernetes Secrets"))
+		})
+
+		It("should accept keysFile with Postgres store", func() {
+			// given
+			cfg := kuma_cp.DefaultConfig()
+			cfg.Store.Type = store.PostgresStore
+			cfg.Store.SecretsEncryption.KeysFile = "/tmp/keys.yaml"
+
+			// when
+			err := cfg.Validate()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
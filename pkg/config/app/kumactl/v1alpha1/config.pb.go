@@ -339,6 +339,14 @@ type ControlPlaneCoordinates_ApiServer struct {
 	ClientKeyFile string `protobuf:"bytes,4,opt,name=client_key_file,json=clientKeyFile,proto3" json:"client_key_file,omitempty"`
 	// Headers to be added for communication with Kuma control plane
 	Headers []*ControlPlaneCoordinates_Headers `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty"`
+	// AuthType defines the type of the authentication to the control plane
+	// API server. Empty value means that client certificates are used (if
+	// any). "tokens" means that the user token is sent in the Authorization
+	// header.
+	AuthType string `protobuf:"bytes,6,opt,name=auth_type,json=authType,proto3" json:"auth_type,omitempty"`
+	// AuthConf defines the configuration of the authentication. For "tokens"
+	// it has to contain "token" key with the user token.
+	AuthConf map[string]string `protobuf:"bytes,7,rep,name=auth_conf,json=authConf,proto3" json:"auth_conf,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *ControlPlaneCoordinates_ApiServer) Reset() {
@@ -408,6 +416,20 @@ func (x *ControlPlaneCoordinates_ApiServer) GetHeaders() []*ControlPlaneCoordina
 	return nil
 }
 
+func (x *ControlPlaneCoordinates_ApiServer) GetAuthType() string {
+	if x != nil {
+		return x.AuthType
+	}
+	return ""
+}
+
+func (x *ControlPlaneCoordinates_ApiServer) GetAuthConf() map[string]string {
+	if x != nil {
+		return x.AuthConf
+	}
+	return nil
+}
+
 // Defaults defines default settings for a context.
 type Context_Defaults struct {
 	state         protoimpl.MessageState
@@ -421,7 +443,7 @@ type Context_Defaults struct {
 func (x *Context_Defaults) Reset() {
 	*x = Context_Defaults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_config_app_kumactl_v1alpha1_config_proto_msgTypes[6]
+		mi := &file_pkg_config_app_kumactl_v1alpha1_config_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -434,7 +456,7 @@ func (x *Context_Defaults) String() string {
 func (*Context_Defaults) ProtoMessage() {}
 
 func (x *Context_Defaults) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_config_app_kumactl_v1alpha1_config_proto_msgTypes[6]
+	mi := &file_pkg_config_app_kumactl_v1alpha1_config_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +509,7 @@ var file_pkg_config_app_kumactl_v1alpha1_config_proto_rawDesc = []byte{
 	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
 	0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x42, 0x08, 0xfa, 0x42,
 	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x73, 0x22, 0xa3, 0x03, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50,
+	0x74, 0x65, 0x73, 0x22, 0xe4, 0x04, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50,
 	0x6c, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12,
 	0x63, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x63, 0x74, 0x6c, 0x2e, 0x63, 0x6f,
@@ -498,7 +520,7 @@ var file_pkg_config_app_kumactl_v1alpha1_config_proto_rawDesc = []byte{
 	0x72, 0x76, 0x65, 0x72, 0x1a, 0x31, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
 	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xef, 0x01, 0x0a, 0x09, 0x41, 0x70, 0x69, 0x53,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xb0, 0x03, 0x0a, 0x09, 0x41, 0x70, 0x69, 0x53,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x88, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72,
 	0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c,
@@ -513,22 +535,34 @@ var file_pkg_config_app_kumactl_v1alpha1_config_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
 	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x43, 0x6f, 0x6f,
 	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x07, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x70, 0x6c,
-	0x61, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65,
-	0x12, 0x45, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x63, 0x74, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x08, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x1e, 0x0a, 0x08, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x42, 0x28, 0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d,
-	0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75,
+	0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x65, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x63, 0x74, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x41, 0x70, 0x69, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x1a, 0x3b, 0x0a,
+	0x0d, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbb, 0x01, 0x0a, 0x07, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x65, 0x12, 0x45, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x63, 0x74, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x08,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x1e, 0x0a, 0x08, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x42, 0x28, 0x5a, 0x26, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75,
+	0x6d, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -543,7 +577,7 @@ func file_pkg_config_app_kumactl_v1alpha1_config_proto_rawDescGZIP() []byte {
 	return file_pkg_config_app_kumactl_v1alpha1_config_proto_rawDescData
 }
 
-var file_pkg_config_app_kumactl_v1alpha1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pkg_config_app_kumactl_v1alpha1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_pkg_config_app_kumactl_v1alpha1_config_proto_goTypes = []interface{}{
 	(*Configuration)(nil),                     // 0: kumactl.config.v1alpha1.Configuration
 	(*ControlPlane)(nil),                      // 1: kumactl.config.v1alpha1.ControlPlane
@@ -551,20 +585,22 @@ var file_pkg_config_app_kumactl_v1alpha1_config_proto_goTypes = []interface{}{
 	(*Context)(nil),                           // 3: kumactl.config.v1alpha1.Context
 	(*ControlPlaneCoordinates_Headers)(nil),   // 4: kumactl.config.v1alpha1.ControlPlaneCoordinates.Headers
 	(*ControlPlaneCoordinates_ApiServer)(nil), // 5: kumactl.config.v1alpha1.ControlPlaneCoordinates.ApiServer
-	(*Context_Defaults)(nil),                  // 6: kumactl.config.v1alpha1.Context.Defaults
+	nil,                      // 6: kumactl.config.v1alpha1.ControlPlaneCoordinates.ApiServer.AuthConfEntry
+	(*Context_Defaults)(nil), // 7: kumactl.config.v1alpha1.Context.Defaults
 }
 var file_pkg_config_app_kumactl_v1alpha1_config_proto_depIdxs = []int32{
 	1, // 0: kumactl.config.v1alpha1.Configuration.control_planes:type_name -> kumactl.config.v1alpha1.ControlPlane
 	3, // 1: kumactl.config.v1alpha1.Configuration.contexts:type_name -> kumactl.config.v1alpha1.Context
 	2, // 2: kumactl.config.v1alpha1.ControlPlane.coordinates:type_name -> kumactl.config.v1alpha1.ControlPlaneCoordinates
 	5, // 3: kumactl.config.v1alpha1.ControlPlaneCoordinates.api_server:type_name -> kumactl.config.v1alpha1.ControlPlaneCoordinates.ApiServer
-	6, // 4: kumactl.config.v1alpha1.Context.defaults:type_name -> kumactl.config.v1alpha1.Context.Defaults
+	7, // 4: kumactl.config.v1alpha1.Context.defaults:type_name -> kumactl.config.v1alpha1.Context.Defaults
 	4, // 5: kumactl.config.v1alpha1.ControlPlaneCoordinates.ApiServer.headers:type_name -> kumactl.config.v1alpha1.ControlPlaneCoordinates.Headers
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	6, // 6: kumactl.config.v1alpha1.ControlPlaneCoordinates.ApiServer.auth_conf:type_name -> kumactl.config.v1alpha1.ControlPlaneCoordinates.ApiServer.AuthConfEntry
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_pkg_config_app_kumactl_v1alpha1_config_proto_init() }
@@ -645,7 +681,7 @@ func file_pkg_config_app_kumactl_v1alpha1_config_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_config_app_kumactl_v1alpha1_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_config_app_kumactl_v1alpha1_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Context_Defaults); i {
 			case 0:
 				return &v.state
@@ -664,7 +700,7 @@ func file_pkg_config_app_kumactl_v1alpha1_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_config_app_kumactl_v1alpha1_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
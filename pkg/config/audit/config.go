@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config"
+)
+
+// AuditConfig defines the audit log of the changes of the resources done through the API Server or applied by KDS.
+type AuditConfig struct {
+	// If true, the changes of the resources are recorded in the audit log
+	Enabled bool `yaml:"enabled" envconfig:"kuma_audit_enabled"`
+	// Path to the file to which the audit log is appended as JSON lines. If empty, the audit log is not written to the file.
+	File string `yaml:"file" envconfig:"kuma_audit_file"`
+	// Number of the latest audit log entries kept in memory of the Control Plane and exposed on the /audit endpoint of the API Server.
+	BufferSize int `yaml:"bufferSize" envconfig:"kuma_audit_buffer_size"`
+}
+
+var _ config.Config = &AuditConfig{}
+
+func (a *AuditConfig) Sanitize() {
+}
+
+func (a *AuditConfig) Validate() error {
+	if a.BufferSize < 0 {
+		return errors.New("BufferSize must not be negative")
+	}
+	return nil
+}
+
+func DefaultAuditConfig() *AuditConfig {
+	return &AuditConfig{
+		Enabled:    true,
+		File:       "",
+		BufferSize: 1000,
+	}
+}
@@ -0,0 +1,40 @@
+package ca_lifecycle
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config"
+)
+
+// CaLifecycleConfig defines how expiration of CA backends of Meshes is monitored.
+type CaLifecycleConfig struct {
+	// Interval between checks of the expiration of CA backends. Automatic rotation of CA backends and removal
+	// of the rotated CA backends that are no longer used by Dataplanes are performed in the same check.
+	PollingInterval time.Duration `yaml:"pollingInterval" envconfig:"kuma_ca_lifecycle_polling_interval"`
+	// How long before the expiration of the enabled CA backend a warning is reported in MeshInsight
+	ExpirationWarningThreshold time.Duration `yaml:"expirationWarningThreshold" envconfig:"kuma_ca_lifecycle_expiration_warning_threshold"`
+}
+
+var _ config.Config = &CaLifecycleConfig{}
+
+func (c *CaLifecycleConfig) Sanitize() {
+}
+
+func (c *CaLifecycleConfig) Validate() error {
+	if c.PollingInterval <= 0 {
+		return errors.New("PollingInterval must be positive")
+	}
+	if c.ExpirationWarningThreshold < 0 {
+		return errors.New("ExpirationWarningThreshold must not be negative")
+	}
+	return nil
+}
+
+func DefaultCaLifecycleConfig() *CaLifecycleConfig {
+	return &CaLifecycleConfig{
+		PollingInterval:            1 * time.Hour,
+		ExpirationWarningThreshold: 30 * 24 * time.Hour,
+	}
+}
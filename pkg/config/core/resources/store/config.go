@@ -1,6 +1,7 @@
 package store
 
 import (
+	"net/url"
 	"time"
 
 	"github.com/pkg/errors"
@@ -32,6 +33,8 @@ type StoreConfig struct {
 	Cache CacheStoreConfig `yaml:"cache"`
 	// Upsert configuration
 	Upsert UpsertConfig `yaml:"upsert"`
+	// SecretsEncryption configuration
+	SecretsEncryption SecretsEncryptionConfig `yaml:"secretsEncryption"`
 }
 
 func DefaultStoreConfig() *StoreConfig {
@@ -41,6 +44,16 @@ func DefaultStoreConfig() *StoreConfig {
 		Kubernetes: k8s.DefaultKubernetesStoreConfig(),
 		Cache:      DefaultCacheStoreConfig(),
 		Upsert:     DefaultUpsertConfig(),
+		SecretsEncryption: SecretsEncryptionConfig{
+			KeysFile: "",
+			KeyProvider: KeyProviderConfig{
+				Type:            NoKeyProvider,
+				DataKeyCacheTTL: 5 * time.Minute,
+				Plugin: PluginKeyProviderConfig{
+					RequestTimeout: 5 * time.Second,
+				},
+			},
+		},
 	}
 }
 
@@ -60,6 +73,9 @@ func (s *StoreConfig) Validate() error {
 		if err := s.Kubernetes.Validate(); err != nil {
 			return errors.Wrap(err, "Kubernetes validation failed")
 		}
+		if s.SecretsEncryption.KeysFile != "" {
+			return errors.New("SecretsEncryption.KeysFile cannot be used with Kubernetes store, Secrets are stored as Kubernetes Secrets")
+		}
 		return nil
 	case MemoryStore:
 		return nil
@@ -69,6 +85,9 @@ func (s *StoreConfig) Validate() error {
 	if err := s.Cache.Validate(); err != nil {
 		return errors.Wrap(err, "Cache validation failed")
 	}
+	if err := s.SecretsEncryption.Validate(); err != nil {
+		return errors.Wrap(err, "SecretsEncryption validation failed")
+	}
 	return nil
 }
 
@@ -118,3 +137,117 @@ func (u *UpsertConfig) Validate() error {
 }
 
 var _ config.Config = &UpsertConfig{}
+
+var _ config.Config = &SecretsEncryptionConfig{}
+
+type KeyProviderType = string
+
+const (
+	// NoKeyProvider disables envelope encryption. Secrets are encrypted directly with keys from KeysFile.
+	NoKeyProvider     KeyProviderType = ""
+	LocalKeyProvider  KeyProviderType = "local"
+	PluginKeyProvider KeyProviderType = "plugin"
+)
+
+// SecretsEncryptionConfig defines encryption at rest of Secrets and GlobalSecrets.
+// Encryption is applied only to "memory" and "postgres" store. Secrets on Kubernetes are stored as Kubernetes Secrets.
+type SecretsEncryptionConfig struct {
+	// Path to a YAML file with AES-256 keys used to encrypt Secrets. If empty, Secrets are stored in plain text.
+	KeysFile string `yaml:"keysFile" envconfig:"kuma_store_secrets_encryption_keys_file"`
+	// KeyProvider configures envelope encryption
+	KeyProvider KeyProviderConfig `yaml:"keyProvider"`
+}
+
+func (s *SecretsEncryptionConfig) Sanitize() {
+	s.KeyProvider.Sanitize()
+}
+
+func (s *SecretsEncryptionConfig) Validate() error {
+	if err := s.KeyProvider.Validate(); err != nil {
+		return errors.Wrap(err, "KeyProvider validation failed")
+	}
+	if s.KeyProvider.Type == LocalKeyProvider && s.KeysFile == "" {
+		return errors.New("KeysFile has to be defined when KeyProvider is local")
+	}
+	return nil
+}
+
+var _ config.Config = &KeyProviderConfig{}
+
+// KeyProviderConfig defines envelope encryption of Secrets.
+// Every Secret is encrypted with its own data key which is wrapped with a master key from the key provider.
+type KeyProviderConfig struct {
+	// Type of the key provider. Can be either "" (envelope encryption disabled), "local" (master keys from KeysFile) or "plugin"
+	Type KeyProviderType `yaml:"type" envconfig:"kuma_store_secrets_encryption_key_provider_type"`
+	// How long unwrapped data keys are kept in memory, so reading a Secret does not require a request to the plugin every time.
+	// Applies only to the "plugin" key provider. 0 disables caching.
+	DataKeyCacheTTL time.Duration `yaml:"dataKeyCacheTTL" envconfig:"kuma_store_secrets_encryption_key_provider_data_key_cache_ttl"`
+	// Plugin key provider configuration
+	Plugin PluginKeyProviderConfig `yaml:"plugin"`
+}
+
+func (k *KeyProviderConfig) Sanitize() {
+	k.Plugin.Sanitize()
+}
+
+func (k *KeyProviderConfig) Validate() error {
+	if k.DataKeyCacheTTL < 0 {
+		return errors.New("DataKeyCacheTTL must not be negative")
+	}
+	switch k.Type {
+	case NoKeyProvider, LocalKeyProvider:
+	case PluginKeyProvider:
+		if err := k.Plugin.Validate(); err != nil {
+			return errors.Wrap(err, "Plugin validation failed")
+		}
+	default:
+		return errors.Errorf("Type should be either %q, %q or %q", NoKeyProvider, LocalKeyProvider, PluginKeyProvider)
+	}
+	return nil
+}
+
+var _ config.Config = &PluginKeyProviderConfig{}
+
+// PluginKeyProviderConfig defines a key provider plugin - an HTTP or a gRPC server that wraps and unwraps data keys.
+type PluginKeyProviderConfig struct {
+	// URL of the key provider plugin. The scheme selects the transport: "http" or "https" for HTTP plugins, "grpc" or "grpcs" (TLS) for gRPC plugins
+	URL string `yaml:"url" envconfig:"kuma_store_secrets_encryption_key_provider_plugin_url"`
+	// Path to a file with the bearer token sent to the plugin
+	TokenFile string `yaml:"tokenFile" envconfig:"kuma_store_secrets_encryption_key_provider_plugin_token_file"`
+	// Path to CA certificate that is used to verify the plugin server certificate
+	CaCertFile string `yaml:"caCertFile" envconfig:"kuma_store_secrets_encryption_key_provider_plugin_ca_cert_file"`
+	// Path to client certificate used in mTLS connection with the plugin
+	ClientCertFile string `yaml:"clientCertFile" envconfig:"kuma_store_secrets_encryption_key_provider_plugin_client_cert_file"`
+	// Path to client key used in mTLS connection with the plugin
+	ClientKeyFile string `yaml:"clientKeyFile" envconfig:"kuma_store_secrets_encryption_key_provider_plugin_client_key_file"`
+	// Timeout of a single request to the plugin
+	RequestTimeout time.Duration `yaml:"requestTimeout" envconfig:"kuma_store_secrets_encryption_key_provider_plugin_request_timeout"`
+}
+
+func (p *PluginKeyProviderConfig) Sanitize() {
+}
+
+func (p *PluginKeyProviderConfig) Validate() error {
+	if p.URL == "" {
+		return errors.New("URL has to be defined")
+	}
+	pluginURL, err := url.Parse(p.URL)
+	if err != nil {
+		return errors.Wrap(err, "URL is invalid")
+	}
+	switch pluginURL.Scheme {
+	case "http", "https", "grpc", "grpcs":
+	default:
+		return errors.Errorf("URL has to have one of the schemes: %s", []string{"http", "https", "grpc", "grpcs"})
+	}
+	if (p.ClientCertFile == "") != (p.ClientKeyFile == "") {
+		return errors.New("both ClientCertFile and ClientKeyFile has to be specified")
+	}
+	if p.ClientCertFile != "" && p.CaCertFile == "" {
+		return errors.New("CaCertFile has to be defined when client certificate is used")
+	}
+	if p.RequestTimeout <= 0 {
+		return errors.New("RequestTimeout has to be greater than 0")
+	}
+	return nil
+}
@@ -32,17 +32,30 @@ const (
 	DpServerAuthNone                = "none"
 )
 
+const (
+	DpTokenSigningMethodHS256 = "HS256"
+	DpTokenSigningMethodRS256 = "RS256"
+	DpTokenSigningMethodES256 = "ES256"
+)
+
 // Authentication configuration for Dataplane Server
 type DpServerAuthConfig struct {
 	// Type of authentication. Available values: "serviceAccountToken", "dpToken", "none".
 	// If empty, autoconfigured based on the environment - "serviceAccountToken" on Kubernetes, "dpToken" on Universal.
 	Type string `yaml:"type" envconfig:"kuma_dp_server_auth_type"`
+	// DpTokenSigningMethod is a method used to sign new Dataplane Tokens. Available values: "HS256", "RS256", "ES256".
+	// With "RS256" and "ES256" only the Global CP keeps the private key and Zone CPs receive the public key to validate the tokens.
+	// "ES256" requires a signing key of ECDSA type.
+	DpTokenSigningMethod string `yaml:"dpTokenSigningMethod" envconfig:"kuma_dp_server_auth_dp_token_signing_method"`
 }
 
 func (a *DpServerAuthConfig) Validate() error {
 	if a.Type != "" && a.Type != DpServerAuthNone && a.Type != DpServerAuthDpToken && a.Type != DpServerAuthServiceAccountToken {
 		return errors.Errorf("Type is invalid. Available values are: %q, %q, %q", DpServerAuthDpToken, DpServerAuthServiceAccountToken, DpServerAuthNone)
 	}
+	if a.DpTokenSigningMethod != DpTokenSigningMethodHS256 && a.DpTokenSigningMethod != DpTokenSigningMethodRS256 && a.DpTokenSigningMethod != DpTokenSigningMethodES256 {
+		return errors.Errorf("DpTokenSigningMethod is invalid. Available values are: %q, %q, %q", DpTokenSigningMethodHS256, DpTokenSigningMethodRS256, DpTokenSigningMethodES256)
+	}
 	return nil
 }
 
@@ -63,7 +76,8 @@ func DefaultDpServerConfig() *DpServerConfig {
 	return &DpServerConfig{
 		Port: 5678,
 		Auth: DpServerAuthConfig{
-			Type: "", // autoconfigured from the environment
+			Type:                 "", // autoconfigured from the environment
+			DpTokenSigningMethod: DpTokenSigningMethodHS256,
 		},
 		Hds: DefaultHdsConfig(),
 	}
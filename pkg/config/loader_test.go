@@ -112,6 +112,15 @@ var _ = Describe("Config loader", func() {
 
 			Expect(cfg.Store.Upsert.ConflictRetryBaseBackoff).To(Equal(4 * time.Second))
 			Expect(cfg.Store.Upsert.ConflictRetryMaxTimes).To(Equal(uint(10)))
+			Expect(cfg.Store.SecretsEncryption.KeysFile).To(Equal("/tmp/keys.yaml"))
+			Expect(cfg.Store.SecretsEncryption.KeyProvider.Type).To(Equal("plugin"))
+			Expect(cfg.Store.SecretsEncryption.KeyProvider.DataKeyCacheTTL).To(Equal(2 * time.Minute))
+			Expect(cfg.Store.SecretsEncryption.KeyProvider.Plugin.URL).To(Equal("https://kms.local:8443"))
+			Expect(cfg.Store.SecretsEncryption.KeyProvider.Plugin.TokenFile).To(Equal("/tmp/token"))
+			Expect(cfg.Store.SecretsEncryption.KeyProvider.Plugin.CaCertFile).To(Equal("/tmp/ca.crt"))
+			Expect(cfg.Store.SecretsEncryption.KeyProvider.Plugin.ClientCertFile).To(Equal("/tmp/client.crt"))
+			Expect(cfg.Store.SecretsEncryption.KeyProvider.Plugin.ClientKeyFile).To(Equal("/tmp/client.key"))
+			Expect(cfg.Store.SecretsEncryption.KeyProvider.Plugin.RequestTimeout).To(Equal(3 * time.Second))
 
 			Expect(cfg.Store.Postgres.TLS.Mode).To(Equal(postgres.VerifyFull))
 			Expect(cfg.Store.Postgres.TLS.CertPath).To(Equal("/path/to/cert"))
@@ -128,6 +137,7 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.ApiServer.HTTPS.TlsCertFile).To(Equal("/cert"))
 			Expect(cfg.ApiServer.HTTPS.TlsKeyFile).To(Equal("/key"))
 			Expect(cfg.ApiServer.Auth.AllowFromLocalhost).To(Equal(false))
+			Expect(cfg.ApiServer.Auth.Authorization).To(Equal("rbac"))
 			Expect(cfg.ApiServer.Auth.ClientCertsDir).To(Equal("/certs"))
 			Expect(cfg.ApiServer.CorsAllowedDomains).To(Equal([]string{"https://kuma", "https://someapi"}))
 
@@ -226,6 +236,7 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.DpServer.TlsCertFile).To(Equal("/test/path"))
 			Expect(cfg.DpServer.TlsKeyFile).To(Equal("/test/path/key"))
 			Expect(cfg.DpServer.Auth.Type).To(Equal("dpToken"))
+			Expect(cfg.DpServer.Auth.DpTokenSigningMethod).To(Equal("RS256"))
 			Expect(cfg.DpServer.Port).To(Equal(9876))
 			Expect(cfg.DpServer.Hds.Enabled).To(BeFalse())
 			Expect(cfg.DpServer.Hds.Interval).To(Equal(11 * time.Second))
@@ -237,6 +248,13 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.DpServer.Hds.CheckDefaults.UnhealthyThreshold).To(Equal(uint32(9)))
 
 			Expect(cfg.SdsServer.DataplaneConfigurationRefreshInterval).To(Equal(11 * time.Second))
+
+			Expect(cfg.Audit.Enabled).To(BeFalse())
+			Expect(cfg.Audit.File).To(Equal("/var/log/kuma/audit.log"))
+			Expect(cfg.Audit.BufferSize).To(Equal(100))
+
+			Expect(cfg.CaLifecycle.PollingInterval).To(Equal(5 * time.Minute))
+			Expect(cfg.CaLifecycle.ExpirationWarningThreshold).To(Equal(48 * time.Hour))
 		},
 		Entry("from config file", testCase{
 			envVars: map[string]string{},
@@ -268,6 +286,18 @@ store:
   upsert:
     conflictRetryBaseBackoff: 4s
     conflictRetryMaxTimes: 10
+  secretsEncryption:
+    keysFile: /tmp/keys.yaml
+    keyProvider:
+      type: plugin
+      dataKeyCacheTTL: 2m
+      plugin:
+        url: https://kms.local:8443
+        tokenFile: /tmp/token
+        caCertFile: /tmp/ca.crt
+        clientCertFile: /tmp/client.crt
+        clientKeyFile: /tmp/client.key
+        requestTimeout: 3s
 bootstrapServer:
   apiVersion: v3
   params:
@@ -291,6 +321,7 @@ apiServer:
   auth:
     clientCertsDir: "/certs" # ENV: KUMA_API_SERVER_AUTH_CLIENT_CERTS_DIR
     allowFromLocalhost: false # ENV: KUMA_API_SERVER_AUTH_ALLOW_FROM_LOCALHOST
+    authorization: rbac
   readOnly: true
   corsAllowedDomains:
     - https://kuma
@@ -416,6 +447,7 @@ dpServer:
   port: 9876
   auth:
     type: dpToken
+    dpTokenSigningMethod: RS256
   hds:
     enabled: false
     interval: 11s
@@ -428,6 +460,13 @@ dpServer:
       unhealthyThreshold: 9
 sdsServer:
   dataplaneConfigurationRefreshInterval: 11s
+audit:
+  enabled: false
+  file: /var/log/kuma/audit.log
+  bufferSize: 100
+caLifecycle:
+  pollingInterval: 5m
+  expirationWarningThreshold: 48h
 `,
 		}),
 		Entry("from env variables", testCase{
@@ -460,6 +499,15 @@ sdsServer:
 				"KUMA_STORE_CACHE_EXPIRATION_TIME":                                                         "3s",
 				"KUMA_STORE_UPSERT_CONFLICT_RETRY_BASE_BACKOFF":                                            "4s",
 				"KUMA_STORE_UPSERT_CONFLICT_RETRY_MAX_TIMES":                                               "10",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEYS_FILE":                                                  "/tmp/keys.yaml",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEY_PROVIDER_TYPE":                                          "plugin",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEY_PROVIDER_DATA_KEY_CACHE_TTL":                            "2m",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEY_PROVIDER_PLUGIN_URL":                                    "https://kms.local:8443",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEY_PROVIDER_PLUGIN_TOKEN_FILE":                             "/tmp/token",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEY_PROVIDER_PLUGIN_CA_CERT_FILE":                           "/tmp/ca.crt",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEY_PROVIDER_PLUGIN_CLIENT_CERT_FILE":                       "/tmp/client.crt",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEY_PROVIDER_PLUGIN_CLIENT_KEY_FILE":                        "/tmp/client.key",
+				"KUMA_STORE_SECRETS_ENCRYPTION_KEY_PROVIDER_PLUGIN_REQUEST_TIMEOUT":                        "3s",
 				"KUMA_API_SERVER_READ_ONLY":                                                                "true",
 				"KUMA_API_SERVER_HTTP_PORT":                                                                "15681",
 				"KUMA_API_SERVER_HTTP_INTERFACE":                                                           "192.168.0.1",
@@ -471,6 +519,7 @@ sdsServer:
 				"KUMA_API_SERVER_HTTPS_TLS_KEY_FILE":                                                       "/key",
 				"KUMA_API_SERVER_AUTH_CLIENT_CERTS_DIR":                                                    "/certs",
 				"KUMA_API_SERVER_AUTH_ALLOW_FROM_LOCALHOST":                                                "false",
+				"KUMA_API_SERVER_AUTH_AUTHORIZATION":                                                       "rbac",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_GRPC_PORT":                                              "3333",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_PORT":                                                   "2222",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_DEFAULT_FETCH_TIMEOUT":                                  "45s",
@@ -550,6 +599,7 @@ sdsServer:
 				"KUMA_DP_SERVER_TLS_CERT_FILE":                                                             "/test/path",
 				"KUMA_DP_SERVER_TLS_KEY_FILE":                                                              "/test/path/key",
 				"KUMA_DP_SERVER_AUTH_TYPE":                                                                 "dpToken",
+				"KUMA_DP_SERVER_AUTH_DP_TOKEN_SIGNING_METHOD":                                              "RS256",
 				"KUMA_DP_SERVER_PORT":                                                                      "9876",
 				"KUMA_DP_SERVER_HDS_ENABLED":                                                               "false",
 				"KUMA_DP_SERVER_HDS_INTERVAL":                                                              "11s",
@@ -560,6 +610,11 @@ sdsServer:
 				"KUMA_DP_SERVER_HDS_CHECK_HEALTHY_THRESHOLD":                                               "8",
 				"KUMA_DP_SERVER_HDS_CHECK_UNHEALTHY_THRESHOLD":                                             "9",
 				"KUMA_SDS_SERVER_DATAPLANE_CONFIGURATION_REFRESH_INTERVAL":                                 "11s",
+				"KUMA_AUDIT_ENABLED":                                                                       "false",
+				"KUMA_AUDIT_FILE":                                                                          "/var/log/kuma/audit.log",
+				"KUMA_AUDIT_BUFFER_SIZE":                                                                   "100",
+				"KUMA_CA_LIFECYCLE_POLLING_INTERVAL":                                                       "5m",
+				"KUMA_CA_LIFECYCLE_EXPIRATION_WARNING_THRESHOLD":                                           "48h",
 			},
 			yamlFileConfig: "",
 		}),
@@ -20,6 +20,7 @@ import (
 
 	"github.com/kumahq/kuma/pkg/events"
 
+	"github.com/kumahq/kuma/pkg/audit"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
@@ -29,6 +30,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/managers/apis/dataplane"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/dataplaneinsight"
 	mesh_managers "github.com/kumahq/kuma/pkg/core/managers/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/managers/apis/ratelimit"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/zoneinsight"
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
@@ -40,9 +42,9 @@ import (
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
 	runtime_reports "github.com/kumahq/kuma/pkg/core/runtime/reports"
-	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	"github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
 func buildRuntime(cfg kuma_cp.Config, closeCh <-chan struct{}) (core_runtime.Runtime, error) {
@@ -98,7 +100,16 @@ func buildRuntime(cfg kuma_cp.Config, closeCh <-chan struct{}) (core_runtime.Run
 	builder.WithAPIManager(customization.NewAPIList())
 	builder.WithXDSHooks(&xds_hooks.Hooks{})
 	builder.WithDpServer(server.NewDpServer(*cfg.DpServer, builder.Metrics()))
-	builder.WithKDSContext(kds_context.DefaultContext(builder.ResourceManager(), cfg.Multizone.Zone.Name))
+	kdsContext := kds_context.DefaultContext(builder.ResourceManager(), cfg.Multizone.Zone.Name)
+	if issuer.IsAsymmetric(cfg.DpServer.Auth.DpTokenSigningMethod) {
+		kdsContext.GlobalResourceMapper = kds_context.PublicSigningKeysMapper
+	}
+	builder.WithKDSContext(kdsContext)
+	auditLog, err := audit.NewLogFromConfig(cfg.Audit)
+	if err != nil {
+		return nil, err
+	}
+	builder.WithAuditLog(auditLog)
 
 	if err := initializeAfterBootstrap(cfg, builder); err != nil {
 		return nil, err
@@ -293,12 +304,16 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 		CaManagers: builder.CaManagers(),
 		Store:      builder.ResourceStore(),
 	}
-	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), meshValidator)
+	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), meshValidator, builder.Config().DpServer.Auth.DpTokenSigningMethod)
 	customManagers[mesh.MeshType] = meshManager
 
 	dpManager := dataplane.NewDataplaneManager(builder.ResourceStore(), builder.Config().Multizone.Zone.Name)
 	customManagers[mesh.DataplaneType] = dpManager
 
+	rateLimitValidator := ratelimit.Validator{Store: builder.ResourceStore()}
+	rateLimitManager := ratelimit.NewRateLimitManager(builder.ResourceStore(), rateLimitValidator)
+	customManagers[mesh.RateLimitType] = rateLimitManager
+
 	dpInsightManager := dataplaneinsight.NewDataplaneInsightManager(builder.ResourceStore(), builder.Config().Metrics.Dataplane)
 	customManagers[mesh.DataplaneInsightType] = dpInsightManager
 
@@ -309,14 +324,9 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 	zoneInsightManager := zoneinsight.NewZoneInsightManager(builder.ResourceStore(), builder.Config().Metrics.Zone)
 	customManagers[system.ZoneInsightType] = zoneInsightManager
 
-	var cipher secret_cipher.Cipher
-	switch cfg.Store.Type {
-	case store.KubernetesStore:
-		cipher = secret_cipher.None() // deliberately turn encryption off on Kubernetes
-	case store.MemoryStore, store.PostgresStore:
-		cipher = secret_cipher.TODO() // get back to encryption in universal case
-	default:
-		return errors.Errorf("unknown store type %s", cfg.Store.Type)
+	cipher, err := SecretCipher(cfg.Store)
+	if err != nil {
+		return err
 	}
 	var secretValidator secret_manager.SecretValidator
 	switch cfg.Mode {
@@ -21,4 +21,5 @@ import (
 
 	_ "github.com/kumahq/kuma/pkg/plugins/ca/builtin"
 	_ "github.com/kumahq/kuma/pkg/plugins/ca/provided"
+	_ "github.com/kumahq/kuma/pkg/plugins/ca/remote"
 )
@@ -0,0 +1,127 @@
+package bootstrap
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/kumahq/kuma/pkg/config/core/resources/store"
+	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+// SecretCipher returns Cipher used to encrypt Secrets and GlobalSecrets at rest.
+func SecretCipher(cfg *store.StoreConfig) (secret_cipher.Cipher, error) {
+	switch cfg.Type {
+	case store.KubernetesStore:
+		return secret_cipher.None(), nil // deliberately turn encryption off on Kubernetes
+	case store.MemoryStore, store.PostgresStore:
+	default:
+		return nil, errors.Errorf("unknown store type %s", cfg.Type)
+	}
+
+	encryptionCfg := cfg.SecretsEncryption
+	localCipher := secret_cipher.None()
+	if encryptionCfg.KeysFile != "" {
+		c, err := secret_cipher.NewAESGCMFromFile(encryptionCfg.KeysFile)
+		if err != nil {
+			return nil, err
+		}
+		localCipher = c
+	}
+
+	switch encryptionCfg.KeyProvider.Type {
+	case store.NoKeyProvider:
+		return localCipher, nil
+	case store.LocalKeyProvider:
+		return secret_cipher.NewEnvelope(secret_cipher.NewLocalKeyProvider(localCipher), localCipher), nil
+	case store.PluginKeyProvider:
+		provider, err := pluginKeyProvider(encryptionCfg.KeyProvider.Plugin)
+		if err != nil {
+			return nil, err
+		}
+		if encryptionCfg.KeyProvider.DataKeyCacheTTL > 0 {
+			provider = secret_cipher.NewCachingKeyProvider(provider, encryptionCfg.KeyProvider.DataKeyCacheTTL)
+		}
+		// local cipher is still used to decrypt Secrets encrypted before envelope encryption was enabled
+		return secret_cipher.NewEnvelope(provider, localCipher), nil
+	default:
+		return nil, errors.Errorf("unknown key provider type %s", encryptionCfg.KeyProvider.Type)
+	}
+}
+
+func pluginKeyProvider(cfg store.PluginKeyProviderConfig) (secret_cipher.KeyProvider, error) {
+	token := ""
+	if cfg.TokenFile != "" {
+		content, err := ioutil.ReadFile(cfg.TokenFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read token file of key provider plugin")
+		}
+		token = strings.TrimSpace(string(content))
+	}
+	pluginURL, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse URL of key provider plugin")
+	}
+	switch pluginURL.Scheme {
+	case "http", "https":
+		client := &http.Client{
+			Timeout: cfg.RequestTimeout,
+		}
+		if cfg.CaCertFile != "" {
+			if err := util_http.ConfigureMTLS(client, cfg.CaCertFile, cfg.ClientCertFile, cfg.ClientKeyFile); err != nil {
+				return nil, errors.Wrap(err, "could not configure TLS for key provider plugin")
+			}
+		}
+		return secret_cipher.NewPluginKeyProvider(client, cfg.URL, token), nil
+	case "grpc", "grpcs":
+		var dialOpt grpc.DialOption
+		if pluginURL.Scheme == "grpc" {
+			dialOpt = grpc.WithInsecure()
+		} else {
+			tlsConfig, err := pluginTLSConfig(cfg)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not configure TLS for key provider plugin")
+			}
+			dialOpt = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+		}
+		// the connection is established lazily, so the Control Plane can start before the plugin
+		conn, err := grpc.Dial(pluginURL.Host, dialOpt)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not connect to key provider plugin")
+		}
+		return secret_cipher.NewGrpcPluginKeyProvider(conn, cfg.RequestTimeout, token), nil
+	default:
+		return nil, errors.Errorf("unsupported scheme %q of key provider plugin URL. Use one of %s", pluginURL.Scheme, []string{"http", "https", "grpc", "grpcs"})
+	}
+}
+
+func pluginTLSConfig(cfg store.PluginKeyProviderConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+	if cfg.CaCertFile != "" {
+		certBytes, err := ioutil.ReadFile(cfg.CaCertFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read CA cert")
+		}
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM(certBytes); !ok {
+			return nil, errors.New("could not add certificate")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+	if cfg.ClientCertFile != "" && cfg.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create key pair from client cert and client key")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
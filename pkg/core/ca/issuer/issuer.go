@@ -3,7 +3,6 @@ package issuer
 import (
 	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -35,12 +34,17 @@ func WithExpirationTime(expiration time.Duration) CertOptsFn {
 }
 
 func NewWorkloadCert(ca util_tls.KeyPair, mesh string, tags mesh_proto.MultiValueTagSet, certOpts ...CertOptsFn) (*util_tls.KeyPair, error) {
+	return NewWorkloadCertWithKeyType(ca, mesh, tags, util_tls.RSAKeyType, certOpts...)
+}
+
+// NewWorkloadCertWithKeyType generates a workload certificate with a private key of a given type
+func NewWorkloadCertWithKeyType(ca util_tls.KeyPair, mesh string, tags mesh_proto.MultiValueTagSet, keyType util_tls.KeyType, certOpts ...CertOptsFn) (*util_tls.KeyPair, error) {
 	caPrivateKey, caCert, err := loadKeyPair(ca)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load CA key pair")
 	}
 
-	workloadKey, err := rsa.GenerateKey(rand.Reader, DefaultRsaBits)
+	workloadKey, err := util_tls.NewKey(keyType, DefaultRsaBits)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate a private key")
 	}
@@ -55,7 +59,8 @@ func NewWorkloadCert(ca util_tls.KeyPair, mesh string, tags mesh_proto.MultiValu
 	return util_tls.ToKeyPair(workloadKey, workloadCert)
 }
 
-func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, publicKey crypto.PublicKey, certOpts ...CertOptsFn) (*x509.Certificate, error) {
+// WorkloadURIs returns URIs which identify a workload with given tags: SPIFFE ID for every service and a Kuma URI for every tag
+func WorkloadURIs(trustDomain string, tags mesh_proto.MultiValueTagSet) ([]*url.URL, error) {
 	var uris []*url.URL
 	for _, service := range tags.Values(mesh_proto.ServiceTag) {
 		uri, err := spiffe.ParseID(fmt.Sprintf("spiffe://%s/%s", trustDomain, service), spiffe.AllowTrustDomainWorkload(trustDomain))
@@ -74,6 +79,14 @@ func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, p
 			uris = append(uris, u)
 		}
 	}
+	return uris, nil
+}
+
+func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, publicKey crypto.PublicKey, certOpts ...CertOptsFn) (*x509.Certificate, error) {
+	uris, err := WorkloadURIs(trustDomain, tags)
+	if err != nil {
+		return nil, err
+	}
 
 	now := time.Now()
 	serialNumber, err := x509util.NewSerialNumber()
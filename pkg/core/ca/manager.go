@@ -2,6 +2,8 @@ package ca
 
 import (
 	"context"
+	"math/big"
+	"time"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/tls"
@@ -9,6 +11,8 @@ import (
 
 type Cert = []byte
 
+type Crl = []byte
+
 type KeyPair = tls.KeyPair
 
 // Manager manages CAs by creating CAs and generating certificate. It is created per CA type and then may be used for different CA instances of the same type
@@ -26,5 +30,25 @@ type Manager interface {
 	GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (KeyPair, error)
 }
 
-// Managers hold Manager instance for each type of backend available (by default: builtin, provided)
+// IntermediateCertGetter is implemented by Managers whose CAs may sign Dataplane certificates with an intermediate CA
+type IntermediateCertGetter interface {
+	// GetIntermediateCert returns the intermediate certificate of the CA or nil if the CA signs certificates with its root
+	GetIntermediateCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) (Cert, error)
+}
+
+// AutoRotator is implemented by Managers that can generate a successor of the CA before it expires
+type AutoRotator interface {
+	// AutoRotationBefore returns how long before the expiration of the CA its successor should be generated.
+	// Zero means that the automatic rotation is disabled for the backend.
+	AutoRotationBefore(backend *mesh_proto.CertificateAuthorityBackend) (time.Duration, error)
+}
+
+// Revoker is implemented by Managers whose CAs can revoke Dataplane certificates
+type Revoker interface {
+	// GenerateCrls returns PEM encoded CRLs that revoke certificates of given serial numbers.
+	// Envoy requires a CRL for every CA in the chain once a CRL is provided for any of them, therefore all of them are returned.
+	GenerateCrls(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, serials []*big.Int) ([]Crl, error)
+}
+
+// Managers hold Manager instance for each type of backend available (by default: builtin, provided, remote)
 type Managers = map[string]Manager
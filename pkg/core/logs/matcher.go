@@ -29,19 +29,20 @@ type TrafficLogsMatcher struct {
 	ResourceManager manager.ReadOnlyResourceManager
 }
 
-func (m *TrafficLogsMatcher) Match(ctx context.Context, dataplane *mesh_core.DataplaneResource) (core_xds.LogMap, error) {
+func (m *TrafficLogsMatcher) Match(ctx context.Context, dataplane *mesh_core.DataplaneResource) (core_xds.LogMap, core_xds.LogFilterMap, error) {
 	logs := &mesh_core.TrafficLogResourceList{}
 	if err := m.ResourceManager.List(ctx, logs, store.ListByMesh(dataplane.GetMeta().GetMesh())); err != nil {
-		return nil, errors.Wrap(err, "could not retrieve traffic logs")
+		return nil, nil, errors.Wrap(err, "could not retrieve traffic logs")
 	}
 	mesh := mesh_core.NewMeshResource()
 	if err := m.ResourceManager.Get(ctx, mesh, store.GetByKey(dataplane.GetMeta().GetMesh(), model.NoMesh)); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return BuildTrafficLogMap(dataplane, mesh, logs.Items), nil
+	logMap, filterMap := BuildTrafficLogMap(dataplane, mesh, logs.Items)
+	return logMap, filterMap, nil
 }
 
-func BuildTrafficLogMap(dataplane *mesh_core.DataplaneResource, mesh *mesh_core.MeshResource, logs []*mesh_core.TrafficLogResource) core_xds.LogMap {
+func BuildTrafficLogMap(dataplane *mesh_core.DataplaneResource, mesh *mesh_core.MeshResource, logs []*mesh_core.TrafficLogResource) (core_xds.LogMap, core_xds.LogFilterMap) {
 	backends := backendsByName(mesh)
 
 	policies := make([]policy.ConnectionPolicy, len(logs))
@@ -51,6 +52,7 @@ func BuildTrafficLogMap(dataplane *mesh_core.DataplaneResource, mesh *mesh_core.
 	policyMap := policy.SelectOutboundConnectionPolicies(dataplane, policies)
 
 	logMap := core_xds.LogMap{}
+	filterMap := core_xds.LogFilterMap{}
 	for service, policy := range policyMap {
 		log := policy.(*mesh_core.TrafficLogResource)
 		backend, found := backends[log.Spec.GetConf().GetBackend()]
@@ -59,8 +61,11 @@ func BuildTrafficLogMap(dataplane *mesh_core.DataplaneResource, mesh *mesh_core.
 			continue
 		}
 		logMap[service] = backend
+		if filters := log.Spec.GetConf().GetFilters(); len(filters) > 0 {
+			filterMap[service] = filters
+		}
 	}
-	return logMap
+	return logMap, filterMap
 }
 
 func backendsByName(mesh *mesh_core.MeshResource) map[string]*mesh_proto.LoggingBackend {
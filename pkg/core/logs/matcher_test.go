@@ -121,6 +121,14 @@ var _ = Describe("Matcher", func() {
 				},
 				Conf: &mesh_proto.TrafficLog_Conf{
 					Backend: "file2",
+					Filters: []*mesh_proto.TrafficLog_Conf_Filter{
+						{
+							StatusCodes: &mesh_proto.TrafficLog_Conf_Filter_StatusCodes{
+								Min: 500,
+								Max: 599,
+							},
+						},
+					},
 				},
 			},
 		}
@@ -150,12 +158,16 @@ var _ = Describe("Matcher", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// when
-		log, err := matcher.Match(context.Background(), &dpRes)
+		log, filters, err := matcher.Match(context.Background(), &dpRes)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
 		// should match because kong->backend rule
 		Expect(log["backend"]).To(Equal(backendFile2))
+		Expect(filters["backend"]).To(HaveLen(1))
+		Expect(filters["backend"][0].GetStatusCodes().GetMin()).To(Equal(uint32(500)))
+		// *->* rule has no filters
+		Expect(filters).ToNot(HaveKey("web"))
 		// should match because *->* rule and default backend file1
 		Expect(log["web"]).To(Equal(backendFile1))
 		// should match implicit pass through because service *->* rule and default backend file1
@@ -189,7 +201,7 @@ var _ = Describe("Matcher", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// when
-		log, err := matcher.Match(context.Background(), &dpRes)
+		log, _, err := matcher.Match(context.Background(), &dpRes)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -223,7 +235,7 @@ var _ = Describe("Matcher", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// when
-		log, err := matcher.Match(context.Background(), &dpRes)
+		log, _, err := matcher.Match(context.Background(), &dpRes)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
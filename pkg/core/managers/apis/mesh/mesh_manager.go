@@ -15,6 +15,7 @@ import (
 	core_registry "github.com/kumahq/kuma/pkg/core/resources/registry"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	defaults_mesh "github.com/kumahq/kuma/pkg/defaults/mesh"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
 func NewMeshManager(
@@ -23,22 +24,25 @@ func NewMeshManager(
 	caManagers core_ca.Managers,
 	registry core_registry.TypeRegistry,
 	validator MeshValidator,
+	dpTokenSigningMethod issuer.SigningMethod,
 ) core_manager.ResourceManager {
 	return &meshManager{
-		store:         store,
-		otherManagers: otherManagers,
-		caManagers:    caManagers,
-		registry:      registry,
-		meshValidator: validator,
+		store:                store,
+		otherManagers:        otherManagers,
+		caManagers:           caManagers,
+		registry:             registry,
+		meshValidator:        validator,
+		dpTokenSigningMethod: dpTokenSigningMethod,
 	}
 }
 
 type meshManager struct {
-	store         core_store.ResourceStore
-	otherManagers core_manager.ResourceManager
-	caManagers    core_ca.Managers
-	registry      core_registry.TypeRegistry
-	meshValidator MeshValidator
+	store                core_store.ResourceStore
+	otherManagers        core_manager.ResourceManager
+	caManagers           core_ca.Managers
+	registry             core_registry.TypeRegistry
+	meshValidator        MeshValidator
+	dpTokenSigningMethod issuer.SigningMethod
 }
 
 func (m *meshManager) Get(ctx context.Context, resource core_model.Resource, fs ...core_store.GetOptionsFunc) error {
@@ -80,7 +84,7 @@ func (m *meshManager) Create(ctx context.Context, resource core_model.Resource,
 	if err := m.store.Create(ctx, mesh, append(fs, core_store.CreatedAt(time.Now()))...); err != nil {
 		return err
 	}
-	if err := defaults_mesh.EnsureDefaultMeshResources(m.otherManagers, opts.Name); err != nil {
+	if err := defaults_mesh.EnsureDefaultMeshResources(m.otherManagers, opts.Name, m.dpTokenSigningMethod); err != nil {
 		return err
 	}
 	return nil
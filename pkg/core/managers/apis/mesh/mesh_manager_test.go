@@ -48,7 +48,7 @@ var _ = Describe("Mesh Manager", func() {
 
 		manager := manager.NewResourceManager(resStore)
 		validator := MeshValidator{CaManagers: caManagers, Store: resStore}
-		resManager = NewMeshManager(resStore, manager, caManagers, test_resources.Global(), validator)
+		resManager = NewMeshManager(resStore, manager, caManagers, test_resources.Global(), validator, issuer.SigningMethodHS256)
 	})
 
 	Describe("Create()", func() {
@@ -274,7 +274,7 @@ var _ = Describe("Mesh Manager", func() {
 	})
 
 	Describe("Update()", func() {
-		It("should not allow to change CA when mTLS is enabled", func() {
+		It("should allow to change CA when mTLS is enabled", func() {
 			// given
 			meshName := "mesh-1"
 			resKey := model.ResourceKey{
@@ -308,13 +308,98 @@ var _ = Describe("Mesh Manager", func() {
 			mesh.Spec.Mtls.EnabledBackend = "builtin-2"
 			err = resManager.Update(context.Background(), &mesh)
 
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not allow to remove previous CA when changing CA when mTLS is enabled", func() {
+			// given
+			meshName := "mesh-1"
+			resKey := model.ResourceKey{
+				Name: meshName,
+			}
+
+			// when
+			mesh := core_mesh.MeshResource{
+				Spec: &mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: "builtin-1",
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{
+								Name: "builtin-1",
+								Type: "builtin",
+							},
+							{
+								Name: "builtin-2",
+								Type: "builtin",
+							},
+						},
+					},
+				},
+			}
+			err := resManager.Create(context.Background(), &mesh, store.CreateBy(resKey))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when trying to change CA and remove the previous one
+			mesh.Spec.Mtls.EnabledBackend = "builtin-2"
+			mesh.Spec.Mtls.Backends = mesh.Spec.Mtls.Backends[1:]
+			err = resManager.Update(context.Background(), &mesh)
+
 			// then
 			Expect(err).To(HaveOccurred())
 			Expect(err).To(Equal(&validators.ValidationError{
 				Violations: []validators.Violation{
 					{
-						Field:   "mtls.enabledBackend",
-						Message: "Changing CA when mTLS is enabled is forbidden. Disable mTLS first and then change the CA",
+						Field:   "mtls.backends",
+						Message: `previous backend "builtin-1" cannot be removed while changing the CA. Remove it once all Dataplanes are switched to the new CA`,
+					},
+				},
+			}))
+		})
+
+		It("should not allow to remove the rate limit service when there are rate limits in GLOBAL mode", func() {
+			// given
+			meshName := "mesh-1"
+			resKey := model.ResourceKey{
+				Name: meshName,
+			}
+			mesh := core_mesh.MeshResource{
+				Spec: &mesh_proto.Mesh{
+					RateLimits: &mesh_proto.RateLimits{
+						Service: &mesh_proto.RateLimits_Service{
+							Address: "ratelimit:8081",
+						},
+					},
+				},
+			}
+			err := resManager.Create(context.Background(), &mesh, store.CreateBy(resKey))
+			Expect(err).ToNot(HaveOccurred())
+
+			// and a rate limit in GLOBAL mode
+			rateLimit := core_mesh.RateLimitResource{
+				Spec: &mesh_proto.RateLimit{
+					Conf: &mesh_proto.RateLimit_Conf{
+						Http: &mesh_proto.RateLimit_Conf_Http{
+							Mode: mesh_proto.RateLimit_Conf_Http_GLOBAL,
+						},
+					},
+				},
+			}
+			err = resStore.Create(context.Background(), &rateLimit, store.CreateByKey("rl-1", meshName))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when trying to remove the rate limit service
+			mesh.Spec.RateLimits = nil
+			err = resManager.Update(context.Background(), &mesh)
+
+			// then
+			Expect(err).To(Equal(&validators.ValidationError{
+				Violations: []validators.Violation{
+					{
+						Field:   "rateLimits.service",
+						Message: `cannot be removed while RateLimit "rl-1" is in GLOBAL mode`,
 					},
 				},
 			}))
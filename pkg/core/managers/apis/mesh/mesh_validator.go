@@ -2,6 +2,7 @@ package mesh
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 
@@ -50,6 +51,9 @@ func (m *MeshValidator) ValidateUpdate(ctx context.Context, previousMesh *core_m
 	if err := m.validateMTLSBackends(ctx, newMesh.Meta.GetName(), newMesh); err != nil {
 		return err
 	}
+	if err := m.validateRateLimitServiceRemoval(ctx, previousMesh, newMesh); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -68,8 +72,32 @@ func (m *MeshValidator) ValidateDelete(ctx context.Context, name string) error {
 
 func (m *MeshValidator) validateMTLSBackendChange(previousMesh *core_mesh.MeshResource, newMesh *core_mesh.MeshResource) error {
 	verr := validators.ValidationError{}
-	if previousMesh.MTLSEnabled() && newMesh.MTLSEnabled() && previousMesh.Spec.GetMtls().GetEnabledBackend() != newMesh.Spec.GetMtls().GetEnabledBackend() {
-		verr.AddViolation("mtls.enabledBackend", "Changing CA when mTLS is enabled is forbidden. Disable mTLS first and then change the CA")
+	// Dataplanes keep trusting and using the previous CA until all of them are switched to the new one,
+	// therefore the previous CA cannot be removed in the same update in which the CA is changed.
+	previousBackend := previousMesh.Spec.GetMtls().GetEnabledBackend()
+	if previousMesh.MTLSEnabled() && newMesh.MTLSEnabled() && previousBackend != newMesh.Spec.GetMtls().GetEnabledBackend() {
+		if newMesh.GetCertificateAuthorityBackend(previousBackend) == nil {
+			verr.AddViolation("mtls.backends", fmt.Sprintf("previous backend %q cannot be removed while changing the CA. Remove it once all Dataplanes are switched to the new CA", previousBackend))
+		}
+	}
+	return verr.OrNil()
+}
+
+// validateRateLimitServiceRemoval rejects removing the rate limit service while there are rate limits in GLOBAL mode,
+// because such limits could not be enforced anymore.
+func (m *MeshValidator) validateRateLimitServiceRemoval(ctx context.Context, previousMesh *core_mesh.MeshResource, newMesh *core_mesh.MeshResource) error {
+	if previousMesh.Spec.GetRateLimits().GetService() == nil || newMesh.Spec.GetRateLimits().GetService() != nil {
+		return nil
+	}
+	rateLimits := core_mesh.RateLimitResourceList{}
+	if err := m.Store.List(ctx, &rateLimits, store.ListByMesh(newMesh.Meta.GetName())); err != nil {
+		return errors.Wrap(err, "unable to list RateLimits")
+	}
+	verr := validators.ValidationError{}
+	for _, rateLimit := range rateLimits.Items {
+		if rateLimit.Spec.IsGlobal() {
+			verr.AddViolation("rateLimits.service", fmt.Sprintf("cannot be removed while RateLimit %q is in GLOBAL mode", rateLimit.Meta.GetName()))
+		}
 	}
 	return verr.OrNil()
 }
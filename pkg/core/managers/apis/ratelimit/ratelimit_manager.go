@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+func NewRateLimitManager(store core_store.ResourceStore, validator Validator) core_manager.ResourceManager {
+	return &rateLimitManager{
+		ResourceManager: core_manager.NewResourceManager(store),
+		validator:       validator,
+	}
+}
+
+type rateLimitManager struct {
+	core_manager.ResourceManager
+	validator Validator
+}
+
+func (m *rateLimitManager) Create(ctx context.Context, resource core_model.Resource, fs ...core_store.CreateOptionsFunc) error {
+	rateLimit, err := m.rateLimit(resource)
+	if err != nil {
+		return err
+	}
+	if err := rateLimit.Validate(); err != nil {
+		return err
+	}
+	opts := core_store.NewCreateOptions(fs...)
+	if err := m.validator.ValidateCreateOrUpdate(ctx, opts.Mesh, rateLimit); err != nil {
+		return err
+	}
+	return m.ResourceManager.Create(ctx, resource, fs...)
+}
+
+func (m *rateLimitManager) Update(ctx context.Context, resource core_model.Resource, fs ...core_store.UpdateOptionsFunc) error {
+	rateLimit, err := m.rateLimit(resource)
+	if err != nil {
+		return err
+	}
+	if err := rateLimit.Validate(); err != nil {
+		return err
+	}
+	if err := m.validator.ValidateCreateOrUpdate(ctx, resource.GetMeta().GetMesh(), rateLimit); err != nil {
+		return err
+	}
+	return m.ResourceManager.Update(ctx, resource, fs...)
+}
+
+func (m *rateLimitManager) rateLimit(resource core_model.Resource) (*core_mesh.RateLimitResource, error) {
+	rateLimit, ok := resource.(*core_mesh.RateLimitResource)
+	if !ok {
+		return nil, errors.Errorf("invalid resource type: expected=%T, got=%T", (*core_mesh.RateLimitResource)(nil), resource)
+	}
+	return rateLimit, nil
+}
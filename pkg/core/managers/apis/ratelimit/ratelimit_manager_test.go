@@ -0,0 +1,88 @@
+package ratelimit_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/managers/apis/ratelimit"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("RateLimit Manager", func() {
+
+	var rateLimitManager manager.ResourceManager
+	var resStore store.ResourceStore
+
+	BeforeEach(func() {
+		resStore = memory.NewStore()
+
+		validator := ratelimit.Validator{Store: resStore}
+		rateLimitManager = ratelimit.NewRateLimitManager(resStore, validator)
+	})
+
+	globalRateLimit := func() *core_mesh.RateLimitResource {
+		return &core_mesh.RateLimitResource{
+			Spec: &mesh_proto.RateLimit{
+				Sources: []*mesh_proto.Selector{
+					{Match: mesh_proto.MatchAnyService()},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{Match: mesh_proto.MatchService("backend")},
+				},
+				Conf: &mesh_proto.RateLimit_Conf{
+					Http: &mesh_proto.RateLimit_Conf_Http{
+						Mode: mesh_proto.RateLimit_Conf_Http_GLOBAL,
+					},
+				},
+			},
+		}
+	}
+
+	It("should not create a rate limit in GLOBAL mode when the rate limit service is not defined", func() {
+		// given
+		err := resStore.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey(model.DefaultMesh, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = rateLimitManager.Create(context.Background(), globalRateLimit(), store.CreateByKey("rl-1", model.DefaultMesh))
+
+		// then
+		Expect(err).To(Equal(&validators.ValidationError{
+			Violations: []validators.Violation{
+				{
+					Field:   "conf.http.mode",
+					Message: "GLOBAL mode requires the rate limit service to be defined in the Mesh (rateLimits.service)",
+				},
+			},
+		}))
+	})
+
+	It("should create a rate limit in GLOBAL mode when the rate limit service is defined", func() {
+		// given
+		mesh := &core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				RateLimits: &mesh_proto.RateLimits{
+					Service: &mesh_proto.RateLimits_Service{
+						Address: "ratelimit:8081",
+					},
+				},
+			},
+		}
+		err := resStore.Create(context.Background(), mesh, store.CreateByKey(model.DefaultMesh, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = rateLimitManager.Create(context.Background(), globalRateLimit(), store.CreateByKey("rl-1", model.DefaultMesh))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
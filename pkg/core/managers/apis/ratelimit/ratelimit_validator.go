@@ -0,0 +1,37 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+type Validator struct {
+	Store store.ResourceStore
+}
+
+// ValidateCreateOrUpdate rejects rate limits in GLOBAL mode when the rate limit service is not defined in the Mesh,
+// because such limits could not be enforced.
+func (v *Validator) ValidateCreateOrUpdate(ctx context.Context, mesh string, rateLimit *core_mesh.RateLimitResource) error {
+	if !rateLimit.Spec.IsGlobal() {
+		return nil
+	}
+	owner := core_mesh.NewMeshResource()
+	if err := v.Store.Get(ctx, owner, store.GetByKey(mesh, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, "unable to get Mesh")
+	}
+	if owner.Spec.GetRateLimits().GetService() == nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation("conf.http.mode", "GLOBAL mode requires the rate limit service to be defined in the Mesh (rateLimits.service)")
+		return verr.OrNil()
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	manager_dataplane "github.com/kumahq/kuma/pkg/core/managers/apis/dataplane"
 	"github.com/kumahq/kuma/pkg/core/policy"
 	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
@@ -26,21 +27,27 @@ func (m *TrafficPermissionsMatcher) Match(ctx context.Context, dataplane *mesh_c
 	return BuildTrafficPermissionMap(dataplane, mesh, permissions.Items)
 }
 
+// MatchDeny picks all the denying Traffic Permissions for each inbound of a given Dataplane.
+func (m *TrafficPermissionsMatcher) MatchDeny(ctx context.Context, dataplane *mesh_core.DataplaneResource, mesh *mesh_core.MeshResource) (core_xds.TrafficDenyPermissionMap, error) {
+	permissions := &mesh_core.TrafficPermissionResourceList{}
+	if err := m.ResourceManager.List(ctx, permissions, store.ListByMesh(dataplane.GetMeta().GetMesh())); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve traffic permissions")
+	}
+
+	return BuildTrafficDenyPermissionMap(dataplane, mesh, permissions.Items)
+}
+
 func BuildTrafficPermissionMap(
 	dataplane *mesh_core.DataplaneResource,
 	mesh *mesh_core.MeshResource,
 	trafficPermissions []*mesh_core.TrafficPermissionResource,
 ) (core_xds.TrafficPermissionMap, error) {
-	policies := make([]policy.ConnectionPolicy, len(trafficPermissions))
-	for i, permission := range trafficPermissions {
-		policies[i] = permission
-	}
+	policies, _ := SplitByAction(trafficPermissions)
 
-	additionalInbounds, err := manager_dataplane.AdditionalInbounds(dataplane, mesh)
+	inbounds, err := allInbounds(dataplane, mesh)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not fetch additional inbounds")
+		return nil, err
 	}
-	inbounds := append(dataplane.Spec.GetNetworking().GetInbound(), additionalInbounds...)
 	policyMap := policy.SelectInboundConnectionPolicies(dataplane, inbounds, policies)
 
 	result := core_xds.TrafficPermissionMap{}
@@ -50,6 +57,48 @@ func BuildTrafficPermissionMap(
 	return result, nil
 }
 
+func BuildTrafficDenyPermissionMap(
+	dataplane *mesh_core.DataplaneResource,
+	mesh *mesh_core.MeshResource,
+	trafficPermissions []*mesh_core.TrafficPermissionResource,
+) (core_xds.TrafficDenyPermissionMap, error) {
+	_, policies := SplitByAction(trafficPermissions)
+
+	inbounds, err := allInbounds(dataplane, mesh)
+	if err != nil {
+		return nil, err
+	}
+	policiesMap := policy.SelectInboundConnectionDenyPolicies(dataplane, inbounds, policies)
+
+	result := core_xds.TrafficDenyPermissionMap{}
+	for inbound, connectionPolicies := range policiesMap {
+		for _, connectionPolicy := range connectionPolicies {
+			result[inbound] = append(result[inbound], connectionPolicy.(*mesh_core.TrafficPermissionResource))
+		}
+	}
+	return result, nil
+}
+
+// SplitByAction splits Traffic Permissions into allowing and denying ones.
+func SplitByAction(trafficPermissions []*mesh_core.TrafficPermissionResource) (allow []policy.ConnectionPolicy, deny []policy.ConnectionPolicy) {
+	for _, permission := range trafficPermissions {
+		if permission.IsDeny() {
+			deny = append(deny, permission)
+		} else {
+			allow = append(allow, permission)
+		}
+	}
+	return
+}
+
+func allInbounds(dataplane *mesh_core.DataplaneResource, mesh *mesh_core.MeshResource) ([]*mesh_proto.Dataplane_Networking_Inbound, error) {
+	additionalInbounds, err := manager_dataplane.AdditionalInbounds(dataplane, mesh)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch additional inbounds")
+	}
+	return append(dataplane.Spec.GetNetworking().GetInbound(), additionalInbounds...), nil
+}
+
 func (m *TrafficPermissionsMatcher) MatchExternalServices(ctx context.Context, dataplane *mesh_core.DataplaneResource, externalServices *mesh_core.ExternalServiceResourceList) ([]*mesh_core.ExternalServiceResource, error) {
 	permissions := &mesh_core.TrafficPermissionResourceList{}
 	if err := m.ResourceManager.List(ctx, permissions, store.ListByMesh(dataplane.GetMeta().GetMesh())); err != nil {
@@ -59,11 +108,15 @@ func (m *TrafficPermissionsMatcher) MatchExternalServices(ctx context.Context, d
 	var matchedExternalServices []*mesh_core.ExternalServiceResource
 
 	externalServicePermissions := m.BuildExternalServicesPermissionsMap(externalServices, permissions.Items)
+	_, denyPermissions := SplitByAction(permissions.Items)
 	for _, externalService := range externalServices.Items {
 		permission := externalServicePermissions[externalService.GetMeta().GetName()]
 		if permission == nil {
 			continue
 		}
+		if isDenied(dataplane, externalService, denyPermissions) {
+			continue
+		}
 		matched := false
 		for _, selector := range permission.Spec.Sources {
 			if dataplane.Spec.MatchTags(selector.Match) {
@@ -80,10 +133,7 @@ func (m *TrafficPermissionsMatcher) MatchExternalServices(ctx context.Context, d
 type ExternalServicePermissions map[string]*mesh_core.TrafficPermissionResource
 
 func (m *TrafficPermissionsMatcher) BuildExternalServicesPermissionsMap(externalServices *mesh_core.ExternalServiceResourceList, trafficPermissions []*mesh_core.TrafficPermissionResource) ExternalServicePermissions {
-	policies := make([]policy.ConnectionPolicy, len(trafficPermissions))
-	for i, permission := range trafficPermissions {
-		policies[i] = permission
-	}
+	policies, _ := SplitByAction(trafficPermissions)
 
 	result := ExternalServicePermissions{}
 	for _, externalService := range externalServices.Items {
@@ -94,3 +144,24 @@ func (m *TrafficPermissionsMatcher) BuildExternalServicesPermissionsMap(external
 	}
 	return result
 }
+
+// isDenied checks whether any of the denying policies matches a given Dataplane as a source and External Service as a destination.
+func isDenied(dataplane *mesh_core.DataplaneResource, externalService *mesh_core.ExternalServiceResource, denyPolicies []policy.ConnectionPolicy) bool {
+	for _, denyPolicy := range denyPolicies {
+		sourceMatches := false
+		for _, selector := range denyPolicy.Sources() {
+			if dataplane.Spec.MatchTags(selector.Match) {
+				sourceMatches = true
+			}
+		}
+		if !sourceMatches {
+			continue
+		}
+		for _, selector := range denyPolicy.Destinations() {
+			if mesh_proto.TagSelector(selector.Match).Matches(externalService.Spec.Tags) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -387,6 +387,187 @@ var _ = Describe("Match", func() {
 					"google":  true,
 				},
 			}),
+			Entry("should not match external services denied by the denying traffic permission", testCase{
+				dataplane: &core_mesh.DataplaneResource{
+					Meta: &model.ResourceMeta{
+						Mesh: "default",
+						Name: "dp1",
+					},
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "192.168.0.1",
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+								{
+									Port:        8080,
+									ServicePort: 8081,
+									Tags: map[string]string{
+										"kuma.io/service": "web",
+									},
+								},
+							},
+						},
+					},
+				},
+				externalServices: []*core_mesh.ExternalServiceResource{
+					{
+						Meta: &model.ResourceMeta{
+							Mesh: "default",
+							Name: "httpbin",
+						},
+						Spec: &mesh_proto.ExternalService{
+							Tags: map[string]string{
+								"kuma.io/service": "httpbin",
+							},
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: "httpbin.org",
+							},
+						},
+					},
+					{ // this won't be matched since it is denied for web
+						Meta: &model.ResourceMeta{
+							Mesh: "default",
+							Name: "google",
+						},
+						Spec: &mesh_proto.ExternalService{
+							Tags: map[string]string{
+								"kuma.io/service": "google",
+							},
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: "google.com",
+							},
+						},
+					},
+				},
+				policies: []*core_mesh.TrafficPermissionResource{
+					{
+						Meta: &model.ResourceMeta{
+							Mesh: "default",
+							Name: "all",
+						},
+						Spec: &mesh_proto.TrafficPermission{
+							Sources: []*mesh_proto.Selector{
+								{
+									Match: map[string]string{
+										"kuma.io/service": "*",
+									},
+								},
+							},
+							Destinations: []*mesh_proto.Selector{
+								{
+									Match: map[string]string{
+										"kuma.io/service": "*",
+									},
+								},
+							},
+						},
+					},
+					{
+						Meta: &model.ResourceMeta{
+							Mesh: "default",
+							Name: "deny-web-to-google",
+						},
+						Spec: &mesh_proto.TrafficPermission{
+							Sources: []*mesh_proto.Selector{
+								{
+									Match: map[string]string{
+										"kuma.io/service": "web",
+									},
+								},
+							},
+							Destinations: []*mesh_proto.Selector{
+								{
+									Match: map[string]string{
+										"kuma.io/service": "google",
+									},
+								},
+							},
+							Action: mesh_proto.TrafficPermission_DENY,
+						},
+					},
+				},
+				expected: map[string]bool{
+					"httpbin": true,
+				},
+			}),
 		)
 	})
+
+	Context("MatchDeny", func() {
+		It("should find all denying policies and ignore them when matching allowing ones", func() {
+			// given
+			manager := core_manager.NewResourceManager(memory.NewStore())
+			matcher := permissions.TrafficPermissionsMatcher{ResourceManager: manager}
+
+			err := manager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+
+			policy := func(name string, destination string, action mesh_proto.TrafficPermission_Action) *core_mesh.TrafficPermissionResource {
+				return &core_mesh.TrafficPermissionResource{
+					Meta: &model.ResourceMeta{Mesh: "default", Name: name},
+					Spec: &mesh_proto.TrafficPermission{
+						Sources: []*mesh_proto.Selector{
+							{Match: map[string]string{"kuma.io/service": "*"}},
+						},
+						Destinations: []*mesh_proto.Selector{
+							{Match: map[string]string{"kuma.io/service": destination}},
+						},
+						Action: action,
+					},
+				}
+			}
+			policies := []*core_mesh.TrafficPermissionResource{
+				policy("allow-web", "web", mesh_proto.TrafficPermission_ALLOW),
+				policy("deny-all", "*", mesh_proto.TrafficPermission_DENY),
+				policy("deny-web", "web", mesh_proto.TrafficPermission_DENY),
+				policy("deny-backend", "backend", mesh_proto.TrafficPermission_DENY),
+			}
+			for _, p := range policies {
+				err := manager.Create(context.Background(), p, store.CreateByKey(p.Meta.GetName(), "default"))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			dataplane := &core_mesh.DataplaneResource{
+				Meta: &model.ResourceMeta{Mesh: "default", Name: "dp1"},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Port:        8080,
+								ServicePort: 8081,
+								Tags: map[string]string{
+									"kuma.io/service": "web",
+								},
+							},
+						},
+					},
+				},
+			}
+			mesh := &core_mesh.MeshResource{
+				Meta: &model.ResourceMeta{Name: "default"},
+				Spec: &mesh_proto.Mesh{},
+			}
+			iface := mesh_proto.InboundInterface{DataplaneIP: "192.168.0.1", WorkloadIP: "127.0.0.1", WorkloadPort: 8081, DataplanePort: 8080}
+
+			// when
+			allowed, err := matcher.Match(context.Background(), dataplane, mesh)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(HaveLen(1))
+			Expect(allowed[iface].GetMeta().GetName()).To(Equal("allow-web"))
+
+			// when
+			denied, err := matcher.MatchDeny(context.Background(), dataplane, mesh)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(denied).To(HaveLen(1))
+			var names []string
+			for _, permission := range denied[iface] {
+				names = append(names, permission.GetMeta().GetName())
+			}
+			Expect(names).To(Equal([]string{"deny-all", "deny-web"}))
+		})
+	})
 })
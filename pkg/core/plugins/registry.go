@@ -25,6 +25,7 @@ const (
 
 	CaBuiltin  PluginName = "builtin"
 	CaProvided PluginName = "provided"
+	CaRemote   PluginName = "remote"
 )
 
 type Registry interface {
@@ -168,6 +168,89 @@ func SelectInboundConnectionMatchingPolicies(dataplane *mesh_core.DataplaneResou
 	return policiesMap
 }
 
+// SelectInboundConnectionDenyPolicies picks all policies matching each inbound interface of a given Dataplane.
+// It is meant for denying policies which take precedence over the allowing ones regardless of how specific they are,
+// therefore every one of them has to be applied.
+func SelectInboundConnectionDenyPolicies(dataplane *mesh_core.DataplaneResource, inbounds []*mesh_proto.Dataplane_Networking_Inbound, policies []ConnectionPolicy) InboundConnectionPoliciesMap {
+	sort.Stable(ConnectionPolicyByName(policies)) // sort to avoid flakiness
+	policiesMap := make(InboundConnectionPoliciesMap)
+	for _, inbound := range inbounds {
+		var matchingPolicies []ConnectionPolicy
+		for _, policy := range policies {
+			if matchesAnySelector(policy.Destinations(), inbound.Tags) {
+				matchingPolicies = append(matchingPolicies, policy)
+			}
+		}
+		if len(matchingPolicies) > 0 {
+			iface := dataplane.Spec.GetNetworking().ToInboundInterface(inbound)
+			policiesMap[iface] = matchingPolicies
+		}
+	}
+	return policiesMap
+}
+
+// ConnectionDecision is a result of resolving allowing and denying policies for a connection between a source and a destination.
+type ConnectionDecision struct {
+	// Allowed is true when the connection is permitted.
+	Allowed bool
+	// Policy is the policy that decided about the connection. It is nil when no policy matches the destination.
+	Policy ConnectionPolicy
+}
+
+// DecideInboundConnection resolves whether a connection from a source with given tags to a destination with given tags is permitted.
+// Denying policies that match both the source and the destination take precedence and the most specific of them is reported.
+// Otherwise, the most specific allowing policy of the destination decides, the same way it is done for inbound interfaces.
+func DecideInboundConnection(sourceTags, destinationTags map[string]string, allowPolicies, denyPolicies []ConnectionPolicy) ConnectionDecision {
+	sort.Stable(ConnectionPolicyByName(allowPolicies)) // sort to avoid flakiness
+	sort.Stable(ConnectionPolicyByName(denyPolicies))
+
+	var denyPolicy ConnectionPolicy
+	var bestDenyRank mesh_proto.TagSelectorRank
+	for _, policy := range denyPolicies {
+		sourceRank, sourceMatches := bestMatchingRank(policy.Sources(), sourceTags)
+		destinationRank, destinationMatches := bestMatchingRank(policy.Destinations(), destinationTags)
+		if !sourceMatches || !destinationMatches {
+			continue
+		}
+		rank := destinationRank.CombinedWith(sourceRank)
+		if denyPolicy == nil || rank.CompareTo(bestDenyRank) > 0 {
+			denyPolicy = policy
+			bestDenyRank = rank
+		}
+	}
+	if denyPolicy != nil {
+		return ConnectionDecision{Allowed: false, Policy: denyPolicy}
+	}
+
+	allowPolicy := SelectInboundConnectionPolicy(destinationTags, allowPolicies)
+	if allowPolicy == nil {
+		return ConnectionDecision{Allowed: false}
+	}
+	_, sourceMatches := bestMatchingRank(allowPolicy.Sources(), sourceTags)
+	return ConnectionDecision{Allowed: sourceMatches, Policy: allowPolicy}
+}
+
+func matchesAnySelector(selectors []*mesh_proto.Selector, tags map[string]string) bool {
+	_, matches := bestMatchingRank(selectors, tags)
+	return matches
+}
+
+func bestMatchingRank(selectors []*mesh_proto.Selector, tags map[string]string) (mesh_proto.TagSelectorRank, bool) {
+	var bestRank mesh_proto.TagSelectorRank
+	matches := false
+	for _, selector := range selectors {
+		tagSelector := mesh_proto.TagSelector(selector.Match)
+		if tagSelector.Matches(tags) {
+			rank := tagSelector.Rank()
+			if !matches || rank.CompareTo(bestRank) > 0 {
+				bestRank = rank
+			}
+			matches = true
+		}
+	}
+	return bestRank, matches
+}
+
 // SelectInboundConnectionPolicy picks a single the most specific policy for given inbound tags.
 func SelectInboundConnectionPolicy(inboundTags map[string]string, policies []ConnectionPolicy) ConnectionPolicy {
 	var bestPolicy ConnectionPolicy
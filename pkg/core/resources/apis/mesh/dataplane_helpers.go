@@ -14,12 +14,16 @@ import (
 type Protocol string
 
 const (
-	ProtocolUnknown = "<unknown>"
-	ProtocolTCP     = "tcp"
-	ProtocolHTTP    = "http"
-	ProtocolHTTP2   = "http2"
-	ProtocolGRPC    = "grpc"
-	ProtocolKafka   = "kafka"
+	ProtocolUnknown  = "<unknown>"
+	ProtocolTCP      = "tcp"
+	ProtocolHTTP     = "http"
+	ProtocolHTTP2    = "http2"
+	ProtocolGRPC     = "grpc"
+	ProtocolKafka    = "kafka"
+	ProtocolRedis    = "redis"
+	ProtocolMongo    = "mongo"
+	ProtocolMySQL    = "mysql"
+	ProtocolPostgres = "postgres"
 )
 
 func ParseProtocol(tag string) Protocol {
@@ -34,6 +38,14 @@ func ParseProtocol(tag string) Protocol {
 		return ProtocolGRPC
 	case ProtocolKafka:
 		return ProtocolKafka
+	case ProtocolRedis:
+		return ProtocolRedis
+	case ProtocolMongo:
+		return ProtocolMongo
+	case ProtocolMySQL:
+		return ProtocolMySQL
+	case ProtocolPostgres:
+		return ProtocolPostgres
 	default:
 		return ProtocolUnknown
 	}
@@ -56,6 +68,10 @@ var SupportedProtocols = ProtocolList{
 	ProtocolHTTP,
 	ProtocolHTTP2,
 	ProtocolKafka,
+	ProtocolMongo,
+	ProtocolMySQL,
+	ProtocolPostgres,
+	ProtocolRedis,
 	ProtocolTCP,
 }
 
@@ -545,12 +545,24 @@ var _ = Describe("ParseProtocol()", func() {
 			tag:      "kafka",
 			expected: ProtocolKafka,
 		}),
+		Entry("redis", testCase{
+			tag:      "redis",
+			expected: ProtocolRedis,
+		}),
 		Entry("mongo", testCase{
 			tag:      "mongo",
-			expected: ProtocolUnknown,
+			expected: ProtocolMongo,
 		}),
 		Entry("mysql", testCase{
 			tag:      "mysql",
+			expected: ProtocolMySQL,
+		}),
+		Entry("postgres", testCase{
+			tag:      "postgres",
+			expected: ProtocolPostgres,
+		}),
+		Entry("mssql", testCase{
+			tag:      "mssql",
 			expected: ProtocolUnknown,
 		}),
 		Entry("unknown", testCase{
@@ -239,7 +239,7 @@ var _ = Describe("ExternalService", func() {
                 - field: tags["kuma.io/protocol"]
                   message: tag value cannot be empty
                 - field: tags["kuma.io/protocol"]
-                  message: 'tag "kuma.io/protocol" has an invalid value "". Allowed values: grpc, http, http2, kafka, tcp'
+                  message: 'tag "kuma.io/protocol" has an invalid value "". Allowed values: grpc, http, http2, kafka, mongo, mysql, postgres, redis, tcp'
 `,
 		}),
 		Entry("tags: `protocol` tag with unsupported value", testCase{
@@ -255,7 +255,7 @@ var _ = Describe("ExternalService", func() {
 			expected: `
                 violations:
                 - field: tags["kuma.io/protocol"]
-                  message: 'tag "kuma.io/protocol" has an invalid value "not-yet-supported-protocol". Allowed values: grpc, http, http2, kafka, tcp'`,
+                  message: 'tag "kuma.io/protocol" has an invalid value "not-yet-supported-protocol". Allowed values: grpc, http, http2, kafka, mongo, mysql, postgres, redis, tcp'`,
 		}),
 		Entry("tags: tag name with invalid characters", testCase{
 			dataplane: `
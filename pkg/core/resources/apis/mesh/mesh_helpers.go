@@ -2,6 +2,7 @@ package mesh
 
 import (
 	"fmt"
+	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
@@ -75,6 +76,38 @@ func (m *MeshResource) GetCertificateAuthorityBackend(name string) *mesh_proto.C
 	return nil
 }
 
+// IsDataplaneRevoked returns true if Dataplane of a given name is not issued certificates anymore
+func (m *MeshResource) IsDataplaneRevoked(name string) bool {
+	for _, dataplane := range m.Spec.GetMtls().GetRevocation().GetDataplanes() {
+		if dataplane == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRevokedSerials returns serial numbers of revoked certificates
+func (m *MeshResource) GetRevokedSerials() ([]*big.Int, error) {
+	var serials []*big.Int
+	for _, serial := range m.Spec.GetMtls().GetRevocation().GetSerials() {
+		parsed, err := ParseCertificateSerial(serial)
+		if err != nil {
+			return nil, err
+		}
+		serials = append(serials, parsed)
+	}
+	return serials, nil
+}
+
+// ParseCertificateSerial parses a serial number of a certificate in hexadecimal format
+func ParseCertificateSerial(serial string) (*big.Int, error) {
+	parsed, ok := new(big.Int).SetString(serial, 16)
+	if !ok || parsed.Sign() <= 0 {
+		return nil, fmt.Errorf("not a valid serial number: %q", serial)
+	}
+	return parsed, nil
+}
+
 var durationRE = regexp.MustCompile("^([0-9]+)(y|w|d|h|m|s|ms)$")
 
 // ParseDuration parses a string into a time.Duration
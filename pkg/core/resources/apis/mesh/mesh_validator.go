@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"sort"
 
+	"github.com/golang/protobuf/ptypes"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -19,6 +21,7 @@ func (m *MeshResource) Validate() error {
 	verr.AddError("logging", validateLogging(m.Spec.Logging))
 	verr.AddError("tracing", validateTracing(m.Spec.Tracing))
 	verr.AddError("metrics", validateMetrics(m.Spec.Metrics))
+	verr.AddError("rateLimits", validateRateLimits(m.Spec.RateLimits))
 	return verr.OrNil()
 }
 
@@ -45,6 +48,17 @@ func validateMtls(mtls *mesh_proto.Mesh_Mtls) validators.ValidationError {
 			}
 		}
 	}
+	revocationPath := validators.RootedAt("revocation")
+	for i, serial := range mtls.GetRevocation().GetSerials() {
+		if _, err := ParseCertificateSerial(serial); err != nil {
+			verr.AddViolationAt(revocationPath.Field("serials").Index(i), "has to be a serial number in hexadecimal format")
+		}
+	}
+	for i, dataplane := range mtls.GetRevocation().GetDataplanes() {
+		if dataplane == "" {
+			verr.AddViolationAt(revocationPath.Field("dataplanes").Index(i), "cannot be empty")
+		}
+	}
 	return verr
 }
 
@@ -75,13 +89,30 @@ func validateLoggingBackend(backend *mesh_proto.LoggingBackend) validators.Valid
 	if err := accesslog.ValidateFormat(backend.Format); err != nil {
 		verr.AddViolation("format", err.Error())
 	}
+	if len(backend.JsonFormat) > 0 {
+		if backend.Format != "" {
+			verr.AddViolation("jsonFormat", "cannot be used together with format")
+		}
+		keys := make([]string, 0, len(backend.JsonFormat))
+		for key := range backend.JsonFormat {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := accesslog.ValidateFormat(backend.JsonFormat[key]); err != nil {
+				verr.AddViolationAt(validators.RootedAt("jsonFormat").Key(key), err.Error())
+			}
+		}
+	}
 	switch backend.GetType() {
 	case mesh_proto.LoggingFileType:
 		verr.AddError("config", validateLoggingFile(backend.Conf))
 	case mesh_proto.LoggingTcpType:
 		verr.AddError("config", validateLoggingTcp(backend.Conf))
+	case mesh_proto.LoggingGrpcType:
+		verr.AddError("config", validateLoggingGrpc(backend.Conf))
 	default:
-		verr.AddViolation("type", fmt.Sprintf("unknown backend type. Available backends: %q, %q", mesh_proto.LoggingTcpType, mesh_proto.LoggingFileType))
+		verr.AddViolation("type", fmt.Sprintf("unknown backend type. Available backends: %q, %q, %q", mesh_proto.LoggingTcpType, mesh_proto.LoggingFileType, mesh_proto.LoggingGrpcType))
 	}
 	return verr
 }
@@ -104,6 +135,32 @@ func validateLoggingTcp(cfgStr *structpb.Struct) validators.ValidationError {
 	return verr
 }
 
+func validateLoggingGrpc(cfgStr *structpb.Struct) validators.ValidationError {
+	var verr validators.ValidationError
+	cfg := mesh_proto.GrpcLoggingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		verr.AddViolation("", fmt.Sprintf("could not parse config: %s", err.Error()))
+		return verr
+	}
+	if cfg.Path == "" && cfg.Address == "" && cfg.Url == "" {
+		verr.AddViolation("", "at least one of path, address or url has to be defined")
+		return verr
+	}
+	if cfg.Address != "" {
+		host, port, err := net.SplitHostPort(cfg.Address)
+		if host == "" || port == "" || err != nil {
+			verr.AddViolation("address", "has to be in format of HOST:PORT")
+		}
+	}
+	if cfg.Url != "" {
+		uri, err := url.ParseRequestURI(cfg.Url)
+		if err != nil || (uri.Scheme != "http" && uri.Scheme != "https") {
+			verr.AddViolation("url", "has to be a valid http or https URL")
+		}
+	}
+	return verr
+}
+
 func validateLoggingFile(cfgStr *structpb.Struct) validators.ValidationError {
 	var verr validators.ValidationError
 	cfg := mesh_proto.FileLoggingBackendConfig{}
@@ -139,14 +196,21 @@ func validateTracingBackend(backend *mesh_proto.TracingBackend) validators.Valid
 	if backend.Name == "" {
 		verr.AddViolation("name", "cannot be empty")
 	}
-	if backend.GetType() != mesh_proto.TracingZipkinType {
-		verr.AddViolation("type", fmt.Sprintf("unknown backend type. Available backends: %q", mesh_proto.TracingZipkinType))
+	switch backend.GetType() {
+	case mesh_proto.TracingZipkinType, mesh_proto.TracingDatadogType, mesh_proto.TracingOpenTelemetryType:
+	default:
+		verr.AddViolation("type", fmt.Sprintf("unknown backend type. Available backends: %q, %q, %q", mesh_proto.TracingZipkinType, mesh_proto.TracingDatadogType, mesh_proto.TracingOpenTelemetryType))
 	}
 	if backend.Sampling.GetValue() < 0.0 || backend.Sampling.GetValue() > 100.0 {
 		verr.AddViolation("sampling", "has to be in [0.0 - 100.0] range")
 	}
-	if backend.GetType() == mesh_proto.TracingZipkinType {
+	switch backend.GetType() {
+	case mesh_proto.TracingZipkinType:
 		verr.AddError("config", validateZipkin(backend.Conf))
+	case mesh_proto.TracingDatadogType:
+		verr.AddError("config", validateDatadog(backend.Conf))
+	case mesh_proto.TracingOpenTelemetryType:
+		verr.AddError("config", validateOpenTelemetry(backend.Conf))
 	}
 	return verr
 }
@@ -174,6 +238,57 @@ func validateZipkin(cfgStr *structpb.Struct) validators.ValidationError {
 	return verr
 }
 
+func validateDatadog(cfgStr *structpb.Struct) validators.ValidationError {
+	var verr validators.ValidationError
+	cfg := mesh_proto.DatadogTracingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		verr.AddViolation("", fmt.Sprintf("could not parse config: %s", err.Error()))
+		return verr
+	}
+	if cfg.Address == "" {
+		verr.AddViolation("address", "cannot be empty")
+	}
+	if cfg.Port == 0 || cfg.Port > 65535 {
+		verr.AddViolation("port", "has to be in [1 - 65535] range")
+	}
+	return verr
+}
+
+func validateOpenTelemetry(cfgStr *structpb.Struct) validators.ValidationError {
+	var verr validators.ValidationError
+	cfg := mesh_proto.OpenTelemetryTracingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		verr.AddViolation("", fmt.Sprintf("could not parse config: %s", err.Error()))
+		return verr
+	}
+	if cfg.Address == "" {
+		verr.AddViolation("address", "cannot be empty")
+	} else if host, port, err := net.SplitHostPort(cfg.Address); host == "" || port == "" || err != nil {
+		verr.AddViolation("address", "has to be in format of HOST:PORT")
+	}
+	return verr
+}
+
+func validateRateLimits(rateLimits *mesh_proto.RateLimits) validators.ValidationError {
+	var verr validators.ValidationError
+	service := rateLimits.GetService()
+	if service == nil {
+		return verr
+	}
+	path := validators.RootedAt("service")
+	if service.Address == "" {
+		verr.AddViolationAt(path.Field("address"), "cannot be empty")
+	} else if host, port, err := net.SplitHostPort(service.Address); host == "" || port == "" || err != nil {
+		verr.AddViolationAt(path.Field("address"), "has to be in format of HOST:PORT")
+	}
+	if service.Timeout != nil {
+		if timeout, err := ptypes.Duration(service.Timeout); err != nil || timeout <= 0 {
+			verr.AddViolationAt(path.Field("timeout"), "must be greater than 0")
+		}
+	}
+	return verr
+}
+
 func validateMetrics(metrics *mesh_proto.Metrics) validators.ValidationError {
 	var verr validators.ValidationError
 	if metrics == nil {
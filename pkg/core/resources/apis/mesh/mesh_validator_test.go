@@ -26,6 +26,11 @@ var _ = Describe("Mesh", func() {
                 dpCert:
                   rotation:
                     expiration: 2y
+              revocation:
+                serials:
+                - 6fa459eaee8a3ca4894edb77e160355e
+                dataplanes:
+                - backend-01
             logging:
               backends:
               - name: file-1
@@ -46,6 +51,19 @@ var _ = Describe("Mesh", func() {
                 type: tcp
                 conf:
                   address: kibana:1234
+              - name: grpc-1
+                type: grpc
+                conf:
+                  path: /path/to/file
+                  address: kibana:1234
+                  url: http://collector.local:8080/logs
+              - name: tcp-3
+                jsonFormat:
+                  start_time: '%START_TIME%'
+                  destination: '%KUMA_DESTINATION_SERVICE%'
+                type: tcp
+                conf:
+                  address: kibana:1234
               defaultBackend: tcp-1
             tracing:
               backends:
@@ -61,6 +79,15 @@ var _ = Describe("Mesh", func() {
                 type: zipkin
                 conf:
                   url: http://zipkin.local:9411/v2/spans
+              - name: datadog
+                type: datadog
+                conf:
+                  address: datadog-agent.local
+                  port: 8126
+              - name: otel
+                type: opentelemetry
+                conf:
+                  address: otel-collector.local:55678
               defaultBackend: zipkin-us
             metrics:
               enabledBackend: prom-1
@@ -70,6 +97,12 @@ var _ = Describe("Mesh", func() {
                 conf:
                   port: 5670
                   path: /metrics
+            rateLimits:
+              service:
+                address: ratelimit.local:8081
+                domain: kuma
+                timeout: 50ms
+                failureModeDeny: true
 `
 			mesh := NewMeshResource()
 
@@ -145,6 +178,26 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: mtls.dpcert.rotation.expiration
                   message: has to be a valid format`,
+			}),
+			Entry("invalid revocation", testCase{
+				mesh: `
+                mtls:
+                  enabledBackend: backend-1
+                  backends:
+                  - name: backend-1
+                    type: builtin
+                  revocation:
+                    serials:
+                    - 6fa459eaee8a3ca4894edb77e160355e
+                    - not-a-serial
+                    dataplanes:
+                    - ""`,
+				expected: `
+                violations:
+                - field: mtls.revocation.serials[1]
+                  message: has to be a serial number in hexadecimal format
+                - field: mtls.revocation.dataplanes[0]
+                  message: cannot be empty`,
 			}),
 			Entry("logging backend with empty name", testCase{
 				mesh: `
@@ -203,6 +256,28 @@ var _ = Describe("Mesh", func() {
 				expected: `
                 violations:
                 - field: logging.backends[0].config.address
+                  message: has to be in format of HOST:PORT`,
+			}),
+			Entry("rate limit service address is empty", testCase{
+				mesh: `
+                rateLimits:
+                  service:
+                    timeout: 0s`,
+				expected: `
+                violations:
+                - field: rateLimits.service.address
+                  message: cannot be empty
+                - field: rateLimits.service.timeout
+                  message: must be greater than 0`,
+			}),
+			Entry("rate limit service address is invalid", testCase{
+				mesh: `
+                rateLimits:
+                  service:
+                    address: ratelimit.local`,
+				expected: `
+                violations:
+                - field: rateLimits.service.address
                   message: has to be in format of HOST:PORT`,
 			}),
 			Entry("file logging path is empty", testCase{
@@ -233,6 +308,69 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: logging.backends[0].format
                   message: 'format string is not valid: expected a command operator to start at position 14, instead got: "%sent_bytes%"'`,
+			}),
+			Entry("invalid access log json format", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    jsonFormat:
+                      start_time: "%START_TIME%"
+                      bytes: "%sent_bytes%"
+                    type: file
+                    conf:
+                      path: /var/logs
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].jsonFormat["bytes"]
+                  message: 'format string is not valid: expected a command operator to start at position 1, instead got: "%sent_bytes%"'`,
+			}),
+			Entry("access log format and json format used together", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    format: "%START_TIME%"
+                    jsonFormat:
+                      start_time: "%START_TIME%"
+                    type: file
+                    conf:
+                      path: /var/logs
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].jsonFormat
+                  message: cannot be used together with format`,
+			}),
+			Entry("grpc logging backend without destinations", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    type: grpc
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].config
+                  message: at least one of path, address or url has to be defined`,
+			}),
+			Entry("grpc logging backend with invalid destinations", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    type: grpc
+                    conf:
+                      address: kibana
+                      url: ftp://collector.local/logs
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].config.address
+                  message: has to be in format of HOST:PORT
+                - field: logging.backends[0].config.url
+                  message: has to be a valid http or https URL`,
 			}),
 			Entry("default backend has to be set to one of the backends", testCase{
 				mesh: `
@@ -317,6 +455,33 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: tracing.backends[0].config.url
                   message: invalid URL`,
+			}),
+			Entry("tracing with datadog without address and port", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: datadog
+                    type: datadog
+                    conf: {}`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].config.address
+                  message: cannot be empty
+                - field: tracing.backends[0].config.port
+                  message: has to be in [1 - 65535] range`,
+			}),
+			Entry("tracing with opentelemetry with invalid address", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: otel
+                    type: opentelemetry
+                    conf:
+                      address: otel-collector`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].config.address
+                  message: has to be in format of HOST:PORT`,
 			}),
 			Entry("tracing with zipkin with valid url but without port", testCase{
 				mesh: `
@@ -402,9 +567,9 @@ var _ = Describe("Mesh", func() {
 `,
 				expected: `violations:
                 - field: logging.backends[0].type
-                  message: 'unknown backend type. Available backends: "tcp", "file"'
+                  message: 'unknown backend type. Available backends: "tcp", "file", "grpc"'
                 - field: tracing.backends[0].type
-                  message: 'unknown backend type. Available backends: "zipkin"'
+                  message: 'unknown backend type. Available backends: "zipkin", "datadog", "opentelemetry"'
                 - field: metrics.backends[0].type
                   message: 'unknown backend type. Available backends: "prometheus"'`,
 			}),
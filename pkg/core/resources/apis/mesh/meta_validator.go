@@ -1,7 +1,11 @@
 package mesh
 
 import (
+	"fmt"
 	"regexp"
+	"sort"
+
+	k8s_validation "k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/validators"
@@ -33,3 +37,23 @@ func ValidateMesh(mesh string, scope model.ResourceScope) validators.ValidationE
 	}
 	return err
 }
+
+func ValidateLabels(labels map[string]string) validators.ValidationError {
+	var err validators.ValidationError
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := labels[key]
+		path := validators.RootedAt("labels").Key(key)
+		for _, msg := range k8s_validation.IsQualifiedName(key) {
+			err.AddViolationAt(path, fmt.Sprintf("key %s", msg))
+		}
+		for _, msg := range k8s_validation.IsValidLabelValue(value) {
+			err.AddViolationAt(path, fmt.Sprintf("value %s", msg))
+		}
+	}
+	return err
+}
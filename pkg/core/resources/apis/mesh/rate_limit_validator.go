@@ -43,16 +43,23 @@ func (d *RateLimitResource) validateConf() (err validators.ValidationError) {
 		err.Add(d.validateHttp(root.Field("http"), d.Spec.GetConf().GetHttp()))
 	}
 
+	if d.Spec.GetConf().GetTcp() != nil {
+		err.Add(d.validateTcp(root.Field("tcp"), d.Spec.GetConf().GetTcp()))
+	}
+
 	return
 }
 
 func (d *RateLimitResource) validateHttp(path validators.PathBuilder, http *v1alpha1.RateLimit_Conf_Http) (err validators.ValidationError) {
-	if http.GetRequests() == 0 {
-		err.AddViolationAt(path.Field("requests"), "requests must be set")
-	}
+	// in GLOBAL mode limits are defined in the rate limit service
+	if http.GetMode() == v1alpha1.RateLimit_Conf_Http_LOCAL {
+		if http.GetRequests() == 0 {
+			err.AddViolationAt(path.Field("requests"), "requests must be set")
+		}
 
-	if http.GetInterval() == nil {
-		err.AddViolationAt(path.Field("interval"), "interval must be set")
+		if http.GetInterval() == nil {
+			err.AddViolationAt(path.Field("interval"), "interval must be set")
+		}
 	}
 
 	if http.GetOnRateLimit() != nil {
@@ -62,6 +69,22 @@ func (d *RateLimitResource) validateHttp(path validators.PathBuilder, http *v1al
 	return
 }
 
+func (d *RateLimitResource) validateTcp(path validators.PathBuilder, tcp *v1alpha1.RateLimit_Conf_Tcp) (err validators.ValidationError) {
+	if tcp.GetConnections() == 0 {
+		err.AddViolationAt(path.Field("connections"), "connections must be set")
+	}
+
+	if tcp.GetInterval() == nil {
+		err.AddViolationAt(path.Field("interval"), "interval must be set")
+	}
+
+	if !d.Spec.AppliesToAllSources() {
+		err.AddViolation("sources", "must match every service (kuma.io/service: '*') when conf.tcp is defined, TCP connections cannot be limited per source")
+	}
+
+	return
+}
+
 func (d *RateLimitResource) validateOnRateLimit(path validators.PathBuilder, onRateLimit *v1alpha1.RateLimit_Conf_Http_OnRateLimit) (err validators.ValidationError) {
 	for i, h := range onRateLimit.GetHeaders() {
 		if h.Key == "" {
@@ -71,6 +71,29 @@ var _ = Describe("RateLimit", func() {
                         - key: "x-kuma-rate-limit"
                           value: "true"
                           append: true`),
+			Entry("global mode", `
+                sources:
+                - match:
+                    kuma.io/service: frontend
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http:
+                    mode: GLOBAL
+                    onRateLimit:
+                      status: 429`),
+			Entry("tcp", `
+                sources:
+                - match:
+                    kuma.io/service: '*'
+                destinations:
+                - match:
+                    kuma.io/service: redis
+                conf:
+                  tcp:
+                    connections: 10
+                    interval: 1s`),
 		)
 
 		type testCase struct {
@@ -213,6 +236,44 @@ var _ = Describe("RateLimit", func() {
                   message: key must be set
                 - field: conf.http.onRateLimit.header["0"]
                   message: value must be set
+`,
+			}),
+			Entry("tcp", testCase{
+				ratelimit: `
+                sources:
+                - match:
+                    kuma.io/service: '*'
+                destinations:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  tcp: {}
+`,
+				expected: `
+                violations:
+                - field: conf.tcp.connections
+                  message: connections must be set
+                - field: conf.tcp.interval
+                  message: interval must be set
+`,
+			}),
+			Entry("tcp with source-scoped sources", testCase{
+				ratelimit: `
+                sources:
+                - match:
+                    kuma.io/service: frontend
+                destinations:
+                - match:
+                    kuma.io/service: redis
+                conf:
+                  tcp:
+                    connections: 100
+                    interval: 1s
+`,
+				expected: `
+                violations:
+                - field: sources
+                  message: "must match every service (kuma.io/service: '*') when conf.tcp is defined, TCP connections cannot be limited per source"
 `,
 			}),
 		)
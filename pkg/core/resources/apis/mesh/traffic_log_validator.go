@@ -1,6 +1,7 @@
 package mesh
 
 import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
 )
 
@@ -8,6 +9,7 @@ func (d *TrafficLogResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(d.validateSources())
 	err.Add(d.validateDestinations())
+	err.Add(d.validateConf())
 	// d.Spec.Conf and d.Spec.Conf.DefaultBackend can be empty, then default backend of the mesh is chosen.
 	return err.OrNil()
 }
@@ -25,3 +27,40 @@ func (d *TrafficLogResource) validateSources() validators.ValidationError {
 func (d *TrafficLogResource) validateDestinations() (err validators.ValidationError) {
 	return ValidateSelectors(validators.RootedAt("destinations"), d.Spec.Destinations, OnlyServiceTagAllowed)
 }
+
+func (d *TrafficLogResource) validateConf() (err validators.ValidationError) {
+	for i, filter := range d.Spec.GetConf().GetFilters() {
+		err.Add(validateTrafficLogFilter(validators.RootedAt("conf").Field("filters").Index(i), filter))
+	}
+	return
+}
+
+func validateTrafficLogFilter(pathBuilder validators.PathBuilder, filter *mesh_proto.TrafficLog_Conf_Filter) (err validators.ValidationError) {
+	if filter.GetStatusCodes() == nil && filter.GetMinDuration() == nil && filter.GetMatch() == nil && filter.GetSampling() == nil {
+		err.AddViolationAt(pathBuilder, `must contain at least one of the elements: "statusCodes", "minDuration", "match" or "sampling"`)
+		return
+	}
+	if statusCodes := filter.GetStatusCodes(); statusCodes != nil {
+		if statusCodes.GetMin() < 100 || statusCodes.GetMin() > 599 {
+			err.AddViolationAt(pathBuilder.Field("statusCodes").Field("min"), "must be in inclusive range [100, 599]")
+		}
+		if statusCodes.GetMax() < 100 || statusCodes.GetMax() > 599 {
+			err.AddViolationAt(pathBuilder.Field("statusCodes").Field("max"), "must be in inclusive range [100, 599]")
+		}
+		if statusCodes.GetMin() > statusCodes.GetMax() {
+			err.AddViolationAt(pathBuilder.Field("statusCodes"), "min cannot be greater than max")
+		}
+	}
+	if filter.GetMinDuration() != nil {
+		err.Add(ValidateDuration(pathBuilder.Field("minDuration"), filter.GetMinDuration()))
+	}
+	if filter.GetMatch() != nil {
+		err.Add(validateHTTPMatch(pathBuilder.Field("match"), filter.GetMatch()))
+	}
+	if filter.GetSampling() != nil {
+		if sampling := filter.GetSampling().GetValue(); sampling < 0.0 || sampling > 100.0 {
+			err.AddViolationAt(pathBuilder.Field("sampling"), "must be in inclusive range [0.0, 100.0]")
+		}
+	}
+	return
+}
@@ -127,8 +127,80 @@ var _ = Describe("TrafficLog", func() {
                   message: must consist of exactly one tag "kuma.io/service"
                 - field: destinations[1].match
                   message: mandatory tag "kuma.io/service" is missing
+`,
+			}),
+			Entry("invalid filters", testCase{
+				trafficLog: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  filters:
+                  - {}
+                  - statusCodes:
+                      min: 600
+                      max: 500
+                    minDuration: 0s
+                    match:
+                      path:
+                        prefix: ""
+                    sampling: 101
+`,
+				expected: `
+                violations:
+                - field: conf.filters[0]
+                  message: 'must contain at least one of the elements: "statusCodes", "minDuration", "match" or "sampling"'
+                - field: conf.filters[1].statusCodes.min
+                  message: must be in inclusive range [100, 599]
+                - field: conf.filters[1].statusCodes
+                  message: min cannot be greater than max
+                - field: conf.filters[1].minDuration
+                  message: must have a positive value
+                - field: conf.filters[1].match.path.prefix
+                  message: cannot be empty
+                - field: conf.filters[1].sampling
+                  message: must be in inclusive range [0.0, 100.0]
 `,
 			}),
 		)
+
+		It("should pass validation with filters", func() {
+			// given
+			trafficLog := NewTrafficLogResource()
+			err := util_proto.FromYAML([]byte(`
+            sources:
+            - match:
+                kuma.io/service: web
+            destinations:
+            - match:
+                kuma.io/service: backend
+            conf:
+              backend: file
+              filters:
+              - statusCodes:
+                  min: 500
+                  max: 599
+              - statusCodes:
+                  min: 200
+                  max: 499
+                minDuration: 1s
+                match:
+                  method:
+                    exact: GET
+                  path:
+                    prefix: /api
+                sampling: 1.0
+`), trafficLog.Spec)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = trafficLog.Validate()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
 	})
 })
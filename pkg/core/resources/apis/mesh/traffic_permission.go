@@ -94,3 +94,7 @@ func init() {
 	registry.RegisterType(NewTrafficPermissionResource())
 	registry.RegistryListType(&TrafficPermissionResourceList{})
 }
+
+func (t *TrafficPermissionResource) IsDeny() bool {
+	return t.Spec.GetAction() == mesh_proto.TrafficPermission_DENY
+}
@@ -1,13 +1,20 @@
 package mesh
 
 import (
+	"sort"
+	"strings"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
 )
 
+var allowedHTTPMethods = []string{"GET", "HEAD", "POST", "PUT", "DELETE", "CONNECT", "OPTIONS", "TRACE", "PATCH"}
+
 func (d *TrafficPermissionResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(d.validateSources())
 	err.Add(d.validateDestinations())
+	err.Add(d.validateConf())
 	return err.OrNil()
 }
 
@@ -28,3 +35,60 @@ func (d *TrafficPermissionResource) validateDestinations() (err validators.Valid
 		},
 	})
 }
+
+func (d *TrafficPermissionResource) validateConf() (err validators.ValidationError) {
+	http := d.Spec.GetConf().GetHttp()
+	if http == nil {
+		return
+	}
+	if d.IsDeny() {
+		err.AddViolationAt(validators.RootedAt("conf").Field("http"), `cannot be defined when action is "DENY"`)
+		return
+	}
+	path := validators.RootedAt("conf").Field("http").Field("rules")
+	if len(http.GetRules()) == 0 {
+		err.AddViolationAt(path, "must have at least one element")
+	}
+	for i, rule := range http.GetRules() {
+		err.Add(validateTrafficPermissionHTTPRule(path.Index(i), rule))
+	}
+	return
+}
+
+func validateTrafficPermissionHTTPRule(pathBuilder validators.PathBuilder, rule *mesh_proto.TrafficPermission_Conf_Http_Rule) (err validators.ValidationError) {
+	if len(rule.GetMethods()) == 0 && rule.GetPath() == nil && len(rule.GetHeaders()) == 0 {
+		err.AddViolationAt(pathBuilder, `must have at least one of "methods", "path" or "headers" defined`)
+		return
+	}
+	for i, method := range rule.GetMethods() {
+		if !isAllowedHTTPMethod(method) {
+			err.AddViolationAt(pathBuilder.Field("methods").Index(i), `must be one of: "`+strings.Join(allowedHTTPMethods, `", "`)+`"`)
+		}
+	}
+	if rule.GetPath() != nil {
+		err.Add(validateStringMatcher(pathBuilder.Field("path"), rule.GetPath()))
+	}
+	// sort keys for consistency
+	var keys []string
+	for key := range rule.GetHeaders() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		path := pathBuilder.Field("headers").Key(key)
+		if len(key) == 0 {
+			err.AddViolationAt(path, "cannot be empty")
+		}
+		err.Add(validateStringMatcher(path, rule.GetHeaders()[key]))
+	}
+	return
+}
+
+func isAllowedHTTPMethod(method string) bool {
+	for _, allowed := range allowedHTTPMethods {
+		if method == allowed {
+			return true
+		}
+	}
+	return false
+}
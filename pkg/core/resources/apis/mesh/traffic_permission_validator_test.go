@@ -12,6 +12,39 @@ import (
 
 var _ = Describe("TrafficPermission", func() {
 	Describe("Validate()", func() {
+		It("should pass validation", func() {
+			// given
+			spec := `
+            sources:
+            - match:
+                kuma.io/service: web
+            destinations:
+            - match:
+                kuma.io/service: backend
+            conf:
+              http:
+                rules:
+                - methods: [GET, HEAD]
+                  path:
+                    prefix: /api
+                - headers:
+                    x-role:
+                      exact: admin
+`
+			permission := NewTrafficPermissionResource()
+
+			// when
+			err := util_proto.FromYAML([]byte(spec), permission.Spec)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			verr := permission.Validate()
+
+			// then
+			Expect(verr).ToNot(HaveOccurred())
+		})
+
 		type testCase struct {
 			permission string
 			expected   string
@@ -111,6 +144,77 @@ var _ = Describe("TrafficPermission", func() {
                   message: tag value must be non-empty
                 - field: destinations[1].match
                   message: must have at least one tag
+`,
+			}),
+			Entry("http without rules", testCase{
+				permission: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http: {}
+`,
+				expected: `
+                violations:
+                - field: conf.http.rules
+                  message: must have at least one element
+`,
+			}),
+			Entry("http rules with DENY action", testCase{
+				permission: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                action: DENY
+                conf:
+                  http:
+                    rules:
+                    - methods: [GET]
+`,
+				expected: `
+                violations:
+                - field: conf.http
+                  message: cannot be defined when action is "DENY"
+`,
+			}),
+			Entry("invalid http rules", testCase{
+				permission: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http:
+                    rules:
+                    - {}
+                    - methods: [GET, FETCH]
+                      path:
+                        prefix: ""
+                      headers:
+                        x-role: {}
+                        x-tenant:
+                          regex: ""
+`,
+				expected: `
+                violations:
+                - field: conf.http.rules[0]
+                  message: must have at least one of "methods", "path" or "headers" defined
+                - field: conf.http.rules[1].methods[1]
+                  message: 'must be one of: "GET", "HEAD", "POST", "PUT", "DELETE", "CONNECT", "OPTIONS", "TRACE", "PATCH"'
+                - field: conf.http.rules[1].path.prefix
+                  message: cannot be empty
+                - field: conf.http.rules[1].headers["x-role"]
+                  message: 'cannot be empty. Available options: "exact", "split" or "regex"'
+                - field: conf.http.rules[1].headers["x-tenant"].regex
+                  message: cannot be empty
 `,
 			}),
 		)
@@ -43,7 +43,7 @@ func (d *TrafficRouteResource) validateConf() (err validators.ValidationError) {
 }
 
 func (d *TrafficRouteResource) validateHTTP(pathBuilder validators.PathBuilder, http *mesh_proto.TrafficRoute_Http) (err validators.ValidationError) {
-	err.Add(d.validateHTTPMatch(pathBuilder.Field("match"), http.GetMatch()))
+	err.Add(validateHTTPMatch(pathBuilder.Field("match"), http.GetMatch()))
 	err.Add(d.validateHTTPModify(pathBuilder.Field("modify"), http.GetModify(), http.GetMatch()))
 	err.Add(d.validateSplitAndDestination(pathBuilder, http.GetSplit(), http.GetDestination()))
 	return
@@ -154,16 +154,16 @@ func (d *TrafficRouteResource) validateSplitAndDestination(pathBuilder validator
 	return
 }
 
-func (d *TrafficRouteResource) validateHTTPMatch(pathBuilder validators.PathBuilder, match *mesh_proto.TrafficRoute_Http_Match) (err validators.ValidationError) {
+func validateHTTPMatch(pathBuilder validators.PathBuilder, match *mesh_proto.TrafficRoute_Http_Match) (err validators.ValidationError) {
 	if match.GetPath() == nil && match.GetMethod() == nil && match.GetHeaders() == nil {
 		err.AddViolationAt(pathBuilder, `must be present and contain at least one of the elements: "method", "path" or "headers"`)
 		return
 	}
 	if match.GetMethod() != nil {
-		err.Add(d.validateStringMatcher(pathBuilder.Field("method"), match.GetMethod()))
+		err.Add(validateStringMatcher(pathBuilder.Field("method"), match.GetMethod()))
 	}
 	if match.GetPath() != nil {
-		err.Add(d.validateStringMatcher(pathBuilder.Field("path"), match.GetPath()))
+		err.Add(validateStringMatcher(pathBuilder.Field("path"), match.GetPath()))
 	}
 	if match.GetHeaders() != nil && len(match.GetHeaders()) == 0 {
 		err.AddViolationAt(pathBuilder.Field("headers"), "must contain at least one element")
@@ -173,12 +173,12 @@ func (d *TrafficRouteResource) validateHTTPMatch(pathBuilder validators.PathBuil
 		if len(key) == 0 {
 			err.AddViolationAt(path, "cannot be empty")
 		}
-		err.Add(d.validateStringMatcher(path, matcher))
+		err.Add(validateStringMatcher(path, matcher))
 	}
 	return
 }
 
-func (d *TrafficRouteResource) validateStringMatcher(pathBuilder validators.PathBuilder, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) (err validators.ValidationError) {
+func validateStringMatcher(pathBuilder validators.PathBuilder, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) (err validators.ValidationError) {
 	switch matcher.GetMatcherType().(type) {
 	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact:
 	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix:
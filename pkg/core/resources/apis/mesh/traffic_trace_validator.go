@@ -1,12 +1,16 @@
 package mesh
 
 import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
 )
 
 func (d *TrafficTraceResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(d.validateSelectors())
+	err.Add(d.validateConf())
 	// d.Spec.Conf and d.Spec.Conf.DefaultBackend can be empty, then default backend of the mesh is chosen.
 	return err.OrNil()
 }
@@ -19,3 +23,54 @@ func (d *TrafficTraceResource) validateSelectors() validators.ValidationError {
 		},
 	})
 }
+
+func (d *TrafficTraceResource) validateConf() (err validators.ValidationError) {
+	path := validators.RootedAt("conf")
+	if sampling := d.Spec.GetConf().GetSampling(); sampling != nil {
+		err.Add(validateTracingPercentage(path.Field("sampling").Field("random"), sampling.GetRandom()))
+		err.Add(validateTracingPercentage(path.Field("sampling").Field("client"), sampling.GetClient()))
+		err.Add(validateTracingPercentage(path.Field("sampling").Field("overall"), sampling.GetOverall()))
+	}
+	usedNames := map[string]bool{}
+	for i, tag := range d.Spec.GetConf().GetTags() {
+		tagPath := path.Field("tags").Index(i)
+		if tag.GetName() == "" {
+			err.AddViolationAt(tagPath.Field("name"), "cannot be empty")
+		} else if usedNames[tag.GetName()] {
+			err.AddViolationAt(tagPath.Field("name"), "tag with the same name is already defined")
+		}
+		usedNames[tag.GetName()] = true
+		err.Add(validateTracingTagSource(tagPath, tag))
+	}
+	return
+}
+
+func validateTracingPercentage(path validators.PathBuilder, percentage *wrappers.DoubleValue) (err validators.ValidationError) {
+	if percentage != nil && (percentage.GetValue() < 0.0 || percentage.GetValue() > 100.0) {
+		err.AddViolationAt(path, "has to be in [0.0 - 100.0] range")
+	}
+	return
+}
+
+func validateTracingTagSource(path validators.PathBuilder, tag *mesh_proto.TrafficTrace_Conf_Tag) (err validators.ValidationError) {
+	sources := 0
+	if tag.GetLiteral() != "" {
+		sources++
+	}
+	if tag.GetHeader() != nil {
+		sources++
+		if tag.GetHeader().GetName() == "" {
+			err.AddViolationAt(path.Field("header").Field("name"), "cannot be empty")
+		}
+	}
+	if tag.GetEnvironment() != nil {
+		sources++
+		if tag.GetEnvironment().GetName() == "" {
+			err.AddViolationAt(path.Field("environment").Field("name"), "cannot be empty")
+		}
+	}
+	if sources != 1 {
+		err.AddViolationAt(path, `has to have exactly one of the elements: "literal", "header" or "environment"`)
+	}
+	return
+}
@@ -47,6 +47,27 @@ var _ = Describe("TrafficTrace", func() {
                 - match:
                     region: eu`,
 			),
+			Entry("sampling and custom tags", `
+                selectors:
+                - match:
+                    region: eu
+                conf:
+                  backend: zipkin-eu
+                  sampling:
+                    random: 10.5
+                    client: 100
+                    overall: 50
+                  tags:
+                  - name: team
+                    literal: core
+                  - name: user
+                    header:
+                      name: x-user-id
+                      default: anonymous
+                  - name: pod
+                    environment:
+                      name: HOSTNAME`,
+			),
 		)
 
 		type testCase struct {
@@ -123,6 +144,43 @@ var _ = Describe("TrafficTrace", func() {
                   message: tag value must be non-empty
                 - field: selectors[1].match
                   message: must have at least one tag
+`,
+			}),
+			Entry("invalid sampling and custom tags", testCase{
+				trafficTrace: `
+                selectors:
+                - match:
+                    region: eu
+                conf:
+                  sampling:
+                    random: 101
+                    overall: -1
+                  tags:
+                  - literal: core
+                  - name: user
+                    literal: core
+                    header:
+                      name: x-user-id
+                  - name: user
+                    environment: {}
+                  - name: empty
+`,
+				expected: `
+                violations:
+                - field: conf.sampling.random
+                  message: has to be in [0.0 - 100.0] range
+                - field: conf.sampling.overall
+                  message: has to be in [0.0 - 100.0] range
+                - field: conf.tags[0].name
+                  message: cannot be empty
+                - field: conf.tags[1]
+                  message: 'has to have exactly one of the elements: "literal", "header" or "environment"'
+                - field: conf.tags[2].name
+                  message: tag with the same name is already defined
+                - field: conf.tags[2].environment.name
+                  message: cannot be empty
+                - field: conf.tags[3]
+                  message: 'has to have exactly one of the elements: "literal", "header" or "environment"'
 `,
 			}),
 		)
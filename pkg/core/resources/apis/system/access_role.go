@@ -0,0 +1,97 @@
+package system
+
+import (
+	"errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+)
+
+const (
+	AccessRoleType model.ResourceType = "AccessRole"
+)
+
+var _ model.Resource = &AccessRoleResource{}
+
+type AccessRoleResource struct {
+	Meta model.ResourceMeta
+	Spec *system_proto.AccessRole
+}
+
+func NewAccessRoleResource() *AccessRoleResource {
+	return &AccessRoleResource{
+		Spec: &system_proto.AccessRole{},
+	}
+}
+
+func (t *AccessRoleResource) GetType() model.ResourceType {
+	return AccessRoleType
+}
+
+func (t *AccessRoleResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *AccessRoleResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *AccessRoleResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *AccessRoleResource) SetSpec(spec model.ResourceSpec) error {
+	value, ok := spec.(*system_proto.AccessRole)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		t.Spec = value
+		return nil
+	}
+}
+
+func (t *AccessRoleResource) Scope() model.ResourceScope {
+	return model.ScopeGlobal
+}
+
+var _ model.ResourceList = &AccessRoleResourceList{}
+
+type AccessRoleResourceList struct {
+	Items      []*AccessRoleResource
+	Pagination model.Pagination
+}
+
+func (l *AccessRoleResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *AccessRoleResourceList) GetItemType() model.ResourceType {
+	return AccessRoleType
+}
+
+func (l *AccessRoleResourceList) NewItem() model.Resource {
+	return NewAccessRoleResource()
+}
+
+func (l *AccessRoleResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*AccessRoleResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*AccessRoleResource)(nil), r)
+	}
+}
+
+func (l *AccessRoleResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+func init() {
+	registry.RegisterType(NewAccessRoleResource())
+	registry.RegistryListType(&AccessRoleResourceList{})
+}
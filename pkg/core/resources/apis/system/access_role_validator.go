@@ -0,0 +1,101 @@
+package system
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// Actions that can be granted by AccessRole
+const (
+	AccessRoleActionGet    = "get"
+	AccessRoleActionList   = "list"
+	AccessRoleActionCreate = "create"
+	AccessRoleActionUpdate = "update"
+	AccessRoleActionDelete = "delete"
+	// AccessRoleWildcard matches all actions, types or meshes
+	AccessRoleWildcard = "*"
+)
+
+var AccessRoleActions = []string{
+	AccessRoleActionGet,
+	AccessRoleActionList,
+	AccessRoleActionCreate,
+	AccessRoleActionUpdate,
+	AccessRoleActionDelete,
+}
+
+func (r *AccessRoleResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(r.validateRules())
+	err.Add(r.validateSubjects())
+	return err.OrNil()
+}
+
+func (r *AccessRoleResource) validateRules() validators.ValidationError {
+	var err validators.ValidationError
+	path := validators.RootedAt("rules")
+	if len(r.Spec.GetRules()) == 0 {
+		err.AddViolationAt(path, "must have at least one element")
+	}
+	for i, rule := range r.Spec.GetRules() {
+		rulePath := path.Index(i)
+		if len(rule.GetActions()) == 0 {
+			err.AddViolationAt(rulePath.Field("actions"), "must have at least one element")
+		}
+		for j, action := range rule.GetActions() {
+			if !isValidAccessRoleAction(action) {
+				err.AddViolationAt(rulePath.Field("actions").Index(j), fmt.Sprintf("must be one of %s or %q", strings.Join(quoted(AccessRoleActions), ", "), AccessRoleWildcard))
+			}
+		}
+		if len(rule.GetTypes()) == 0 {
+			err.AddViolationAt(rulePath.Field("types"), "must have at least one element")
+		}
+		for j, typ := range rule.GetTypes() {
+			if typ == "" {
+				err.AddViolationAt(rulePath.Field("types").Index(j), "cannot be empty")
+			}
+		}
+		for j, mesh := range rule.GetMeshes() {
+			if mesh == "" {
+				err.AddViolationAt(rulePath.Field("meshes").Index(j), "cannot be empty")
+			}
+		}
+	}
+	return err
+}
+
+func (r *AccessRoleResource) validateSubjects() validators.ValidationError {
+	var err validators.ValidationError
+	path := validators.RootedAt("subjects")
+	if len(r.Spec.GetSubjects()) == 0 {
+		err.AddViolationAt(path, "must have at least one element")
+	}
+	for i, subject := range r.Spec.GetSubjects() {
+		if (subject.GetUser() == "") == (subject.GetGroup() == "") {
+			err.AddViolationAt(path.Index(i), `either "user" or "group" has to be defined`)
+		}
+	}
+	return err
+}
+
+func isValidAccessRoleAction(action string) bool {
+	if action == AccessRoleWildcard {
+		return true
+	}
+	for _, a := range AccessRoleActions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+func quoted(values []string) []string {
+	var result []string
+	for _, value := range values {
+		result = append(result, fmt.Sprintf("%q", value))
+	}
+	return result
+}
@@ -0,0 +1,103 @@
+package system_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("AccessRole", func() {
+	Describe("Validate()", func() {
+		It("should pass validation", func() {
+			// given
+			accessRole := NewAccessRoleResource()
+			err := util_proto.FromYAML([]byte(`
+                rules:
+                - actions: ["get", "list"]
+                  types: ["*"]
+                - actions: ["*"]
+                  types: ["TrafficPermission", "Secret"]
+                  meshes: ["default"]
+                subjects:
+                - user: john
+                - group: mesh-operators`), accessRole.Spec)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			verr := accessRole.Validate()
+
+			// then
+			Expect(verr).ToNot(HaveOccurred())
+		})
+
+		type testCase struct {
+			accessRole string
+			expected   string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				accessRole := NewAccessRoleResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.accessRole), accessRole.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := accessRole.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				accessRole: ``,
+				expected: `
+                violations:
+                - field: rules
+                  message: must have at least one element
+                - field: subjects
+                  message: must have at least one element
+`,
+			}),
+			Entry("invalid rules and subjects", testCase{
+				accessRole: `
+                rules:
+                - actions: ["watch"]
+                  types: [""]
+                  meshes: [""]
+                - {}
+                subjects:
+                - user: john
+                  group: admins
+                - {}
+`,
+				expected: `
+                violations:
+                - field: rules[0].actions[0]
+                  message: must be one of "get", "list", "create", "update", "delete" or "*"
+                - field: rules[0].types[0]
+                  message: cannot be empty
+                - field: rules[0].meshes[0]
+                  message: cannot be empty
+                - field: rules[1].actions
+                  message: must have at least one element
+                - field: rules[1].types
+                  message: must have at least one element
+                - field: subjects[0]
+                  message: either "user" or "group" has to be defined
+                - field: subjects[1]
+                  message: either "user" or "group" has to be defined
+`,
+			}),
+		)
+	})
+})
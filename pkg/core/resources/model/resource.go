@@ -86,6 +86,7 @@ type ResourceMeta interface {
 	GetMesh() string
 	GetCreationTime() time.Time
 	GetModificationTime() time.Time
+	GetLabels() map[string]string
 }
 
 func MetaToResourceKey(meta ResourceMeta) ResourceKey {
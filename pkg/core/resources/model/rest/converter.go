@@ -19,6 +19,7 @@ func (c *from) Resource(r model.Resource) *Resource {
 			Mesh:             meshName,
 			Type:             string(r.GetType()),
 			Name:             r.GetMeta().GetName(),
+			Labels:           r.GetMeta().GetLabels(),
 			CreationTime:     r.GetMeta().GetCreationTime(),
 			ModificationTime: r.GetMeta().GetModificationTime(),
 		},
@@ -12,11 +12,12 @@ import (
 )
 
 type ResourceMeta struct {
-	Type             string    `json:"type"`
-	Mesh             string    `json:"mesh,omitempty"`
-	Name             string    `json:"name"`
-	CreationTime     time.Time `json:"creationTime"`
-	ModificationTime time.Time `json:"modificationTime"`
+	Type             string            `json:"type"`
+	Mesh             string            `json:"mesh,omitempty"`
+	Name             string            `json:"name"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	CreationTime     time.Time         `json:"creationTime"`
+	ModificationTime time.Time         `json:"modificationTime"`
 }
 
 func (r *ResourceMeta) GetName() string {
@@ -43,6 +44,10 @@ func (r *ResourceMeta) GetModificationTime() time.Time {
 	return r.ModificationTime
 }
 
+func (r *ResourceMeta) GetLabels() map[string]string {
+	return r.Labels
+}
+
 var _ model.ResourceMeta = &ResourceMeta{}
 
 type Resource struct {
@@ -0,0 +1,23 @@
+package rest
+
+type WatchEventType string
+
+const (
+	WatchEventAdded    WatchEventType = "ADDED"
+	WatchEventModified WatchEventType = "MODIFIED"
+	WatchEventDeleted  WatchEventType = "DELETED"
+	// WatchEventError is sent when the watch cannot be continued, e.g. the client fell too far behind.
+	// The client has to list the resources again and start a new watch.
+	WatchEventError WatchEventType = "ERROR"
+)
+
+// WatchEvent is a change of the resource streamed by the API Server when the list of resources is requested with ?watch=true.
+// ResourceVersion identifies the position in the stream of changes. It can be passed to the next watch to resume the stream.
+//
+// Object is the current state of the resource for ADDED and MODIFIED events and only the meta of the resource for DELETED event.
+type WatchEvent struct {
+	Type            WatchEventType `json:"type"`
+	ResourceVersion string         `json:"resourceVersion"`
+	Object          *Resource      `json:"object,omitempty"`
+	Message         string         `json:"message,omitempty"`
+}
@@ -0,0 +1,56 @@
+package store
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ParseLabelSelector parses the equality based label selector in the format "key1=value1,key2=value2".
+func ParseLabelSelector(selector string) (map[string]string, error) {
+	labels := map[string]string{}
+	if strings.TrimSpace(selector) == "" {
+		return labels, nil
+	}
+	for _, requirement := range strings.Split(selector, ",") {
+		parts := strings.SplitN(requirement, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid requirement %q, expected format key=value", requirement)
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(strings.TrimPrefix(parts[1], "=")) // "==" is accepted as well
+		if key == "" {
+			return nil, errors.Errorf("invalid requirement %q, key cannot be empty", requirement)
+		}
+		if existing, ok := labels[key]; ok && existing != value {
+			return nil, errors.Errorf("invalid requirement %q, key %q is already selected with value %q", requirement, key, existing)
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+// FormatLabelSelector formats the label selector, so it can be parsed with ParseLabelSelector. Keys are sorted.
+func FormatLabelSelector(selector map[string]string) string {
+	keys := make([]string, 0, len(selector))
+	for key := range selector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	requirements := make([]string, 0, len(keys))
+	for _, key := range keys {
+		requirements = append(requirements, key+"="+selector[key])
+	}
+	return strings.Join(requirements, ",")
+}
+
+// MatchesLabels returns true if the labels contain all of the labels of the selector.
+func MatchesLabels(selector map[string]string, labels map[string]string) bool {
+	for key, value := range selector {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
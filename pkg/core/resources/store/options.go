@@ -2,6 +2,7 @@ package store
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
@@ -13,6 +14,7 @@ type CreateOptions struct {
 	CreationTime time.Time
 	Owner        core_model.Resource
 	Synced       bool
+	Labels       map[string]string
 }
 
 type CreateOptionsFunc func(*CreateOptions)
@@ -54,9 +56,17 @@ func CreateSynced() CreateOptionsFunc {
 	}
 }
 
+func CreateWithLabels(labels map[string]string) CreateOptionsFunc {
+	return func(opts *CreateOptions) {
+		opts.Labels = labels
+	}
+}
+
 type UpdateOptions struct {
 	ModificationTime time.Time
 	Synced           bool
+	// Labels replace the labels of the resource. If nil, the labels are not changed.
+	Labels map[string]string
 }
 
 func ModifiedAt(modificationTime time.Time) UpdateOptionsFunc {
@@ -71,6 +81,26 @@ func UpdateSynced() UpdateOptionsFunc {
 	}
 }
 
+func UpdateWithLabels(labels map[string]string) UpdateOptionsFunc {
+	return func(opts *UpdateOptions) {
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		opts.Labels = labels
+	}
+}
+
+// LabelsOf returns the labels the resource should have after the update.
+func (u *UpdateOptions) LabelsOf(meta core_model.ResourceMeta) map[string]string {
+	if u.Labels != nil {
+		return u.Labels
+	}
+	if meta == nil {
+		return nil
+	}
+	return meta.GetLabels()
+}
+
 type UpdateOptionsFunc func(*UpdateOptions)
 
 func NewUpdateOptions(fs ...UpdateOptionsFunc) *UpdateOptions {
@@ -171,6 +201,10 @@ type ListOptions struct {
 	PageSize   int
 	PageOffset string
 	FilterFunc ListFilterFunc
+	// LabelSelector selects only the resources that have all of the labels.
+	LabelSelector map[string]string
+	// NameContains selects only the resources which name contains the value.
+	NameContains string
 }
 
 type ListOptionsFunc func(*ListOptions)
@@ -205,6 +239,30 @@ func ListByPage(size int, offset string) ListOptionsFunc {
 	}
 }
 
+func ListByLabels(selector map[string]string) ListOptionsFunc {
+	return func(opts *ListOptions) {
+		opts.LabelSelector = selector
+	}
+}
+
+func ListByNameContains(nameContains string) ListOptionsFunc {
+	return func(opts *ListOptions) {
+		opts.NameContains = nameContains
+	}
+}
+
+// Matches returns true if the resource passes the label selector and name filter.
+// It is used by the stores which can't push down the filtering into the underlying database.
+func (l *ListOptions) Matches(meta core_model.ResourceMeta) bool {
+	if l.NameContains != "" && !strings.Contains(meta.GetName(), l.NameContains) {
+		return false
+	}
+	return MatchesLabels(l.LabelSelector, meta.GetLabels())
+}
+
 func (l *ListOptions) HashCode() string {
-	return l.Mesh
+	if len(l.LabelSelector) == 0 && l.NameContains == "" {
+		return l.Mesh
+	}
+	return fmt.Sprintf("%s:%s:%s", l.Mesh, FormatLabelSelector(l.LabelSelector), l.NameContains)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/emicklei/go-restful"
 
+	"github.com/kumahq/kuma/pkg/api-server/authz"
 	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -30,8 +31,12 @@ func HandleError(response *restful.Response, err error, title string) {
 		handleMaxPageSizeExceeded(title, err, response)
 	case err == api_server_types.InvalidPageSize:
 		handleInvalidPageSize(title, response)
-	case issuer.IsSigningKeyNotFoundErr(err):
+	case issuer.IsSigningKeyNotFoundErr(err), err == issuer.UserTokenSigningKeyNotFound:
 		handleSigningKeyNotFound(err, response)
+	case authz.IsAccessDeniedError(err):
+		handleAccessDenied(err, response)
+	case authz.IsUnauthenticatedError(err):
+		handleUnauthenticated(err, response)
 	default:
 		handleUnknownError(err, title, response)
 	}
@@ -140,6 +145,22 @@ func handleSigningKeyNotFound(err error, response *restful.Response) {
 	writeError(response, 404, kumaErr)
 }
 
+func handleAccessDenied(err error, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   "Access Denied",
+		Details: err.Error(),
+	}
+	writeError(response, 403, kumaErr)
+}
+
+func handleUnauthenticated(err error, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   "Unauthenticated",
+		Details: err.Error(),
+	}
+	writeError(response, 401, kumaErr)
+}
+
 func writeError(response *restful.Response, httpStatus int, kumaErr types.Error) {
 	if err := response.WriteHeaderAndJson(httpStatus, kumaErr, "application/json"); err != nil {
 		core.Log.Error(err, "Could not write the error response")
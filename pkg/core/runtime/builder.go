@@ -9,6 +9,7 @@ import (
 	kds_context "github.com/kumahq/kuma/pkg/kds/context"
 
 	api_server "github.com/kumahq/kuma/pkg/api-server/customization"
+	"github.com/kumahq/kuma/pkg/audit"
 	dp_server "github.com/kumahq/kuma/pkg/dp-server/server"
 	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
 
@@ -50,6 +51,7 @@ type BuilderContext interface {
 	XDSHooks() *xds_hooks.Hooks
 	DpServer() *dp_server.DpServer
 	KDSContext() *kds_context.Context
+	AuditLog() audit.Log
 }
 
 var _ BuilderContext = &Builder{}
@@ -77,6 +79,7 @@ type Builder struct {
 	xdsh       *xds_hooks.Hooks
 	dps        *dp_server.DpServer
 	kdsctx     *kds_context.Context
+	auditLog   audit.Log
 	shutdownCh <-chan struct{}
 	*runtimeInfo
 }
@@ -208,6 +211,11 @@ func (b *Builder) WithKDSContext(kdsctx *kds_context.Context) *Builder {
 	return b
 }
 
+func (b *Builder) WithAuditLog(auditLog audit.Log) *Builder {
+	b.auditLog = auditLog
+	return b
+}
+
 func (b *Builder) Build() (Runtime, error) {
 	if b.cm == nil {
 		return nil, errors.Errorf("ComponentManager has not been configured")
@@ -257,6 +265,9 @@ func (b *Builder) Build() (Runtime, error) {
 	if b.kdsctx == nil {
 		return nil, errors.Errorf("KDSContext has not been configured")
 	}
+	if b.auditLog == nil {
+		return nil, errors.Errorf("AuditLog has not been configured")
+	}
 	return &runtime{
 		RuntimeInfo: b.runtimeInfo,
 		RuntimeContext: &runtimeContext{
@@ -279,6 +290,7 @@ func (b *Builder) Build() (Runtime, error) {
 			xdsh:       b.xdsh,
 			dps:        b.dps,
 			kdsctx:     b.kdsctx,
+			auditLog:   b.auditLog,
 			shutdownCh: b.shutdownCh,
 		},
 		Manager: b.cm,
@@ -345,6 +357,9 @@ func (b *Builder) DpServer() *dp_server.DpServer {
 func (b *Builder) KDSContext() *kds_context.Context {
 	return b.kdsctx
 }
+func (b *Builder) AuditLog() audit.Log {
+	return b.auditLog
+}
 func (b *Builder) ShutdownCh() <-chan struct{} {
 	return b.shutdownCh
 }
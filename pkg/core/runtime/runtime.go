@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	api_server "github.com/kumahq/kuma/pkg/api-server/customization"
+	"github.com/kumahq/kuma/pkg/audit"
 	dp_server "github.com/kumahq/kuma/pkg/dp-server/server"
 	"github.com/kumahq/kuma/pkg/envoy/admin"
 	kds_context "github.com/kumahq/kuma/pkg/kds/context"
@@ -62,6 +63,7 @@ type RuntimeContext interface {
 	XDSHooks() *xds_hooks.Hooks
 	DpServer() *dp_server.DpServer
 	KDSContext() *kds_context.Context
+	AuditLog() audit.Log
 	ShutdownCh() <-chan struct{}
 }
 
@@ -121,6 +123,7 @@ type runtimeContext struct {
 	xdsh       *xds_hooks.Hooks
 	dps        *dp_server.DpServer
 	kdsctx     *kds_context.Context
+	auditLog   audit.Log
 	shutdownCh <-chan struct{}
 }
 
@@ -203,6 +206,10 @@ func (rc *runtimeContext) KDSContext() *kds_context.Context {
 	return rc.kdsctx
 }
 
+func (rc *runtimeContext) AuditLog() audit.Log {
+	return rc.auditLog
+}
+
 func (rc *runtimeContext) ShutdownCh() <-chan struct{} {
 	return rc.shutdownCh
 }
@@ -0,0 +1,122 @@
+package cipher
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// encryptedPrefix marks data encrypted by AES-GCM Cipher.
+// Data without the prefix is treated as plain text, so Secrets stored before enabling encryption can still be read.
+var encryptedPrefix = []byte("kuma:aesgcm:v1:")
+
+const aesKeyLength = 32
+
+// Key is an AES-256 key identified by ID. The ID is embedded in the ciphertext, so the key can be rotated.
+type Key struct {
+	ID    string
+	Value []byte
+}
+
+// NewAESGCM returns a Cipher that encrypts data with the primary key and decrypts data with any of the given keys.
+// The output has the following format: prefix | len(keyID) | keyID | nonce | sealed data.
+func NewAESGCM(keys []Key, primaryKeyID string) (Cipher, error) {
+	aeads := map[string]cipher.AEAD{}
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, errors.New("key ID cannot be empty")
+		}
+		if len(key.ID) > 255 {
+			return nil, errors.Errorf("key ID %q cannot be longer than 255 characters", key.ID)
+		}
+		if _, ok := aeads[key.ID]; ok {
+			return nil, errors.Errorf("key ID %q is duplicated", key.ID)
+		}
+		if len(key.Value) != aesKeyLength {
+			return nil, errors.Errorf("key %q has to be %d bytes long", key.ID, aesKeyLength)
+		}
+		aead, err := newAEAD(key.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid key %q", key.ID)
+		}
+		aeads[key.ID] = aead
+	}
+	if _, ok := aeads[primaryKeyID]; !ok {
+		return nil, errors.Errorf("primary key %q is not defined", primaryKeyID)
+	}
+	return &aesGCM{
+		aeads:        aeads,
+		primaryKeyID: primaryKeyID,
+	}, nil
+}
+
+var _ Cipher = &aesGCM{}
+
+type aesGCM struct {
+	aeads        map[string]cipher.AEAD
+	primaryKeyID string
+}
+
+func (a *aesGCM) Encrypt(data []byte) ([]byte, error) {
+	aead := a.aeads[a.primaryKeyID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "could not generate nonce")
+	}
+	keyID := []byte(a.primaryKeyID)
+	var out []byte
+	out = append(out, encryptedPrefix...)
+	out = append(out, byte(len(keyID)))
+	out = append(out, keyID...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, data, keyID), nil
+}
+
+func (a *aesGCM) Decrypt(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	keyID, rest, err := splitKeyID(data)
+	if err != nil {
+		return nil, err
+	}
+	aead, ok := a.aeads[keyID]
+	if !ok {
+		return nil, errors.Errorf("data was encrypted with key %q which is not available", keyID)
+	}
+	if len(rest) < aead.NonceSize() {
+		return nil, errors.New("encrypted data is malformed")
+	}
+	nonce, sealed := rest[:aead.NonceSize()], rest[aead.NonceSize():]
+	out, err := aead.Open(nil, nonce, sealed, []byte(keyID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decrypt data with key %q", keyID)
+	}
+	return out, nil
+}
+
+// IsEncrypted returns true if data was encrypted by AES-GCM Cipher.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, encryptedPrefix)
+}
+
+// KeyIDOf returns ID of the key that was used to encrypt the data.
+func KeyIDOf(data []byte) (string, error) {
+	if !IsEncrypted(data) {
+		return "", errors.New("data is not encrypted")
+	}
+	keyID, _, err := splitKeyID(data)
+	return keyID, err
+}
+
+func splitKeyID(data []byte) (string, []byte, error) {
+	data = data[len(encryptedPrefix):]
+	if len(data) < 1 || len(data) < 1+int(data[0]) {
+		return "", nil, errors.New("encrypted data is malformed")
+	}
+	keyIDLen := int(data[0])
+	return string(data[1 : 1+keyIDLen]), data[1+keyIDLen:], nil
+}
@@ -0,0 +1,148 @@
+package cipher_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+)
+
+var _ = Describe("AES-GCM Cipher", func() {
+
+	key1 := cipher.Key{ID: "key-1", Value: bytes.Repeat([]byte{1}, 32)}
+	key2 := cipher.Key{ID: "key-2", Value: bytes.Repeat([]byte{2}, 32)}
+
+	It("should encrypt and decrypt data", func() {
+		// given
+		c, err := cipher.NewAESGCM([]cipher.Key{key1}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		encrypted, err := c.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(encrypted).ToNot(ContainSubstring("secret"))
+		Expect(cipher.IsEncrypted(encrypted)).To(BeTrue())
+		Expect(cipher.KeyIDOf(encrypted)).To(Equal("key-1"))
+
+		// when
+		decrypted, err := c.Decrypt(encrypted)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal([]byte("secret")))
+	})
+
+	It("should decrypt data encrypted with a previous primary key", func() {
+		// given
+		oldCipher, err := cipher.NewAESGCM([]cipher.Key{key1}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+		encrypted, err := oldCipher.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		newCipher, err := cipher.NewAESGCM([]cipher.Key{key1, key2}, "key-2")
+		Expect(err).ToNot(HaveOccurred())
+		decrypted, err := newCipher.Decrypt(encrypted)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal([]byte("secret")))
+
+		// when
+		reencrypted, err := newCipher.Encrypt(decrypted)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cipher.KeyIDOf(reencrypted)).To(Equal("key-2"))
+	})
+
+	It("should pass through data stored in plain text", func() {
+		// given
+		c, err := cipher.NewAESGCM([]cipher.Key{key1}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		decrypted, err := c.Decrypt([]byte("plain"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal([]byte("plain")))
+	})
+
+	It("should fail to decrypt data encrypted with unknown key", func() {
+		// given
+		oldCipher, err := cipher.NewAESGCM([]cipher.Key{key1}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+		encrypted, err := oldCipher.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+		newCipher, err := cipher.NewAESGCM([]cipher.Key{key2}, "key-2")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = newCipher.Decrypt(encrypted)
+
+		// then
+		Expect(err).To(MatchError(`data was encrypted with key "key-1" which is not available`))
+	})
+
+	It("should fail to decrypt tampered data", func() {
+		// given
+		c, err := cipher.NewAESGCM([]cipher.Key{key1}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+		encrypted, err := c.Encrypt([]byte("secret"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		encrypted[len(encrypted)-1] ^= 0xff
+		_, err = c.Decrypt(encrypted)
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should validate keys",
+		func(keys []cipher.Key, primaryKeyID string, expectedErr string) {
+			_, err := cipher.NewAESGCM(keys, primaryKeyID)
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("missing primary key", []cipher.Key{key1}, "key-2", `primary key "key-2" is not defined`),
+		Entry("duplicated key", []cipher.Key{key1, key1}, "key-1", `key ID "key-1" is duplicated`),
+		Entry("invalid key length", []cipher.Key{{ID: "key-1", Value: []byte("short")}}, "key-1", `key "key-1" has to be 32 bytes long`),
+		Entry("empty key ID", []cipher.Key{{Value: key1.Value}}, "key-1", `key ID cannot be empty`),
+	)
+
+	It("should load keys from file", func() {
+		// given
+		dir, err := ioutil.TempDir("", "cipher")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		file := filepath.Join(dir, "keys.yaml")
+		content := `
+primaryKeyId: key-2
+keys:
+- id: key-1
+  key: ` + base64.StdEncoding.EncodeToString(key1.Value) + `
+- id: key-2
+  key: ` + base64.StdEncoding.EncodeToString(key2.Value) + `
+`
+		Expect(ioutil.WriteFile(file, []byte(content), 0600)).To(Succeed())
+
+		// when
+		c, err := cipher.NewAESGCMFromFile(file)
+		Expect(err).ToNot(HaveOccurred())
+		encrypted, err := c.Encrypt([]byte("secret"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cipher.KeyIDOf(encrypted)).To(Equal("key-2"))
+	})
+})
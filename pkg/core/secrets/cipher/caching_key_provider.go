@@ -0,0 +1,86 @@
+package cipher
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+// NewCachingKeyProvider returns KeyProvider that keeps unwrapped data keys in memory for the given TTL,
+// so reading the same Secret again does not require a round-trip to the underlying KeyProvider.
+// Data keys wrapped by this KeyProvider are cached as well, because they are usually read right after they are written.
+func NewCachingKeyProvider(provider KeyProvider, ttl time.Duration) KeyProvider {
+	return &cachingKeyProvider{
+		provider: provider,
+		ttl:      ttl,
+		dataKeys: map[string]cachedDataKey{},
+	}
+}
+
+var _ KeyProvider = &cachingKeyProvider{}
+
+type cachingKeyProvider struct {
+	provider KeyProvider
+	ttl      time.Duration
+
+	sync.Mutex
+	// dataKeys are unwrapped data keys by their wrapped form
+	dataKeys map[string]cachedDataKey
+	// lastEviction is the last time expired data keys were removed from the cache
+	lastEviction time.Time
+}
+
+type cachedDataKey struct {
+	dataKey    []byte
+	expiration time.Time
+}
+
+func (c *cachingKeyProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	wrappedKey, err := c.provider.WrapKey(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	c.put(wrappedKey, dataKey)
+	return wrappedKey, nil
+}
+
+func (c *cachingKeyProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	if dataKey, ok := c.get(wrappedKey); ok {
+		return dataKey, nil
+	}
+	dataKey, err := c.provider.UnwrapKey(wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	c.put(wrappedKey, dataKey)
+	return dataKey, nil
+}
+
+func (c *cachingKeyProvider) get(wrappedKey []byte) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+	cached, ok := c.dataKeys[string(wrappedKey)]
+	if !ok || !core.Now().Before(cached.expiration) {
+		return nil, false
+	}
+	return cached.dataKey, true
+}
+
+func (c *cachingKeyProvider) put(wrappedKey []byte, dataKey []byte) {
+	c.Lock()
+	defer c.Unlock()
+	now := core.Now()
+	if now.Sub(c.lastEviction) >= c.ttl {
+		for key, cached := range c.dataKeys {
+			if !now.Before(cached.expiration) {
+				delete(c.dataKeys, key)
+			}
+		}
+		c.lastEviction = now
+	}
+	c.dataKeys[string(wrappedKey)] = cachedDataKey{
+		dataKey:    dataKey,
+		expiration: now.Add(c.ttl),
+	}
+}
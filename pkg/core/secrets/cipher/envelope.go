@@ -0,0 +1,107 @@
+package cipher
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// envelopePrefix marks data encrypted by envelope Cipher.
+var envelopePrefix = []byte("kuma:envelope:v1:")
+
+// NewEnvelope returns a Cipher that encrypts every value with a new AES-256 data key and stores the data key
+// wrapped by the KeyProvider next to the encrypted value.
+// The output has the following format: prefix | len(wrapped key) as uint16 | wrapped key | nonce | sealed data.
+// Data that was not encrypted by envelope Cipher is decrypted with the legacy Decryptor,
+// so Secrets stored before enabling envelope encryption can still be read and re-encrypted.
+func NewEnvelope(provider KeyProvider, legacy Decryptor) Cipher {
+	return &envelope{
+		provider: provider,
+		legacy:   legacy,
+	}
+}
+
+var _ Cipher = &envelope{}
+
+type envelope struct {
+	provider KeyProvider
+	legacy   Decryptor
+}
+
+func (e *envelope) Encrypt(data []byte) ([]byte, error) {
+	dataKey := make([]byte, aesKeyLength)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, errors.Wrap(err, "could not generate data key")
+	}
+	wrappedKey, err := e.provider.WrapKey(dataKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not wrap data key")
+	}
+	if len(wrappedKey) > 0xffff {
+		return nil, errors.New("wrapped data key is too long")
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "could not generate nonce")
+	}
+	var out []byte
+	out = append(out, envelopePrefix...)
+	out = append(out, 0, 0)
+	binary.BigEndian.PutUint16(out[len(envelopePrefix):], uint16(len(wrappedKey)))
+	out = append(out, wrappedKey...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, data, wrappedKey), nil
+}
+
+func (e *envelope) Decrypt(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, envelopePrefix) {
+		return e.legacy.Decrypt(data)
+	}
+	rest := data[len(envelopePrefix):]
+	if len(rest) < 2 {
+		return nil, errors.New("encrypted data is malformed")
+	}
+	wrappedKeyLen := int(binary.BigEndian.Uint16(rest))
+	rest = rest[2:]
+	if len(rest) < wrappedKeyLen {
+		return nil, errors.New("encrypted data is malformed")
+	}
+	wrappedKey, rest := rest[:wrappedKeyLen], rest[wrappedKeyLen:]
+	dataKey, err := e.provider.UnwrapKey(wrappedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unwrap data key")
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < aead.NonceSize() {
+		return nil, errors.New("encrypted data is malformed")
+	}
+	nonce, sealed := rest[:aead.NonceSize()], rest[aead.NonceSize():]
+	out, err := aead.Open(nil, nonce, sealed, wrappedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt data")
+	}
+	return out, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != aesKeyLength {
+		return nil, errors.Errorf("key has to be %d bytes long", aesKeyLength)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create AES cipher")
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,264 @@
+package cipher_test
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	test_cipher "github.com/kumahq/kuma/pkg/test/secrets/cipher"
+)
+
+// countingKeyProvider counts requests to unwrap data keys
+type countingKeyProvider struct {
+	cipher.KeyProvider
+	unwraps int32
+}
+
+func (c *countingKeyProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	atomic.AddInt32(&c.unwraps, 1)
+	return c.KeyProvider.UnwrapKey(wrappedKey)
+}
+
+var _ = Describe("Envelope Cipher", func() {
+
+	var masterKey cipher.Cipher
+
+	BeforeEach(func() {
+		c, err := cipher.NewAESGCM([]cipher.Key{{ID: "master", Value: bytes.Repeat([]byte{1}, 32)}}, "master")
+		Expect(err).ToNot(HaveOccurred())
+		masterKey = c
+	})
+
+	Context("with local key provider", func() {
+		It("should encrypt and decrypt data", func() {
+			// given
+			c := cipher.NewEnvelope(cipher.NewLocalKeyProvider(masterKey), cipher.None())
+
+			// when
+			encrypted, err := c.Encrypt([]byte("secret"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(encrypted).ToNot(ContainSubstring("secret"))
+
+			// when
+			decrypted, err := c.Decrypt(encrypted)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("secret")))
+		})
+
+		It("should use a different data key for every value", func() {
+			// given
+			c := cipher.NewEnvelope(cipher.NewLocalKeyProvider(masterKey), cipher.None())
+
+			// when
+			encrypted1, err := c.Encrypt([]byte("secret"))
+			Expect(err).ToNot(HaveOccurred())
+			encrypted2, err := c.Encrypt([]byte("secret"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(encrypted1).ToNot(Equal(encrypted2))
+		})
+
+		It("should decrypt legacy data with legacy decryptor", func() {
+			// given
+			legacy, err := masterKey.Encrypt([]byte("legacy"))
+			Expect(err).ToNot(HaveOccurred())
+			c := cipher.NewEnvelope(cipher.NewLocalKeyProvider(masterKey), masterKey)
+
+			// when
+			decrypted, err := c.Decrypt(legacy)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("legacy")))
+
+			// when
+			decrypted, err = c.Decrypt([]byte("plain"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("plain")))
+		})
+
+		It("should fail to decrypt tampered data", func() {
+			// given
+			c := cipher.NewEnvelope(cipher.NewLocalKeyProvider(masterKey), cipher.None())
+			encrypted, err := c.Encrypt([]byte("secret"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			encrypted[len(encrypted)-1] ^= 0xff
+			_, err = c.Decrypt(encrypted)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("could not decrypt data")))
+		})
+	})
+
+	Context("with plugin key provider", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(test_cipher.NewPluginKeyProviderHandler(cipher.NewLocalKeyProvider(masterKey), "plugin-token"))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should encrypt and decrypt data", func() {
+			// given
+			provider := cipher.NewPluginKeyProvider(http.DefaultClient, server.URL, "plugin-token")
+			c := cipher.NewEnvelope(provider, cipher.None())
+
+			// when
+			encrypted, err := c.Encrypt([]byte("secret"))
+			Expect(err).ToNot(HaveOccurred())
+			decrypted, err := c.Decrypt(encrypted)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("secret")))
+
+			// and data can be decrypted locally with the same master key
+			local := cipher.NewEnvelope(cipher.NewLocalKeyProvider(masterKey), cipher.None())
+			Expect(local.Decrypt(encrypted)).To(Equal([]byte("secret")))
+		})
+
+		It("should return error from the plugin", func() {
+			// given
+			provider := cipher.NewPluginKeyProvider(http.DefaultClient, server.URL, "invalid-token")
+			c := cipher.NewEnvelope(provider, cipher.None())
+
+			// when
+			_, err := c.Encrypt([]byte("secret"))
+
+			// then
+			Expect(err).To(MatchError("could not wrap data key: key provider plugin returned status code 401: unauthorized"))
+		})
+	})
+
+	Context("with gRPC plugin key provider", func() {
+		var server *grpc.Server
+		var conn *grpc.ClientConn
+
+		BeforeEach(func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			server = grpc.NewServer()
+			system_proto.RegisterKeyProviderPluginServiceServer(server, test_cipher.NewGrpcPluginKeyProviderServer(cipher.NewLocalKeyProvider(masterKey), "plugin-token"))
+			go func() {
+				_ = server.Serve(listener)
+			}()
+			conn, err = grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(conn.Close()).To(Succeed())
+			server.Stop()
+		})
+
+		It("should encrypt and decrypt data", func() {
+			// given
+			provider := cipher.NewGrpcPluginKeyProvider(conn, 5*time.Second, "plugin-token")
+			c := cipher.NewEnvelope(provider, cipher.None())
+
+			// when
+			encrypted, err := c.Encrypt([]byte("secret"))
+			Expect(err).ToNot(HaveOccurred())
+			decrypted, err := c.Decrypt(encrypted)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("secret")))
+
+			// and data can be decrypted locally with the same master key
+			local := cipher.NewEnvelope(cipher.NewLocalKeyProvider(masterKey), cipher.None())
+			Expect(local.Decrypt(encrypted)).To(Equal([]byte("secret")))
+		})
+
+		It("should return error from the plugin", func() {
+			// given
+			provider := cipher.NewGrpcPluginKeyProvider(conn, 5*time.Second, "invalid-token")
+			c := cipher.NewEnvelope(provider, cipher.None())
+
+			// when
+			_, err := c.Encrypt([]byte("secret"))
+
+			// then
+			Expect(err).To(MatchError("could not wrap data key: could not call key provider plugin: rpc error: code = Unauthenticated desc = unauthorized"))
+		})
+	})
+
+	Context("with caching key provider", func() {
+		var provider *countingKeyProvider
+
+		BeforeEach(func() {
+			core.Now = time.Now
+			provider = &countingKeyProvider{KeyProvider: cipher.NewLocalKeyProvider(masterKey)}
+		})
+
+		AfterEach(func() {
+			core.Now = time.Now
+		})
+
+		It("should not unwrap data keys that were just wrapped", func() {
+			// given
+			c := cipher.NewEnvelope(cipher.NewCachingKeyProvider(provider, time.Minute), cipher.None())
+
+			// when
+			encrypted, err := c.Encrypt([]byte("secret"))
+			Expect(err).ToNot(HaveOccurred())
+			decrypted, err := c.Decrypt(encrypted)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("secret")))
+			Expect(atomic.LoadInt32(&provider.unwraps)).To(Equal(int32(0)))
+		})
+
+		It("should unwrap data keys again once they expire", func() {
+			// given
+			encrypted, err := cipher.NewEnvelope(provider, cipher.None()).Encrypt([]byte("secret"))
+			Expect(err).ToNot(HaveOccurred())
+			c := cipher.NewEnvelope(cipher.NewCachingKeyProvider(provider, time.Minute), cipher.None())
+
+			// when
+			for i := 0; i < 3; i++ {
+				decrypted, err := c.Decrypt(encrypted)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decrypted).To(Equal([]byte("secret")))
+			}
+
+			// then
+			Expect(atomic.LoadInt32(&provider.unwraps)).To(Equal(int32(1)))
+
+			// when
+			now := time.Now()
+			core.Now = func() time.Time {
+				return now.Add(2 * time.Minute)
+			}
+			decrypted, err := c.Decrypt(encrypted)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("secret")))
+			Expect(atomic.LoadInt32(&provider.unwraps)).To(Equal(int32(2)))
+		})
+	})
+})
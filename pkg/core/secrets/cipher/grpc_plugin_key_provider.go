@@ -0,0 +1,65 @@
+package cipher
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+)
+
+// NewGrpcPluginKeyProvider returns KeyProvider that delegates wrapping of data keys to the plugin
+// that implements KeyProviderPluginService over the given connection.
+// It is the gRPC transport of the same protocol as NewPluginKeyProvider.
+func NewGrpcPluginKeyProvider(conn grpc.ClientConnInterface, requestTimeout time.Duration, token string) KeyProvider {
+	return &grpcPluginKeyProvider{
+		client:         system_proto.NewKeyProviderPluginServiceClient(conn),
+		requestTimeout: requestTimeout,
+		token:          token,
+	}
+}
+
+var _ KeyProvider = &grpcPluginKeyProvider{}
+
+type grpcPluginKeyProvider struct {
+	client         system_proto.KeyProviderPluginServiceClient
+	requestTimeout time.Duration
+	token          string
+}
+
+func (p *grpcPluginKeyProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	ctx, cancel := p.context()
+	defer cancel()
+	res, err := p.client.WrapKey(ctx, &system_proto.WrapKeyRequest{Plaintext: dataKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not call key provider plugin")
+	}
+	if len(res.GetCiphertext()) == 0 {
+		return nil, errors.New("key provider plugin returned empty ciphertext")
+	}
+	return res.GetCiphertext(), nil
+}
+
+func (p *grpcPluginKeyProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	ctx, cancel := p.context()
+	defer cancel()
+	res, err := p.client.UnwrapKey(ctx, &system_proto.UnwrapKeyRequest{Ciphertext: wrappedKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not call key provider plugin")
+	}
+	return res.GetPlaintext(), nil
+}
+
+func (p *grpcPluginKeyProvider) context() (context.Context, context.CancelFunc) {
+	ctx := context.Background()
+	if p.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+p.token)
+	}
+	if p.requestTimeout > 0 {
+		return context.WithTimeout(ctx, p.requestTimeout)
+	}
+	return context.WithCancel(ctx)
+}
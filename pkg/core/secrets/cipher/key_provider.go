@@ -0,0 +1,32 @@
+package cipher
+
+// KeyProvider wraps and unwraps data keys with a master key that never leaves the provider.
+// It lets Kuma use KMS-like services (for example Vault Transit) for envelope encryption of Secrets.
+type KeyProvider interface {
+	// WrapKey encrypts the data key with the master key. The result is opaque to Kuma.
+	WrapKey(dataKey []byte) ([]byte, error)
+	// UnwrapKey decrypts the data key previously wrapped by WrapKey.
+	UnwrapKey(wrappedKey []byte) ([]byte, error)
+}
+
+// NewLocalKeyProvider returns KeyProvider that wraps data keys with the local master key.
+// Usually the master key is AES-GCM Cipher with keys loaded from a file.
+func NewLocalKeyProvider(masterKey Cipher) KeyProvider {
+	return &localKeyProvider{
+		masterKey: masterKey,
+	}
+}
+
+var _ KeyProvider = &localKeyProvider{}
+
+type localKeyProvider struct {
+	masterKey Cipher
+}
+
+func (l *localKeyProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	return l.masterKey.Encrypt(dataKey)
+}
+
+func (l *localKeyProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	return l.masterKey.Decrypt(wrappedKey)
+}
@@ -0,0 +1,58 @@
+package cipher
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// KeysFile is a format of the file with keys used for encryption of Secrets.
+// Every key is AES-256 key encoded in base64.
+type KeysFile struct {
+	// PrimaryKeyID is an ID of the key that is used to encrypt new Secrets.
+	PrimaryKeyID string `yaml:"primaryKeyId"`
+	// Keys is a list of all keys. Keys other than primary are used only to decrypt existing Secrets.
+	Keys []KeyEntry `yaml:"keys"`
+}
+
+type KeyEntry struct {
+	ID  string `yaml:"id"`
+	Key string `yaml:"key"`
+}
+
+// NewAESGCMFromFile returns AES-GCM Cipher with keys loaded from the given file.
+func NewAESGCMFromFile(path string) (Cipher, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read keys file %q", path)
+	}
+	keys, primaryKeyID, err := ParseKeys(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid keys file %q", path)
+	}
+	return NewAESGCM(keys, primaryKeyID)
+}
+
+func ParseKeys(content []byte) ([]Key, string, error) {
+	file := KeysFile{}
+	if err := yaml.UnmarshalStrict(content, &file); err != nil {
+		return nil, "", err
+	}
+	if file.PrimaryKeyID == "" {
+		return nil, "", errors.New("primaryKeyId has to be defined")
+	}
+	var keys []Key
+	for _, entry := range file.Keys {
+		value, err := base64.StdEncoding.DecodeString(entry.Key)
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "key %q is not valid base64", entry.ID)
+		}
+		keys = append(keys, Key{
+			ID:    entry.ID,
+			Value: value,
+		})
+	}
+	return keys, file.PrimaryKeyID, nil
+}
@@ -0,0 +1,120 @@
+package cipher
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Key provider plugin protocol.
+//
+// The plugin is either an HTTP server or a gRPC server. The gRPC server implements KeyProviderPluginService
+// (see api/system/v1alpha1/key_provider_plugin.proto), the HTTP server exposes two endpoints accepting and returning JSON.
+// Binary values are encoded in base64.
+//
+//   POST <url>/wrap   {"plaintext": "..."}  -> 200 {"ciphertext": "..."}
+//   POST <url>/unwrap {"ciphertext": "..."} -> 200 {"plaintext": "..."}
+//
+// Any other status code is treated as an error and the body may contain {"error": "..."}.
+// If a token is configured, it is sent as "Bearer <token>" in the "Authorization" header or in the "authorization" gRPC metadata.
+// This way Kuma can use services like Vault Transit or cloud KMS through a small adapter without linking their SDKs.
+
+const (
+	PluginWrapPath   = "/wrap"
+	PluginUnwrapPath = "/unwrap"
+)
+
+type PluginWrapRequest struct {
+	Plaintext []byte `json:"plaintext"`
+}
+
+type PluginWrapResponse struct {
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+type PluginUnwrapRequest struct {
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+type PluginUnwrapResponse struct {
+	Plaintext []byte `json:"plaintext"`
+}
+
+type PluginErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewPluginKeyProvider returns KeyProvider that delegates wrapping of data keys to the plugin available under the URL.
+func NewPluginKeyProvider(client *http.Client, url string, token string) KeyProvider {
+	return &pluginKeyProvider{
+		client: client,
+		url:    strings.TrimSuffix(url, "/"),
+		token:  token,
+	}
+}
+
+var _ KeyProvider = &pluginKeyProvider{}
+
+type pluginKeyProvider struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+func (p *pluginKeyProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	res := PluginWrapResponse{}
+	if err := p.call(PluginWrapPath, PluginWrapRequest{Plaintext: dataKey}, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Ciphertext) == 0 {
+		return nil, errors.New("key provider plugin returned empty ciphertext")
+	}
+	return res.Ciphertext, nil
+}
+
+func (p *pluginKeyProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	res := PluginUnwrapResponse{}
+	if err := p.call(PluginUnwrapPath, PluginUnwrapRequest{Ciphertext: wrappedKey}, &res); err != nil {
+		return nil, err
+	}
+	return res.Plaintext, nil
+}
+
+func (p *pluginKeyProvider) call(path string, request interface{}, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, p.url+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not call key provider plugin")
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "could not read response from key provider plugin")
+	}
+	if resp.StatusCode != http.StatusOK {
+		errRes := PluginErrorResponse{}
+		if err := json.Unmarshal(respBody, &errRes); err == nil && errRes.Error != "" {
+			return errors.Errorf("key provider plugin returned status code %d: %s", resp.StatusCode, errRes.Error)
+		}
+		return errors.Errorf("key provider plugin returned status code %d", resp.StatusCode)
+	}
+	if err := json.Unmarshal(respBody, response); err != nil {
+		return errors.Wrap(err, "could not parse response from key provider plugin")
+	}
+	return nil
+}
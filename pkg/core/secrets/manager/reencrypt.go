@@ -0,0 +1,62 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	secret_model "github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	secret_cipher "github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
+)
+
+// ReEncrypt decrypts all Secrets and GlobalSecrets and encrypts them again with the primary key of the cipher.
+// Secrets stored in plain text are encrypted. It returns the number of re-encrypted Secrets.
+func ReEncrypt(ctx context.Context, secretStore secret_store.SecretStore, cipher secret_cipher.Cipher) (int, error) {
+	count := 0
+
+	secrets := &secret_model.SecretResourceList{}
+	if err := secretStore.List(ctx, secrets); err != nil {
+		return 0, errors.Wrap(err, "could not list Secrets")
+	}
+	for _, secret := range secrets.Items {
+		if err := reEncrypt(ctx, secretStore, cipher, secret, secret.Spec); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	globalSecrets := &secret_model.GlobalSecretResourceList{}
+	if err := secretStore.List(ctx, globalSecrets); err != nil {
+		return count, errors.Wrap(err, "could not list GlobalSecrets")
+	}
+	for _, secret := range globalSecrets.Items {
+		if err := reEncrypt(ctx, secretStore, cipher, secret, secret.Spec); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+func reEncrypt(ctx context.Context, secretStore secret_store.SecretStore, cipher secret_cipher.Cipher, secret model.Resource, spec *system_proto.Secret) error {
+	if len(spec.GetData().GetValue()) > 0 {
+		value, err := cipher.Decrypt(spec.Data.Value)
+		if err != nil {
+			return errors.Wrapf(err, "could not decrypt %s %q", secret.GetType(), secret.GetMeta().GetName())
+		}
+		value, err = cipher.Encrypt(value)
+		if err != nil {
+			return errors.Wrapf(err, "could not encrypt %s %q", secret.GetType(), secret.GetMeta().GetName())
+		}
+		spec.Data.Value = value
+	}
+	if err := secretStore.Update(ctx, secret, core_store.ModifiedAt(time.Now())); err != nil {
+		return errors.Wrapf(err, "could not update %s %q", secret.GetType(), secret.GetMeta().GetName())
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package manager_test
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("ReEncrypt", func() {
+
+	var resourceStore store.ResourceStore
+
+	key1 := cipher.Key{ID: "key-1", Value: bytes.Repeat([]byte{1}, 32)}
+	key2 := cipher.Key{ID: "key-2", Value: bytes.Repeat([]byte{2}, 32)}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+	})
+
+	secretValue := func(key model.ResourceKey) []byte {
+		secret := system.NewSecretResource()
+		Expect(resourceStore.Get(context.Background(), secret, store.GetBy(key))).To(Succeed())
+		return secret.Spec.Data.Value
+	}
+
+	globalSecretValue := func(key model.ResourceKey) []byte {
+		secret := system.NewGlobalSecretResource()
+		Expect(resourceStore.Get(context.Background(), secret, store.GetBy(key))).To(Succeed())
+		return secret.Spec.Data.Value
+	}
+
+	It("should re-encrypt secrets with the primary key", func() {
+		// given
+		oldCipher, err := cipher.NewAESGCM([]cipher.Key{key1}, "key-1")
+		Expect(err).ToNot(HaveOccurred())
+		newCipher, err := cipher.NewAESGCM([]cipher.Key{key1, key2}, "key-2")
+		Expect(err).ToNot(HaveOccurred())
+
+		// and secrets encrypted with the old key and stored in plain text
+		encrypted, err := oldCipher.Encrypt([]byte("encrypted"))
+		Expect(err).ToNot(HaveOccurred())
+		err = resourceStore.Create(context.Background(), &system.SecretResource{
+			Spec: &system_proto.Secret{Data: &wrappers.BytesValue{Value: encrypted}},
+		}, store.CreateByKey("encrypted", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		err = resourceStore.Create(context.Background(), &system.GlobalSecretResource{
+			Spec: &system_proto.Secret{Data: &wrappers.BytesValue{Value: []byte("plain")}},
+		}, store.CreateByKey("plain", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		count, err := secret_manager.ReEncrypt(context.Background(), resourceStore, newCipher)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(2))
+
+		// and
+		value := secretValue(model.ResourceKey{Name: "encrypted", Mesh: "default"})
+		Expect(cipher.KeyIDOf(value)).To(Equal("key-2"))
+		Expect(newCipher.Decrypt(value)).To(Equal([]byte("encrypted")))
+
+		// and
+		value = globalSecretValue(model.ResourceKey{Name: "plain"})
+		Expect(cipher.KeyIDOf(value)).To(Equal("key-2"))
+		Expect(newCipher.Decrypt(value)).To(Equal([]byte("plain")))
+	})
+})
@@ -79,6 +79,9 @@ type EndpointMap map[ServiceName][]Endpoint
 // LogMap holds the most specific TrafficLog for each outbound interface of a Dataplane.
 type LogMap map[ServiceName]*mesh_proto.LoggingBackend
 
+// LogFilterMap holds filters of the most specific TrafficLog for each outbound interface of a Dataplane.
+type LogFilterMap map[ServiceName][]*mesh_proto.TrafficLog_Conf_Filter
+
 // HealthCheckMap holds the most specific HealthCheck for each reachable service.
 type HealthCheckMap map[ServiceName]*mesh_core.HealthCheckResource
 
@@ -94,6 +97,9 @@ type FaultInjectionMap map[mesh_proto.InboundInterface]*mesh_proto.FaultInjectio
 // TrafficPermissionMap holds the most specific TrafficPermissionResource for each InboundInterface
 type TrafficPermissionMap map[mesh_proto.InboundInterface]*mesh_core.TrafficPermissionResource
 
+// TrafficDenyPermissionMap holds all denying TrafficPermissionResources for each InboundInterface
+type TrafficDenyPermissionMap map[mesh_proto.InboundInterface][]*mesh_core.TrafficPermissionResource
+
 // RateLimitsMap holds all RateLimitResources for each InboundInterface
 type RateLimitsMap map[mesh_proto.InboundInterface][]*mesh_proto.RateLimit
 
@@ -128,20 +134,23 @@ type Routing struct {
 }
 
 type MatchedPolicies struct {
-	TrafficPermissions TrafficPermissionMap
-	Logs               LogMap
-	HealthChecks       HealthCheckMap
-	CircuitBreakers    CircuitBreakerMap
-	Retries            RetryMap
-	TrafficTrace       *mesh_core.TrafficTraceResource
-	TracingBackend     *mesh_proto.TracingBackend
-	FaultInjections    FaultInjectionMap
-	Timeouts           TimeoutMap
-	RateLimits         RateLimitsMap
+	TrafficPermissions     TrafficPermissionMap
+	TrafficDenyPermissions TrafficDenyPermissionMap
+	Logs                   LogMap
+	LogFilters             LogFilterMap
+	HealthChecks           HealthCheckMap
+	CircuitBreakers        CircuitBreakerMap
+	Retries                RetryMap
+	TrafficTrace           *mesh_core.TrafficTraceResource
+	TracingBackend         *mesh_proto.TracingBackend
+	FaultInjections        FaultInjectionMap
+	Timeouts               TimeoutMap
+	RateLimits             RateLimitsMap
 }
 
 type CaSecret struct {
 	PemCerts [][]byte
+	PemCrls  [][]byte
 }
 
 type IdentitySecret struct {
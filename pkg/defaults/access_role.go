@@ -0,0 +1,49 @@
+package defaults
+
+import (
+	"context"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/authz"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+var defaultAccessRoleKey = core_model.ResourceKey{
+	Name: "admin",
+}
+
+// createAdminAccessRoleIfNotExist creates AccessRole that grants all actions to members of the admin group.
+// Thanks to this, when RBAC authorization is enabled, the admin (localhost or a client with the admin certificate) is not locked out.
+func (d *defaultsComponent) createAdminAccessRoleIfNotExist() error {
+	role := system.NewAccessRoleResource()
+	err := d.resManager.Get(context.Background(), role, core_store.GetBy(defaultAccessRoleKey))
+	if err == nil {
+		log.V(1).Info("default AccessRole already exists. Skip creating default AccessRole.")
+		return nil
+	}
+	if !core_store.IsResourceNotFound(err) {
+		return err
+	}
+	role.Spec = &system_proto.AccessRole{
+		Rules: []*system_proto.AccessRole_Rule{
+			{
+				Actions: []string{system.AccessRoleWildcard},
+				Types:   []string{system.AccessRoleWildcard},
+			},
+		},
+		Subjects: []*system_proto.AccessRole_Subject{
+			{
+				Group: authz.AdminGroup,
+			},
+		},
+	}
+	log.Info("trying to create default AccessRole")
+	if err := d.resManager.Create(context.Background(), role, core_store.CreateBy(defaultAccessRoleKey)); err != nil {
+		log.V(1).Info("could not create default AccessRole", "err", err)
+		return err
+	}
+	log.Info("default AccessRole created")
+	return nil
+}
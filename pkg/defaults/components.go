@@ -57,6 +57,12 @@ func (d *defaultsComponent) Start(_ <-chan struct{}) error {
 		// This code can execute before the control plane is ready therefore hooks can fail.
 		return errors.Wrap(err, "could not create the default Mesh")
 	}
+	if err := doWithRetry(d.createAdminAccessRoleIfNotExist); err != nil {
+		return errors.Wrap(err, "could not create the default AccessRole")
+	}
+	if err := doWithRetry(d.createUserTokenSigningKeyIfNotExist); err != nil {
+		return errors.Wrap(err, "could not create the User Token Signing Key")
+	}
 	return nil
 }
 
@@ -7,15 +7,18 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/authz"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	"github.com/kumahq/kuma/pkg/config/core"
 	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	core_component "github.com/kumahq/kuma/pkg/core/runtime/component"
 	"github.com/kumahq/kuma/pkg/defaults"
 	resources_memory "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
 var _ = Describe("Defaults Component", func() {
@@ -72,6 +75,32 @@ var _ = Describe("Defaults Component", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(mesh.Spec.Mtls.EnabledBackend).To(Equal("builtin"))
 		})
+
+		It("should create default admin AccessRole", func() {
+			// when
+			err := component.Start(nil)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			role := system.NewAccessRoleResource()
+			err = manager.Get(context.Background(), role, core_store.GetByKey("admin", model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(role.Spec.Subjects[0].Group).To(Equal(authz.AdminGroup))
+			Expect(role.Spec.Rules[0].Actions).To(ConsistOf("*"))
+			Expect(role.Spec.Rules[0].Types).To(ConsistOf("*"))
+		})
+
+		It("should create User Token Signing Key", func() {
+			// when
+			err := component.Start(nil)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			key := system.NewGlobalSecretResource()
+			err = manager.Get(context.Background(), key, core_store.GetBy(issuer.UserTokenSigningKeyResourceKey))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key.Spec.GetData().GetValue()).ToNot(BeEmpty())
+		})
 	})
 
 	Describe("when skip mesh creation is set to true", func() {
@@ -19,7 +19,9 @@ var log = core.Log.WithName("defaults").WithName("mesh")
 // 2 invocation can check that TrafficPermission is absent, but it was just created, so it tries to created it which results in error
 var ensureMux = sync.Mutex{}
 
-func EnsureDefaultMeshResources(resManager manager.ResourceManager, meshName string) error {
+// EnsureDefaultMeshResources creates default resources for the Mesh.
+// The default Dataplane Token Signing Key is created with the type of the key required by dpTokenSigningMethod.
+func EnsureDefaultMeshResources(resManager manager.ResourceManager, meshName string, dpTokenSigningMethod issuer.SigningMethod) error {
 	ensureMux.Lock()
 	defer ensureMux.Unlock()
 	log.Info("ensuring default resources for Mesh exist", "mesh", meshName)
@@ -74,7 +76,7 @@ func EnsureDefaultMeshResources(resManager manager.ResourceManager, meshName str
 		log.Info("default Retry already exist", "mesh", meshName, "name", defaultRetryKey(meshName).Name)
 	}
 
-	created, err = ensureDataplaneTokenSigningKey(resManager, meshName)
+	created, err = ensureDataplaneTokenSigningKey(resManager, meshName, dpTokenSigningMethod)
 	if err != nil {
 		return errors.Wrap(err, "could not create default Dataplane Token Signing Key")
 	}
@@ -2,6 +2,7 @@ package mesh_test
 
 import (
 	"context"
+	"crypto/x509"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -13,6 +14,7 @@ import (
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/defaults/mesh"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/tokens/builtin"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
@@ -30,7 +32,7 @@ var _ = Describe("EnsureDefaultMeshResources", func() {
 
 	It("should create default resources", func() {
 		// when
-		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh)
+		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, issuer.SigningMethodHS256)
 		Expect(err).ToNot(HaveOccurred())
 
 		// then default TrafficPermission for the mesh exist
@@ -56,11 +58,11 @@ var _ = Describe("EnsureDefaultMeshResources", func() {
 
 	It("should ignore subsequent calls to EnsureDefaultMeshResources", func() {
 		// given already ensured default resources
-		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh)
+		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, issuer.SigningMethodHS256)
 		Expect(err).ToNot(HaveOccurred())
 
 		// when ensuring again
-		err = mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh)
+		err = mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, issuer.SigningMethodHS256)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -77,4 +79,24 @@ var _ = Describe("EnsureDefaultMeshResources", func() {
 		err = resManager.Get(context.Background(), system.NewSecretResource(), core_store.GetBy(issuer.SigningKeyResourceKey(issuer.EnvoyAdminClientTokenPrefix, model.DefaultMesh)))
 		Expect(err).ToNot(HaveOccurred())
 	})
+	It("should create Dataplane Token Signing Key of the type required by the signing method", func() {
+		// when
+		err := mesh.EnsureDefaultMeshResources(resManager, model.DefaultMesh, issuer.SigningMethodES256)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then the key is an EC private key
+		key := system.NewSecretResource()
+		err = resManager.Get(context.Background(), key, core_store.GetBy(issuer.SigningKeyResourceKey(issuer.DataplaneTokenPrefix, model.DefaultMesh)))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = x509.ParseECPrivateKey(key.Spec.GetData().GetValue())
+		Expect(err).ToNot(HaveOccurred())
+
+		// and tokens can be signed and validated with the key
+		tokenIssuer, err := builtin.NewDataplaneTokenIssuer(resManager, issuer.SigningMethodES256)
+		Expect(err).ToNot(HaveOccurred())
+		token, err := tokenIssuer.Generate(issuer.DataplaneIdentity{Mesh: model.DefaultMesh, Name: "dp-1"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = tokenIssuer.Validate(token, model.DefaultMesh)
+		Expect(err).ToNot(HaveOccurred())
+	})
 })
@@ -10,27 +10,29 @@ import (
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
-func ensureDataplaneTokenSigningKey(resManager manager.ResourceManager, meshName string) (created bool, err error) {
-	return ensureSigningKeyForPrefix(resManager, meshName, issuer.DataplaneTokenPrefix)
+func ensureDataplaneTokenSigningKey(resManager manager.ResourceManager, meshName string, signingMethod issuer.SigningMethod) (created bool, err error) {
+	return ensureSigningKeyForPrefix(resManager, meshName, issuer.DataplaneTokenPrefix, issuer.KeyTypeForSigningMethod(signingMethod))
 }
 
 func ensureEnvoyAdminClientSigningKey(resManager manager.ResourceManager, meshName string) (created bool, err error) {
-	return ensureSigningKeyForPrefix(resManager, meshName, issuer.EnvoyAdminClientTokenPrefix)
+	// Envoy Admin Client key is used as a shared secret of HMAC, therefore it does not depend on the signing method of Dataplane Tokens
+	return ensureSigningKeyForPrefix(resManager, meshName, issuer.EnvoyAdminClientTokenPrefix, issuer.KeyTypeRSA)
 }
 
-func ensureSigningKeyForPrefix(resManager manager.ResourceManager, meshName, prefix string) (created bool, err error) {
-	signingKey, err := issuer.CreateSigningKey()
+func ensureSigningKeyForPrefix(resManager manager.ResourceManager, meshName, prefix string, keyType issuer.KeyType) (created bool, err error) {
+	// the default key could have been retired after the rotation, so we check if there is any key
+	keys, err := issuer.ListSigningKeys(resManager, prefix, meshName)
 	if err != nil {
-		return false, errors.Wrap(err, "could not create a signing key")
+		return false, errors.Wrap(err, "could not retrieve a resource")
 	}
-	key := issuer.SigningKeyResourceKey(prefix, meshName)
-	err = resManager.Get(context.Background(), signingKey, core_store.GetBy(key))
-	if err == nil {
+	if len(keys) > 0 {
 		return false, nil
 	}
-	if !core_store.IsResourceNotFound(err) {
-		return false, errors.Wrap(err, "could not retrieve a resource")
+	signingKey, err := issuer.CreateSigningKeyOfType(keyType)
+	if err != nil {
+		return false, errors.Wrap(err, "could not create a signing key")
 	}
+	key := issuer.SigningKeyResourceKey(prefix, meshName)
 	if err := resManager.Create(context.Background(), signingKey, core_store.CreateBy(key)); err != nil {
 		return false, errors.Wrap(err, "could not create a resource")
 	}
@@ -0,0 +1,31 @@
+package defaults
+
+import (
+	"context"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+func (d *defaultsComponent) createUserTokenSigningKeyIfNotExist() error {
+	err := d.resManager.Get(context.Background(), system.NewGlobalSecretResource(), core_store.GetBy(issuer.UserTokenSigningKeyResourceKey))
+	if err == nil {
+		log.V(1).Info("User Token Signing Key already exists. Skip creating User Token Signing Key.")
+		return nil
+	}
+	if !core_store.IsResourceNotFound(err) {
+		return err
+	}
+	signingKey, err := issuer.CreateUserTokenSigningKey()
+	if err != nil {
+		return err
+	}
+	log.Info("trying to create User Token Signing Key")
+	if err := d.resManager.Create(context.Background(), signingKey, core_store.CreateBy(issuer.UserTokenSigningKeyResourceKey)); err != nil {
+		log.V(1).Info("could not create User Token Signing Key", "err", err)
+		return err
+	}
+	log.Info("User Token Signing Key created")
+	return nil
+}
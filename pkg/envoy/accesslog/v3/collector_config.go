@@ -0,0 +1,65 @@
+package v3
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CollectorLogNamePrefix distinguishes log streams that have to be handled
+// by the access log collector built into kuma-dp.
+const CollectorLogNamePrefix = "grpc;"
+
+// CollectorConfig represents configuration of the access log collector built into kuma-dp.
+//
+// Kuma CP passes it to kuma-dp in the log name of `envoy.access_loggers.http_grpc`.
+type CollectorConfig struct {
+	// Path to a file that logs will be written to.
+	Path string `json:"path,omitempty"`
+	// Address of a TCP service that will receive logs.
+	Address string `json:"address,omitempty"`
+	// URL of an HTTP service that will receive logs.
+	Url string `json:"url,omitempty"`
+	// Format string in the canonical representation.
+	Format string `json:"format,omitempty"`
+	// JSON format in the canonical representation.
+	JsonFormat map[string]string `json:"jsonFormat,omitempty"`
+	// Metadata that is attached to every log entry if neither
+	// Format nor JsonFormat are set.
+	Metadata CollectorMetadata `json:"metadata"`
+}
+
+// CollectorMetadata represents a context of log entries of a single log stream.
+type CollectorMetadata struct {
+	Mesh             string            `json:"mesh,omitempty"`
+	TrafficDirection string            `json:"trafficDirection,omitempty"`
+	Source           map[string]string `json:"source,omitempty"`
+	Destination      map[string]string `json:"destination,omitempty"`
+}
+
+// LogName returns a log name that makes kuma-dp handle log stream by the collector.
+func (c *CollectorConfig) LogName() (string, error) {
+	bytes, err := json.Marshal(c)
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal collector config")
+	}
+	return CollectorLogNamePrefix + string(bytes), nil
+}
+
+// IsCollectorLogName returns true if a given log name has to be handled by the collector.
+func IsCollectorLogName(logName string) bool {
+	return strings.HasPrefix(logName, CollectorLogNamePrefix)
+}
+
+// ParseCollectorLogName parses collector configuration from a given log name.
+func ParseCollectorLogName(logName string) (*CollectorConfig, error) {
+	if !IsCollectorLogName(logName) {
+		return nil, errors.Errorf("log name %q has invalid format: expected prefix %q", logName, CollectorLogNamePrefix)
+	}
+	config := &CollectorConfig{}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(logName, CollectorLogNamePrefix)), config); err != nil {
+		return nil, errors.Wrap(err, "collector config is not valid")
+	}
+	return config, nil
+}
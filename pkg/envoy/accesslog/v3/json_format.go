@@ -0,0 +1,137 @@
+package v3
+
+import (
+	"encoding/json"
+	"sort"
+
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	"github.com/pkg/errors"
+)
+
+// AccessLogJsonFormat represents an access log format where every log entry
+// is rendered as a flat JSON object, e.g.
+//
+//	{"protocol": "%PROTOCOL%", "source": "%KUMA_SOURCE_SERVICE%"}
+//
+// Every value of the object is an access log format string on its own.
+type AccessLogJsonFormat struct {
+	Fields map[string]*AccessLogFormat
+}
+
+// ParseJsonFormat parses format strings of every key of a given JSON format.
+func ParseJsonFormat(fields map[string]string) (*AccessLogJsonFormat, error) {
+	format := &AccessLogJsonFormat{
+		Fields: map[string]*AccessLogFormat{},
+	}
+	for _, key := range sortedKeys(fields) {
+		value, err := ParseFormat(fields[key])
+		if err != nil {
+			return nil, errors.Wrapf(err, "value of %q", key)
+		}
+		format.Fields[key] = value
+	}
+	return format, nil
+}
+
+// ParseJsonFormatString parses a JSON format from its canonical representation.
+func ParseJsonFormatString(format string) (*AccessLogJsonFormat, error) {
+	fields := map[string]string{}
+	if err := json.Unmarshal([]byte(format), &fields); err != nil {
+		return nil, errors.Wrap(err, "JSON format is not valid")
+	}
+	return ParseJsonFormat(fields)
+}
+
+func (f *AccessLogJsonFormat) FormatHttpLogEntry(entry *accesslog_data.HTTPAccessLogEntry) (string, error) {
+	return f.format(func(format *AccessLogFormat) (string, error) {
+		return format.FormatHttpLogEntry(entry)
+	})
+}
+
+func (f *AccessLogJsonFormat) FormatTcpLogEntry(entry *accesslog_data.TCPAccessLogEntry) (string, error) {
+	return f.format(func(format *AccessLogFormat) (string, error) {
+		return format.FormatTcpLogEntry(entry)
+	})
+}
+
+func (f *AccessLogJsonFormat) format(formatField func(*AccessLogFormat) (string, error)) (string, error) {
+	values := map[string]string{}
+	for key, format := range f.Fields {
+		value, err := formatField(format)
+		if err != nil {
+			return "", err
+		}
+		values[key] = value
+	}
+	record, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	// to replicate Envoy's behaviour every JSON log entry is followed by a newline
+	return string(record) + "\n", nil
+}
+
+func (f *AccessLogJsonFormat) ConfigureHttpLog(config *accesslog_config.HttpGrpcAccessLogConfig) error {
+	for _, key := range f.keys() {
+		if err := f.Fields[key].ConfigureHttpLog(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *AccessLogJsonFormat) ConfigureTcpLog(config *accesslog_config.TcpGrpcAccessLogConfig) error {
+	for _, key := range f.keys() {
+		if err := f.Fields[key].ConfigureTcpLog(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *AccessLogJsonFormat) Interpolate(variables InterpolationVariables) (*AccessLogJsonFormat, error) {
+	fields := map[string]*AccessLogFormat{}
+	for key, format := range f.Fields {
+		interpolated, err := format.Interpolate(variables)
+		if err != nil {
+			return nil, errors.Wrapf(err, "value of %q", key)
+		}
+		fields[key] = interpolated
+	}
+	return &AccessLogJsonFormat{Fields: fields}, nil
+}
+
+// Strings returns the canonical representation of every value of this JSON format.
+func (f *AccessLogJsonFormat) Strings() map[string]string {
+	values := map[string]string{}
+	for key, format := range f.Fields {
+		values[key] = format.String()
+	}
+	return values
+}
+
+// String returns the canonical representation of this JSON format.
+func (f *AccessLogJsonFormat) String() string {
+	// marshalling of map[string]string cannot fail and keys are sorted
+	bytes, _ := json.Marshal(f.Strings())
+	return string(bytes)
+}
+
+func (f *AccessLogJsonFormat) keys() []string {
+	keys := make([]string, 0, len(f.Fields))
+	for key := range f.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedKeys(fields map[string]string) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
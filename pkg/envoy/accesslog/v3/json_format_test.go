@@ -0,0 +1,100 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+)
+
+var _ = Describe("ParseJsonFormat()", func() {
+
+	It("should format log entries as JSON objects", func() {
+		// when
+		format, err := ParseJsonFormat(map[string]string{
+			"protocol": "%PROTOCOL%",
+			"request":  `"%REQ(:METHOD)% %REQ(:PATH)%"`,
+			"sent":     "%BYTES_SENT%",
+		})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		httpRecord, err := format.FormatHttpLogEntry(&accesslog_data.HTTPAccessLogEntry{
+			ProtocolVersion: accesslog_data.HTTPAccessLogEntry_HTTP11,
+			Request: &accesslog_data.HTTPRequestProperties{
+				RequestMethod: envoy_core.RequestMethod_GET,
+				Path:          "/api",
+			},
+			Response: &accesslog_data.HTTPResponseProperties{
+				ResponseBodyBytes: 123,
+			},
+		})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(httpRecord).To(Equal(`{"protocol":"HTTP/1.1","request":"\"GET /api\"","sent":"123"}` + "\n"))
+
+		// when
+		tcpRecord, err := format.FormatTcpLogEntry(&accesslog_data.TCPAccessLogEntry{
+			ConnectionProperties: &accesslog_data.ConnectionProperties{
+				SentBytes: 456,
+			},
+		})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tcpRecord).To(Equal(`{"protocol":"-","request":"\"- -\"","sent":"456"}` + "\n"))
+	})
+
+	It("should configure HTTP log according to every value", func() {
+		// given
+		format, err := ParseJsonFormat(map[string]string{
+			"origin": "%REQ(ORIGIN)%",
+			"server": "%RESP(SERVER)%",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		config := &accesslog_config.HttpGrpcAccessLogConfig{}
+
+		// when
+		err = format.ConfigureHttpLog(config)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.AdditionalRequestHeadersToLog).To(Equal([]string{"origin"}))
+		Expect(config.AdditionalResponseHeadersToLog).To(Equal([]string{"server"}))
+	})
+
+	It("should round-trip through its canonical representation", func() {
+		// given
+		format, err := ParseJsonFormat(map[string]string{
+			"source":  "%KUMA_SOURCE_SERVICE%",
+			"request": "%REQ(X-REQUEST-ID)%",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		format, err = format.Interpolate(InterpolationVariables{
+			CMD_KUMA_SOURCE_SERVICE: "web",
+		})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(format.String()).To(Equal(`{"request":"%REQ(x-request-id)%","source":"web"}`))
+
+		// when
+		parsed, err := ParseJsonFormatString(format.String())
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed.String()).To(Equal(format.String()))
+	})
+
+	It("should reject an invalid value", func() {
+		// when
+		_, err := ParseJsonFormat(map[string]string{
+			"sent": "%bytes_sent%",
+		})
+		// then
+		Expect(err).To(MatchError(`value of "sent": format string is not valid: expected a command operator to start at position 1, instead got: "%bytes_sent%"`))
+	})
+})
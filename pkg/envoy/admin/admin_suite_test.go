@@ -11,6 +11,7 @@ import (
 	"github.com/kumahq/kuma/pkg/defaults/mesh"
 	"github.com/kumahq/kuma/pkg/envoy/admin"
 	"github.com/kumahq/kuma/pkg/test/runtime"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -43,13 +44,13 @@ var _ = BeforeSuite(func() {
 	err = resManager.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(testMesh, model.NoMesh))
 	Expect(err).ToNot(HaveOccurred())
 
-	err = mesh.EnsureDefaultMeshResources(runtime.ResourceManager(), testMesh)
+	err = mesh.EnsureDefaultMeshResources(runtime.ResourceManager(), testMesh, issuer.SigningMethodHS256)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = resManager.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(anotherMesh, model.NoMesh))
 	Expect(err).ToNot(HaveOccurred())
 
-	err = mesh.EnsureDefaultMeshResources(runtime.ResourceManager(), anotherMesh)
+	err = mesh.EnsureDefaultMeshResources(runtime.ResourceManager(), anotherMesh, issuer.SigningMethodHS256)
 	Expect(err).ToNot(HaveOccurred())
 
 	// setup the Envoy Admin Client
@@ -74,12 +74,20 @@ func (a *envoyAdminClient) GenerateAPIToken(dataplane *mesh_core.DataplaneResour
 }
 
 func (a *envoyAdminClient) getOrCreateSigningKey(mesh string) (string, error) {
-	key, err := issuer.GetSigningKey(a.rm, issuer.DataplaneTokenPrefix, mesh)
+	key, err := issuer.GetSigningKey(a.rm, issuer.EnvoyAdminClientTokenPrefix, mesh)
+	if err == nil {
+		return string(key), nil
+	}
+	if !issuer.IsSigningKeyNotFoundErr(err) {
+		return "", errors.Wrap(err, "unable to retrieve the signing key")
+	}
+	// Meshes created before the Envoy Admin Client key was introduced have only the Dataplane Token key.
+	// The Dataplane Token key is not available on the Zone CP when tokens are signed with the asymmetric method.
+	dpTokenKey, err := issuer.GetLatestSigningKey(a.rm, issuer.DataplaneTokenPrefix, mesh)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to retrieve the signing key")
 	}
-
-	return string(key), nil
+	return string(dpTokenKey.Key), nil
 }
 
 func (a *envoyAdminClient) adminAddress(dataplane *mesh_core.DataplaneResource) string {
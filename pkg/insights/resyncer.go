@@ -284,6 +284,10 @@ func (r *resyncer) createOrUpdateMeshInsight(mesh string) error {
 			KumaDp: map[string]*mesh_proto.MeshInsight_DataplaneStat{},
 			Envoy:  map[string]*mesh_proto.MeshInsight_DataplaneStat{},
 		},
+		MTLS: &mesh_proto.MeshInsight_MTLS{
+			IssuedBackends:    map[string]*mesh_proto.MeshInsight_DataplaneStat{},
+			SupportedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{},
+		},
 	}
 
 	dataplanes := &core_mesh.DataplaneResourceList{}
@@ -329,6 +333,13 @@ func (r *resyncer) createOrUpdateMeshInsight(mesh string) error {
 
 		updateTotal(kumaDpVersion, insight.DpVersions.KumaDp)
 		updateTotal(envoyVersion, insight.DpVersions.Envoy)
+
+		if issuedBackend := dpInsight.GetMTLS().GetIssuedBackend(); issuedBackend != "" {
+			updateBackendStat(issuedBackend, status, insight.MTLS.IssuedBackends)
+		}
+		for _, supportedBackend := range dpInsight.GetMTLS().GetSupportedBackends() {
+			updateBackendStat(supportedBackend, status, insight.MTLS.SupportedBackends)
+		}
 	}
 
 	for _, resType := range registry.Global().ListTypes() {
@@ -352,6 +363,8 @@ func (r *resyncer) createOrUpdateMeshInsight(mesh string) error {
 
 	err := manager.Upsert(r.rm, model.ResourceKey{Mesh: model.NoMesh, Name: mesh}, core_mesh.NewMeshInsightResource(), func(resource model.Resource) {
 		insight.LastSync = proto.MustTimestampProto(core.Now())
+		// state of CA backends is maintained by the CA lifecycle component
+		insight.MTLS.CaBackends = resource.(*core_mesh.MeshInsightResource).Spec.GetMTLS().GetCaBackends()
 		_ = resource.SetSpec(insight)
 	})
 	if err != nil {
@@ -374,6 +387,21 @@ func updateTotal(version string, dpStats map[string]*mesh_proto.MeshInsight_Data
 	dpStats[version].Total = dpStats[version].Online + dpStats[version].Offline
 }
 
+func updateBackendStat(backend string, status core_mesh.Status, dpStats map[string]*mesh_proto.MeshInsight_DataplaneStat) {
+	if _, exists := dpStats[backend]; !exists {
+		dpStats[backend] = &mesh_proto.MeshInsight_DataplaneStat{}
+	}
+	switch status {
+	case core_mesh.Online:
+		dpStats[backend].Online++
+	case core_mesh.PartiallyDegraded:
+		dpStats[backend].PartiallyDegraded++
+	case core_mesh.Offline:
+		dpStats[backend].Offline++
+	}
+	dpStats[backend].Total++
+}
+
 func ensureVersionExists(version string, m map[string]*mesh_proto.MeshInsight_DataplaneStat) {
 	if _, versionExists := m[version]; !versionExists {
 		m[version] = &mesh_proto.MeshInsight_DataplaneStat{}
@@ -413,6 +441,10 @@ func (r *resyncer) needResyncMeshInsight(mesh string) (bool, error) {
 		}
 		return true, nil
 	}
+	if meshInsight.Spec.LastSync == nil {
+		// MeshInsight could have been created by the CA lifecycle component before the first resync
+		return true, nil
+	}
 	lastSync, err := ptypes.Timestamp(meshInsight.Spec.LastSync)
 	if err != nil {
 		return false, errors.Wrapf(err, "lastSync has wrong value: %s", meshInsight.Spec.LastSync)
@@ -173,6 +173,84 @@ var _ = Describe("Insight Persistence", func() {
 		Expect(envoy["1.15.0"].Offline).To(Equal(uint32(2)))
 	})
 
+	It("should count dataplanes by CA backends", func() {
+		// setup
+		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		for i, mtls := range []*mesh_proto.DataplaneInsight_MTLS{
+			{IssuedBackend: "ca-1", SupportedBackends: []string{"ca-2", "ca-1"}},
+			{IssuedBackend: "ca-2", SupportedBackends: []string{"ca-2", "ca-1"}},
+			nil,
+		} {
+			name := "dp" + strconv.Itoa(i+1)
+			err = rm.Create(context.Background(), &core_mesh.DataplaneResource{Spec: samples.Dataplane}, store.CreateByKey(name, "mesh-1"))
+			Expect(err).ToNot(HaveOccurred())
+
+			dpInsight := core_mesh.NewDataplaneInsightResource()
+			dpInsight.Spec.MTLS = mtls
+			err = rm.Create(context.Background(), dpInsight, store.CreateByKey(name, "mesh-1"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		nowMtx.Lock()
+		now = now.Add(60 * time.Second)
+		nowMtx.Unlock()
+		tickCh <- now
+
+		// when
+		meshInsight := core_mesh.NewMeshInsightResource()
+		Eventually(func() error {
+			return rm.Get(context.Background(), meshInsight, store.GetByKey("mesh-1", model.NoMesh))
+		}, "10s", "100ms").Should(BeNil())
+
+		// then
+		issued := meshInsight.Spec.MTLS.IssuedBackends
+		Expect(issued).To(HaveLen(2))
+		Expect(issued["ca-1"].Total).To(Equal(uint32(1)))
+		Expect(issued["ca-1"].Offline).To(Equal(uint32(1)))
+		Expect(issued["ca-2"].Total).To(Equal(uint32(1)))
+
+		supported := meshInsight.Spec.MTLS.SupportedBackends
+		Expect(supported).To(HaveLen(2))
+		Expect(supported["ca-1"].Total).To(Equal(uint32(2)))
+		Expect(supported["ca-2"].Total).To(Equal(uint32(2)))
+	})
+
+	It("should preserve state of CA backends", func() {
+		// setup
+		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and state of CA backends reported by the CA lifecycle component
+		meshInsight := core_mesh.NewMeshInsightResource()
+		meshInsight.Spec.MTLS = &mesh_proto.MeshInsight_MTLS{
+			CaBackends: map[string]*mesh_proto.MeshInsight_MTLS_CaBackend{
+				"ca-1": {Warnings: []string{"root certificate expires at 2021-01-01T00:00:00Z"}},
+			},
+		}
+		err = rm.Create(context.Background(), meshInsight, store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		nowMtx.Lock()
+		now = now.Add(60 * time.Second)
+		nowMtx.Unlock()
+		tickCh <- now
+
+		// when
+		Eventually(func() bool {
+			meshInsight = core_mesh.NewMeshInsightResource()
+			if err := rm.Get(context.Background(), meshInsight, store.GetByKey("mesh-1", model.NoMesh)); err != nil {
+				return false
+			}
+			return meshInsight.Spec.LastSync != nil
+		}, "10s", "100ms").Should(BeTrue())
+
+		// then
+		Expect(meshInsight.Spec.MTLS.CaBackends).To(HaveLen(1))
+		Expect(meshInsight.Spec.MTLS.CaBackends["ca-1"].Warnings).To(ConsistOf("root certificate expires at 2021-01-01T00:00:00Z"))
+	})
+
 	It("should not count dataplane as a policy", func() {
 		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
 		Expect(err).ToNot(HaveOccurred())
@@ -13,6 +13,7 @@ import (
 	"github.com/kumahq/kuma/pkg/kds/mux"
 	"github.com/kumahq/kuma/pkg/kds/reconcile"
 	"github.com/kumahq/kuma/pkg/kds/util"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
 var log = core.Log.WithName("kds")
@@ -22,6 +23,7 @@ type Context struct {
 	GlobalServerCallbacks []mux.Callbacks
 	GlobalProvidedFilter  reconcile.ResourceFilter
 	ZoneProvidedFilter    reconcile.ResourceFilter
+	GlobalResourceMapper  reconcile.ResourceMapper
 	// Configs contains the names of system.ConfigResource that will be transferred from Global to Zone
 	Configs map[string]bool
 }
@@ -34,6 +36,7 @@ func DefaultContext(manager manager.ResourceManager, zone string) *Context {
 		ZoneClientCtx:        context.Background(),
 		GlobalProvidedFilter: GlobalProvidedFilter(manager, configs),
 		ZoneProvidedFilter:   ZoneProvidedFilter(zone),
+		GlobalResourceMapper: reconcile.NoopResourceMapper,
 		Configs:              configs,
 	}
 }
@@ -75,3 +78,22 @@ func ZoneProvidedFilter(clusterName string) reconcile.ResourceFilter {
 		return r.GetType() == mesh.DataplaneInsightType
 	}
 }
+
+// PublicSigningKeysMapper replaces Dataplane Token Signing Keys with their public keys,
+// so private keys used for asymmetric signing of the tokens never leave the Global CP.
+func PublicSigningKeysMapper(r model.Resource) (model.Resource, error) {
+	secret, ok := r.(*system.SecretResource)
+	if !ok {
+		return r, nil
+	}
+	publicKey, key, ok, err := issuer.PublicKeySecret(issuer.DataplaneTokenPrefix, secret)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r, nil
+	}
+	meta := r.GetMeta()
+	publicKey.SetMeta(util.NewResourceMeta(key.Name, key.Mesh, meta.GetVersion(), meta.GetCreationTime(), meta.GetModificationTime()))
+	return publicKey, nil
+}
@@ -0,0 +1,51 @@
+package context_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	kds_context "github.com/kumahq/kuma/pkg/kds/context"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("PublicSigningKeysMapper", func() {
+
+	It("should replace signing key with the public key", func() {
+		// given
+		signingKey, err := issuer.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		signingKey.SetMeta(&test_model.ResourceMeta{
+			Mesh:    "demo",
+			Name:    "dataplane-token-rotated-signing-key-demo-2",
+			Version: "1",
+		})
+
+		// when
+		mapped, err := kds_context.PublicSigningKeysMapper(signingKey)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mapped.GetMeta().GetName()).To(Equal("dataplane-token-rotated-public-key-demo-2"))
+		Expect(mapped.GetMeta().GetMesh()).To(Equal("demo"))
+		Expect(mapped.GetMeta().GetVersion()).To(Equal("1"))
+		Expect(string(mapped.(*system.SecretResource).Spec.GetData().GetValue())).To(HavePrefix("-----BEGIN PUBLIC KEY-----"))
+	})
+
+	It("should not change other secrets", func() {
+		// given
+		secret := system.NewSecretResource()
+		secret.SetMeta(&test_model.ResourceMeta{
+			Mesh: "demo",
+			Name: "my-secret",
+		})
+
+		// when
+		mapped, err := kds_context.PublicSigningKeysMapper(secret)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mapped).To(BeIdenticalTo(secret))
+	})
+})
@@ -58,11 +58,11 @@ var (
 func Setup(rt runtime.Runtime) (err error) {
 	kdsServer, err := kds_server.New(kdsGlobalLog, rt, ProvidedTypes,
 		"global", rt.Config().Multizone.Global.KDS.RefreshInterval,
-		rt.KDSContext().GlobalProvidedFilter, true)
+		rt.KDSContext().GlobalProvidedFilter, rt.KDSContext().GlobalResourceMapper, true)
 	if err != nil {
 		return err
 	}
-	resourceSyncer := sync_store.NewResourceSyncer(kdsGlobalLog, rt.ResourceStore())
+	resourceSyncer := sync_store.NewResourceSyncer(kdsGlobalLog, rt.ResourceStore(), rt.AuditLog())
 	kubeFactory := resources_k8s.NewSimpleKubeFactory()
 	onSessionStarted := mux.OnSessionStartedFunc(func(session mux.Session) error {
 		log := kdsGlobalLog.WithValues("peer-id", session.PeerID())
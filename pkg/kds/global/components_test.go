@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/kumahq/kuma/pkg/audit"
 	"github.com/kumahq/kuma/pkg/core"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -58,7 +59,7 @@ var _ = Describe("Global Sync", func() {
 
 		// Start 1 Kuma CP Global
 		globalStore = memory.NewStore()
-		globalSyncer = sync_store.NewResourceSyncer(core.Log, globalStore)
+		globalSyncer = sync_store.NewResourceSyncer(core.Log, globalStore, audit.NewNoopLog())
 		stopCh := make(chan struct{})
 		clientStreams := []*grpc.MockClientStream{}
 		for _, ss := range serverStreams {
@@ -22,11 +22,19 @@ func Any(clusterID string, r model.Resource) bool {
 	return true
 }
 
-func NewSnapshotGenerator(resourceManager core_manager.ReadOnlyResourceManager, types []model.ResourceType, filter ResourceFilter) SnapshotGenerator {
+// ResourceMapper transforms the Resource before it is sent to the peer.
+type ResourceMapper func(r model.Resource) (model.Resource, error)
+
+func NoopResourceMapper(r model.Resource) (model.Resource, error) {
+	return r, nil
+}
+
+func NewSnapshotGenerator(resourceManager core_manager.ReadOnlyResourceManager, types []model.ResourceType, filter ResourceFilter, mapper ResourceMapper) SnapshotGenerator {
 	return &snapshotGenerator{
 		resourceManager: resourceManager,
 		resourceTypes:   types,
 		resourceFilter:  filter,
+		resourceMapper:  mapper,
 	}
 }
 
@@ -34,6 +42,7 @@ type snapshotGenerator struct {
 	resourceManager core_manager.ReadOnlyResourceManager
 	resourceTypes   []model.ResourceType
 	resourceFilter  ResourceFilter
+	resourceMapper  ResourceMapper
 }
 
 func (s *snapshotGenerator) GenerateSnapshot(ctx context.Context, node *envoy_core.Node) (util_xds.Snapshot, error) {
@@ -57,7 +66,11 @@ func (s *snapshotGenerator) getResources(context context.Context, typ model.Reso
 	if err := s.resourceManager.List(context, rlist); err != nil {
 		return nil, err
 	}
-	return util.ToEnvoyResources(s.filter(rlist, node))
+	mapped, err := s.mapper(s.filter(rlist, node))
+	if err != nil {
+		return nil, err
+	}
+	return util.ToEnvoyResources(mapped)
 }
 
 func (s *snapshotGenerator) filter(rs model.ResourceList, node *envoy_core.Node) model.ResourceList {
@@ -69,3 +82,15 @@ func (s *snapshotGenerator) filter(rs model.ResourceList, node *envoy_core.Node)
 	}
 	return rv
 }
+
+func (s *snapshotGenerator) mapper(rs model.ResourceList) (model.ResourceList, error) {
+	rv, _ := registry.Global().NewList(rs.GetItemType())
+	for _, r := range rs.GetItems() {
+		resource, err := s.resourceMapper(r)
+		if err != nil {
+			return nil, err
+		}
+		_ = rv.AddItem(resource)
+	}
+	return rv, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/golang/protobuf/proto"
 
+	"github.com/kumahq/kuma/pkg/audit"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 
 	"github.com/kumahq/kuma/pkg/core/resources/model"
@@ -57,12 +58,14 @@ func PrefilterBy(predicate func(r model.Resource) bool) SyncOptionFunc {
 type syncResourceStore struct {
 	log           logr.Logger
 	resourceStore store.ResourceStore
+	auditLog      audit.Log
 }
 
-func NewResourceSyncer(log logr.Logger, resourceStore store.ResourceStore) ResourceSyncer {
+func NewResourceSyncer(log logr.Logger, resourceStore store.ResourceStore, auditLog audit.Log) ResourceSyncer {
 	return &syncResourceStore{
 		log:           log,
 		resourceStore: resourceStore,
+		auditLog:      auditLog,
 	}
 }
 
@@ -103,13 +106,16 @@ func (s *syncResourceStore) Sync(upstream model.ResourceList, fs ...SyncOptionFu
 	// 2. create resources which are not represented in 'downstream' and update the rest of them
 	onCreate := []model.Resource{}
 	onUpdate := []model.Resource{}
+	updatedLabels := map[model.ResourceKey]map[string]string{}
 	for _, r := range upstream.GetItems() {
-		existing := indexedDownstream.get(model.MetaToResourceKey(r.GetMeta()))
+		rk := model.MetaToResourceKey(r.GetMeta())
+		existing := indexedDownstream.get(rk)
 		if existing == nil {
 			onCreate = append(onCreate, r)
 			continue
 		}
-		if !proto.Equal(existing.GetSpec(), r.GetSpec()) {
+		if !proto.Equal(existing.GetSpec(), r.GetSpec()) || !equalLabels(existing.GetMeta().GetLabels(), r.GetMeta().GetLabels()) {
+			updatedLabels[rk] = r.GetMeta().GetLabels()
 			// we have to use meta of the current Store during update, because some Stores (Kubernetes, Memory)
 			// expect to receive ResourceMeta of own type.
 			r.SetMeta(existing.GetMeta())
@@ -123,6 +129,7 @@ func (s *syncResourceStore) Sync(upstream model.ResourceList, fs ...SyncOptionFu
 		if err := s.resourceStore.Delete(ctx, r, store.DeleteBy(rk)); err != nil {
 			return err
 		}
+		s.record(opts, audit.Delete, r.GetType(), rk, r.GetSpec(), nil)
 	}
 
 	zone := system.NewZoneResource()
@@ -136,6 +143,7 @@ func (s *syncResourceStore) Sync(upstream model.ResourceList, fs ...SyncOptionFu
 		rk := model.MetaToResourceKey(r.GetMeta())
 		log.Info("creating a new resource from upstream", "name", r.GetMeta().GetName(), "mesh", r.GetMeta().GetMesh())
 		creationTime := r.GetMeta().GetCreationTime()
+		labels := r.GetMeta().GetLabels()
 		// some Stores try to cast ResourceMeta to own Store type that's why we have to set meta to nil
 		r.SetMeta(nil)
 
@@ -143,6 +151,7 @@ func (s *syncResourceStore) Sync(upstream model.ResourceList, fs ...SyncOptionFu
 			store.CreateBy(rk),
 			store.CreatedAt(creationTime),
 			store.CreateSynced(),
+			store.CreateWithLabels(labels),
 		}
 		if opts.Zone != "" {
 			createOpts = append(createOpts, store.CreateWithOwner(zone))
@@ -150,6 +159,7 @@ func (s *syncResourceStore) Sync(upstream model.ResourceList, fs ...SyncOptionFu
 		if err := s.resourceStore.Create(ctx, r, createOpts...); err != nil {
 			return err
 		}
+		s.record(opts, audit.Create, r.GetType(), rk, nil, r.GetSpec())
 	}
 
 	for _, r := range onUpdate {
@@ -158,14 +168,29 @@ func (s *syncResourceStore) Sync(upstream model.ResourceList, fs ...SyncOptionFu
 		// some stores manage ModificationTime time on they own (Kubernetes), in order to be consistent
 		// we set ModificationTime when we add to downstream store. This time is almost the same with ModificationTime
 		// from upstream store, because we update downstream only when resource have changed in upstream
-		if err := s.resourceStore.Update(ctx, r, store.ModifiedAt(now), store.UpdateSynced()); err != nil {
+		rk := model.MetaToResourceKey(r.GetMeta())
+		if err := s.resourceStore.Update(ctx, r, store.ModifiedAt(now), store.UpdateSynced(), store.UpdateWithLabels(updatedLabels[rk])); err != nil {
 			return err
 		}
+		s.record(opts, audit.Update, r.GetType(), rk, indexedDownstream.get(rk).GetSpec(), r.GetSpec())
 	}
 
 	return nil
 }
 
+// record adds the change to the audit log. The actor is the zone that sent the resource on Global
+// and "global" on Zone.
+func (s *syncResourceStore) record(opts *SyncOption, op audit.Operation, resType model.ResourceType, rk model.ResourceKey, before, after model.ResourceSpec) {
+	if !audit.IsAudited(resType) {
+		return
+	}
+	actor := audit.Actor{Name: "global"}
+	if opts.Zone != "" {
+		actor = audit.Actor{Name: "zone:" + opts.Zone}
+	}
+	s.auditLog.Record(audit.NewEntry(audit.KDS, actor, op, resType, rk, before, after))
+}
+
 func filter(rs model.ResourceList, predicate func(r model.Resource) bool) (model.ResourceList, error) {
 	rv, err := registry.Global().NewList(rs.GetItemType())
 	if err != nil {
@@ -196,3 +221,15 @@ func newIndexed(rs model.ResourceList) *indexed {
 	}
 	return &indexed{indexByResourceKey: idxByRk}
 }
+
+func equalLabels(l1, l2 map[string]string) bool {
+	if len(l1) != len(l2) {
+		return false
+	}
+	for key, value := range l1 {
+		if actual, ok := l2[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
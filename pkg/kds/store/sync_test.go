@@ -11,7 +11,9 @@ import (
 	. "github.com/onsi/gomega"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/audit"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	sync_store "github.com/kumahq/kuma/pkg/kds/store"
@@ -22,6 +24,7 @@ import (
 var _ = Describe("SyncResourceStore", func() {
 	var syncer sync_store.ResourceSyncer
 	var resourceStore store.ResourceStore
+	var auditLog audit.Log
 
 	meshBuilder := func(idx int) *mesh.MeshResource {
 		ca := fmt.Sprintf("ca-%d", idx)
@@ -46,7 +49,8 @@ var _ = Describe("SyncResourceStore", func() {
 
 	BeforeEach(func() {
 		resourceStore = memory.NewStore()
-		syncer = sync_store.NewResourceSyncer(core.Log, resourceStore)
+		auditLog = audit.NewLog(100, nil)
+		syncer = sync_store.NewResourceSyncer(core.Log, resourceStore, auditLog)
 	})
 
 	It("should create new resources in empty store", func() {
@@ -110,4 +114,70 @@ var _ = Describe("SyncResourceStore", func() {
 			Expect(item.Spec).To(MatchProto(upstream.Items[i].Spec))
 		}
 	})
+
+	It("should record the changes in the audit log", func() {
+		err := resourceStore.Create(context.Background(), system.NewZoneResource(), store.CreateByKey("zone-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		for i := 0; i < 3; i++ {
+			m := meshBuilder(i)
+			err := resourceStore.Create(context.Background(), m, store.CreateBy(model.MetaToResourceKey(m.GetMeta())))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		upstream := &mesh.MeshResourceList{}
+		updated := meshBuilder(1)
+		updated.Spec.Mtls.EnabledBackend = ""
+		Expect(upstream.AddItem(updated)).To(Succeed())
+		Expect(upstream.AddItem(meshBuilder(2))).To(Succeed())
+		Expect(upstream.AddItem(meshBuilder(3))).To(Succeed())
+
+		err = syncer.Sync(upstream, sync_store.Zone("zone-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		type change struct {
+			op   audit.Operation
+			name string
+		}
+		var changes []change
+		for _, entry := range auditLog.Entries() {
+			Expect(entry.Origin).To(Equal(audit.KDS))
+			Expect(entry.Actor.Name).To(Equal("zone:zone-1"))
+			changes = append(changes, change{op: entry.Operation, name: entry.Name})
+		}
+		Expect(changes).To(Equal([]change{
+			{op: audit.Delete, name: "mesh-0"},
+			{op: audit.Create, name: "mesh-3"},
+			{op: audit.Update, name: "mesh-1"},
+		}))
+		Expect(auditLog.Entries()[2].Changes).To(Equal([]audit.Change{
+			{Path: "mtls.enabledBackend", Before: "ca-1"},
+		}))
+	})
+
+	It("should sync labels of the resources", func() {
+		// given
+		existing := meshBuilder(1)
+		err := resourceStore.Create(context.Background(), existing, store.CreateBy(model.MetaToResourceKey(existing.GetMeta())),
+			store.CreateWithLabels(map[string]string{"team": "payments"}))
+		Expect(err).ToNot(HaveOccurred())
+
+		upstream := &mesh.MeshResourceList{}
+		for i, team := range []string{"orders", "payments"} {
+			m := meshBuilder(i + 1)
+			m.Meta.(*model2.ResourceMeta).Labels = map[string]string{"team": team}
+			Expect(upstream.AddItem(m)).To(Succeed())
+		}
+
+		// when
+		err = syncer.Sync(upstream)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		actual := &mesh.MeshResourceList{}
+		err = resourceStore.List(context.Background(), actual)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual.Items).To(HaveLen(2))
+		Expect(actual.Items[0].Meta.GetLabels()).To(Equal(map[string]string{"team": "orders"}))
+		Expect(actual.Items[1].Meta.GetLabels()).To(Equal(map[string]string{"team": "payments"}))
+	})
 })
@@ -14,6 +14,7 @@ type resourceMeta struct {
 	mesh             string
 	creationTime     *time.Time
 	modificationTime *time.Time
+	labels           map[string]string
 }
 
 func NewResourceMeta(name, mesh, version string, creationTime, modificationTime time.Time) model.ResourceMeta {
@@ -33,6 +34,21 @@ func kumaResourceMetaToResourceMeta(meta *mesh_proto.KumaResource_Meta) model.Re
 		version:          meta.Version,
 		creationTime:     proto.MustTimestampFromProto(meta.CreationTime),
 		modificationTime: proto.MustTimestampFromProto(meta.ModificationTime),
+		labels:           meta.Labels,
+	}
+}
+
+// renamedResourceMeta returns a copy of the meta with the changed name.
+func renamedResourceMeta(meta model.ResourceMeta, name string) model.ResourceMeta {
+	creationTime := meta.GetCreationTime()
+	modificationTime := meta.GetModificationTime()
+	return &resourceMeta{
+		name:             name,
+		mesh:             meta.GetMesh(),
+		version:          meta.GetVersion(),
+		creationTime:     &creationTime,
+		modificationTime: &modificationTime,
+		labels:           meta.GetLabels(),
 	}
 }
 
@@ -59,3 +75,7 @@ func (r *resourceMeta) GetCreationTime() time.Time {
 func (r *resourceMeta) GetModificationTime() time.Time {
 	return *r.modificationTime
 }
+
+func (r *resourceMeta) GetLabels() map[string]string {
+	return r.labels
+}
@@ -40,6 +40,7 @@ func ToEnvoyResources(rlist model.ResourceList) ([]envoy_types.Resource, error)
 				CreationTime:     proto.MustTimestampProto(r.GetMeta().GetCreationTime()),
 				ModificationTime: proto.MustTimestampProto(r.GetMeta().GetModificationTime()),
 				Version:          r.GetMeta().GetVersion(),
+				Labels:           r.GetMeta().GetLabels(),
 			},
 			Spec: pbany,
 		})
@@ -50,18 +51,14 @@ func ToEnvoyResources(rlist model.ResourceList) ([]envoy_types.Resource, error)
 func AddPrefixToNames(rs []model.Resource, prefix string) {
 	for _, r := range rs {
 		newName := fmt.Sprintf("%s.%s", prefix, r.GetMeta().GetName())
-		m := NewResourceMeta(newName, r.GetMeta().GetMesh(), r.GetMeta().GetVersion(),
-			r.GetMeta().GetCreationTime(), r.GetMeta().GetModificationTime())
-		r.SetMeta(m)
+		r.SetMeta(renamedResourceMeta(r.GetMeta(), newName))
 	}
 }
 
 func AddSuffixToNames(rs []model.Resource, suffix string) {
 	for _, r := range rs {
 		newName := fmt.Sprintf("%s.%s", r.GetMeta().GetName(), suffix)
-		m := NewResourceMeta(newName, r.GetMeta().GetMesh(), r.GetMeta().GetVersion(),
-			r.GetMeta().GetCreationTime(), r.GetMeta().GetModificationTime())
-		r.SetMeta(m)
+		r.SetMeta(renamedResourceMeta(r.GetMeta(), newName))
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
 	"github.com/kumahq/kuma/pkg/kds/mux"
+	"github.com/kumahq/kuma/pkg/kds/reconcile"
 	kds_server "github.com/kumahq/kuma/pkg/kds/server"
 	resources_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s"
 	k8s_model "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
@@ -51,11 +52,11 @@ func Setup(rt core_runtime.Runtime) error {
 	zone := rt.Config().Multizone.Zone.Name
 	kdsServer, err := kds_server.New(kdsZoneLog, rt, ProvidedTypes,
 		zone, rt.Config().Multizone.Zone.KDS.RefreshInterval,
-		rt.KDSContext().ZoneProvidedFilter, false)
+		rt.KDSContext().ZoneProvidedFilter, reconcile.NoopResourceMapper, false)
 	if err != nil {
 		return err
 	}
-	resourceSyncer := sync_store.NewResourceSyncer(kdsZoneLog, rt.ResourceStore())
+	resourceSyncer := sync_store.NewResourceSyncer(kdsZoneLog, rt.ResourceStore(), rt.AuditLog())
 	kubeFactory := resources_k8s.NewSimpleKubeFactory()
 	onSessionStarted := mux.OnSessionStartedFunc(func(session mux.Session) error {
 		log := kdsZoneLog.WithValues("peer-id", session.PeerID())
@@ -3,15 +3,17 @@ package builtin
 import (
 	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
+	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/pem"
 	"math/big"
 	"net/url"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spiffe/go-spiffe/spiffe"
+	"github.com/spiffe/spire/pkg/common/x509util"
 
 	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
@@ -19,9 +21,11 @@ import (
 )
 
 const (
-	DefaultRsaBits              = 2048
-	DefaultAllowedClockSkew     = 10 * time.Second
-	DefaultCACertValidityPeriod = 10 * 365 * 24 * time.Hour
+	DefaultRsaBits                        = 2048
+	DefaultAllowedClockSkew               = 10 * time.Second
+	DefaultCACertValidityPeriod           = 10 * 365 * 24 * time.Hour
+	DefaultIntermediateCertValidityPeriod = 365 * 24 * time.Hour
+	DefaultAutoRotationBefore             = 30 * 24 * time.Hour
 )
 
 type certOptsFn = func(*x509.Certificate)
@@ -33,11 +37,11 @@ func withExpirationTime(expiration time.Duration) certOptsFn {
 	}
 }
 
-func newRootCa(mesh string, rsaBits int, certOpts ...certOptsFn) (*core_ca.KeyPair, error) {
+func newRootCa(mesh string, keyType util_tls.KeyType, rsaBits int, certOpts ...certOptsFn) (*core_ca.KeyPair, error) {
 	if rsaBits == 0 {
 		rsaBits = DefaultRsaBits
 	}
-	key, err := rsa.GenerateKey(rand.Reader, rsaBits)
+	key, err := util_tls.NewKey(keyType, rsaBits)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate a private key")
 	}
@@ -48,6 +52,65 @@ func newRootCa(mesh string, rsaBits int, certOpts ...certOptsFn) (*core_ca.KeyPa
 	return util_tls.ToKeyPair(key, cert)
 }
 
+// newIntermediateCa generates an intermediate CA signed by the root CA.
+// The intermediate CA cannot sign other CAs, only workload certificates.
+func newIntermediateCa(root core_ca.KeyPair, mesh string, keyType util_tls.KeyType, rsaBits int, certOpts ...certOptsFn) (*core_ca.KeyPair, error) {
+	rootPair, err := tls.X509KeyPair(root.CertPEM, root.KeyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse root CA key pair")
+	}
+	rootCert, err := x509.ParseCertificate(rootPair.Certificate[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse root CA certificate")
+	}
+	rootSigner, ok := rootPair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.Errorf("unsupported root CA private key type %T", rootPair.PrivateKey)
+	}
+
+	if rsaBits == 0 {
+		rsaBits = DefaultRsaBits
+	}
+	key, err := util_tls.NewKey(keyType, rsaBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate a private key")
+	}
+
+	spiffeID := &url.URL{
+		Scheme: "spiffe",
+		Host:   mesh,
+	}
+	subject := pkix.Name{
+		Organization:       []string{"Kuma"},
+		OrganizationalUnit: []string{"Mesh"},
+		CommonName:         mesh + " intermediate",
+	}
+	now := core.Now()
+	notBefore := now.Add(-DefaultAllowedClockSkew)
+	notAfter := now.Add(DefaultIntermediateCertValidityPeriod)
+	serialNumber, err := x509util.NewSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	template, err := caTemplate(spiffeID.String(), mesh, subject, key.Public(), notBefore, notAfter, serialNumber)
+	if err != nil {
+		return nil, err
+	}
+	template.MaxPathLenZero = true
+	for _, opt := range certOpts {
+		opt(template)
+	}
+	if template.NotAfter.After(rootCert.NotAfter) {
+		return nil, errors.Errorf("intermediate CA certificate cannot outlive the root CA certificate which expires at %s", rootCert.NotAfter)
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, rootCert, key.Public(), rootSigner)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate X509 certificate")
+	}
+	return util_tls.ToKeyPair(key, cert)
+}
+
 func newCACert(signer crypto.Signer, trustDomain string, certOpts ...certOptsFn) ([]byte, error) {
 	spiffeID := &url.URL{
 		Scheme: "spiffe",
@@ -91,3 +154,70 @@ func caTemplate(spiffeID string, trustDomain string, subject pkix.Name, publicKe
 		PublicKey:             publicKey,
 	}, nil
 }
+
+// newCrl generates a CRL signed by the CA that revokes certificates of given serial numbers.
+// The CRL is valid as long as the CA, because it is generated again whenever the list of revoked certificates changes.
+func newCrl(ca core_ca.KeyPair, serials []*big.Int) (core_ca.Crl, error) {
+	pair, err := tls.X509KeyPair(ca.CertPEM, ca.KeyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse CA key pair")
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse CA certificate")
+	}
+	signer, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.Errorf("unsupported CA private key type %T", pair.PrivateKey)
+	}
+
+	now := core.Now()
+	thisUpdate := now.Add(-DefaultAllowedClockSkew)
+	var revoked []pkix.RevokedCertificate
+	for _, serial := range serials {
+		revoked = append(revoked, pkix.RevokedCertificate{
+			SerialNumber:   serial,
+			RevocationTime: thisUpdate,
+		})
+	}
+	template := &x509.RevocationList{
+		Number:              big.NewInt(now.UnixNano()),
+		ThisUpdate:          thisUpdate,
+		NextUpdate:          cert.NotAfter,
+		RevokedCertificates: revoked,
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, template, cert, signer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate CRL")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}), nil
+}
+
+// revokesExactly checks if the PEM encoded CRL revokes exactly the given serial numbers
+func revokesExactly(crl core_ca.Crl, serials []*big.Int) bool {
+	block, _ := pem.Decode(crl)
+	if block == nil {
+		return false
+	}
+	parsed, err := x509.ParseCRL(block.Bytes)
+	if err != nil {
+		return false
+	}
+	revoked := map[string]bool{}
+	for _, cert := range parsed.TBSCertList.RevokedCertificates {
+		revoked[cert.SerialNumber.Text(16)] = true
+	}
+	expected := map[string]bool{}
+	for _, serial := range serials {
+		expected[serial.Text(16)] = true
+	}
+	if len(revoked) != len(expected) {
+		return false
+	}
+	for serial := range expected {
+		if !revoked[serial] {
+			return false
+		}
+	}
+	return true
+}
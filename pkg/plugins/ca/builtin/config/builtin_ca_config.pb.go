@@ -35,6 +35,19 @@ type BuiltinCertificateAuthorityConfig struct {
 
 	// Configuration of CA Certificate
 	CaCert *BuiltinCertificateAuthorityConfig_CaCert `protobuf:"bytes,1,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	// Configuration of intermediate CA Certificate. When defined, Dataplane
+	// certificates are signed by the intermediate CA and the private key of the
+	// root CA is not stored. It is applied only when the CA is created.
+	// Because the private key of the root CA is discarded, the intermediate CA
+	// cannot be reissued and the backend stops issuing certificates once it
+	// expires. Either enable autoRotation, so a successor backend is created
+	// before the expiration, or define the expiration of the intermediate CA
+	// explicitly and replace the backend manually.
+	IntermediateCert *BuiltinCertificateAuthorityConfig_IntermediateCert `protobuf:"bytes,2,opt,name=intermediateCert,proto3" json:"intermediateCert,omitempty"`
+	// Configuration of Dataplane certificates
+	DpCert *BuiltinCertificateAuthorityConfig_DpCert `protobuf:"bytes,3,opt,name=dpCert,proto3" json:"dpCert,omitempty"`
+	// Configuration of automatic rotation of the CA
+	AutoRotation *BuiltinCertificateAuthorityConfig_AutoRotation `protobuf:"bytes,4,opt,name=autoRotation,proto3" json:"autoRotation,omitempty"`
 }
 
 func (x *BuiltinCertificateAuthorityConfig) Reset() {
@@ -76,6 +89,27 @@ func (x *BuiltinCertificateAuthorityConfig) GetCaCert() *BuiltinCertificateAutho
 	return nil
 }
 
+func (x *BuiltinCertificateAuthorityConfig) GetIntermediateCert() *BuiltinCertificateAuthorityConfig_IntermediateCert {
+	if x != nil {
+		return x.IntermediateCert
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig) GetDpCert() *BuiltinCertificateAuthorityConfig_DpCert {
+	if x != nil {
+		return x.DpCert
+	}
+	return nil
+}
+
+func (x *BuiltinCertificateAuthorityConfig) GetAutoRotation() *BuiltinCertificateAuthorityConfig_AutoRotation {
+	if x != nil {
+		return x.AutoRotation
+	}
+	return nil
+}
+
 // CaCert defines configuration for Certificate of CA.
 type BuiltinCertificateAuthorityConfig_CaCert struct {
 	state         protoimpl.MessageState
@@ -86,6 +120,9 @@ type BuiltinCertificateAuthorityConfig_CaCert struct {
 	RSAbits *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=RSAbits,proto3" json:"RSAbits,omitempty"`
 	// Expiration time of the certificate
 	Expiration string `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// Type of the private key of the certificate: RSA or ECDSA (P-256).
+	// Default: RSA
+	KeyType string `protobuf:"bytes,3,opt,name=keyType,proto3" json:"keyType,omitempty"`
 }
 
 func (x *BuiltinCertificateAuthorityConfig_CaCert) Reset() {
@@ -134,6 +171,175 @@ func (x *BuiltinCertificateAuthorityConfig_CaCert) GetExpiration() string {
 	return ""
 }
 
+func (x *BuiltinCertificateAuthorityConfig_CaCert) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+// IntermediateCert defines configuration for Certificate of intermediate
+// CA.
+type BuiltinCertificateAuthorityConfig_IntermediateCert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Expiration time of the certificate. Default: 1y
+	Expiration string `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *BuiltinCertificateAuthorityConfig_IntermediateCert) Reset() {
+	*x = BuiltinCertificateAuthorityConfig_IntermediateCert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_IntermediateCert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinCertificateAuthorityConfig_IntermediateCert) ProtoMessage() {}
+
+func (x *BuiltinCertificateAuthorityConfig_IntermediateCert) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinCertificateAuthorityConfig_IntermediateCert.ProtoReflect.Descriptor instead.
+func (*BuiltinCertificateAuthorityConfig_IntermediateCert) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_IntermediateCert) GetExpiration() string {
+	if x != nil {
+		return x.Expiration
+	}
+	return ""
+}
+
+// DpCert defines configuration for Dataplane certificates.
+type BuiltinCertificateAuthorityConfig_DpCert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the private key of the certificate: RSA or ECDSA (P-256).
+	// Default: RSA
+	KeyType string `protobuf:"bytes,1,opt,name=keyType,proto3" json:"keyType,omitempty"`
+}
+
+func (x *BuiltinCertificateAuthorityConfig_DpCert) Reset() {
+	*x = BuiltinCertificateAuthorityConfig_DpCert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_DpCert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinCertificateAuthorityConfig_DpCert) ProtoMessage() {}
+
+func (x *BuiltinCertificateAuthorityConfig_DpCert) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinCertificateAuthorityConfig_DpCert.ProtoReflect.Descriptor instead.
+func (*BuiltinCertificateAuthorityConfig_DpCert) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_DpCert) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+// AutoRotation defines automatic rotation of the CA before it expires.
+type BuiltinCertificateAuthorityConfig_AutoRotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If true, a successor of the CA is generated and enabled before the root
+	// or the intermediate certificate of the CA expires. The previous CA is
+	// removed together with its Secrets once no online Dataplane has
+	// a certificate issued by it.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// How long before the expiration the successor is generated.
+	// Default: 30d
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+}
+
+func (x *BuiltinCertificateAuthorityConfig_AutoRotation) Reset() {
+	*x = BuiltinCertificateAuthorityConfig_AutoRotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_AutoRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinCertificateAuthorityConfig_AutoRotation) ProtoMessage() {}
+
+func (x *BuiltinCertificateAuthorityConfig_AutoRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinCertificateAuthorityConfig_AutoRotation.ProtoReflect.Descriptor instead.
+func (*BuiltinCertificateAuthorityConfig_AutoRotation) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *BuiltinCertificateAuthorityConfig_AutoRotation) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BuiltinCertificateAuthorityConfig_AutoRotation) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
 var File_pkg_plugins_ca_builtin_config_builtin_ca_config_proto protoreflect.FileDescriptor
 
 var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDesc = []byte{
@@ -143,7 +349,7 @@ var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDesc = []byte{
 	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c,
 	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x01, 0x0a, 0x21, 0x42, 0x75, 0x69,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x05, 0x0a, 0x21, 0x42, 0x75, 0x69,
 	0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41,
 	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x51,
 	0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
@@ -151,16 +357,46 @@ var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDesc = []byte{
 	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
 	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x2e, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72,
-	0x74, 0x1a, 0x60, 0x0a, 0x06, 0x43, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x52,
-	0x53, 0x41, 0x62, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x52, 0x53, 0x41, 0x62,
-	0x69, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x70, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x12, 0x6f, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
+	0x65, 0x43, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x42, 0x75,
+	0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74,
+	0x52, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65,
+	0x72, 0x74, 0x12, 0x51, 0x0a, 0x06, 0x64, 0x70, 0x43, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2e, 0x63, 0x61, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x70, 0x43, 0x65, 0x72, 0x74, 0x52, 0x06, 0x64,
+	0x70, 0x43, 0x65, 0x72, 0x74, 0x12, 0x63, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x42, 0x75,
+	0x69, 0x6c, 0x74, 0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x75,
+	0x74, 0x6f, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x7a, 0x0a, 0x06, 0x43, 0x61,
+	0x43, 0x65, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x52, 0x53, 0x41, 0x62, 0x69, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x52, 0x53, 0x41, 0x62, 0x69, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b,
+	0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x32, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x22, 0x0a, 0x06, 0x44, 0x70,
+	0x43, 0x65, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x40,
+	0x0a, 0x0c, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2f, 0x63, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -175,20 +411,26 @@ func file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescGZIP() []
 	return file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDescData
 }
 
-var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_goTypes = []interface{}{
-	(*BuiltinCertificateAuthorityConfig)(nil),        // 0: kuma.plugins.ca.BuiltinCertificateAuthorityConfig
-	(*BuiltinCertificateAuthorityConfig_CaCert)(nil), // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert
-	(*wrapperspb.UInt32Value)(nil),                   // 2: google.protobuf.UInt32Value
+	(*BuiltinCertificateAuthorityConfig)(nil),                  // 0: kuma.plugins.ca.BuiltinCertificateAuthorityConfig
+	(*BuiltinCertificateAuthorityConfig_CaCert)(nil),           // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert
+	(*BuiltinCertificateAuthorityConfig_IntermediateCert)(nil), // 2: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.IntermediateCert
+	(*BuiltinCertificateAuthorityConfig_DpCert)(nil),           // 3: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.DpCert
+	(*BuiltinCertificateAuthorityConfig_AutoRotation)(nil),     // 4: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.AutoRotation
+	(*wrapperspb.UInt32Value)(nil),                             // 5: google.protobuf.UInt32Value
 }
 var file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_depIdxs = []int32{
 	1, // 0: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.caCert:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert
-	2, // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert.RSAbits:type_name -> google.protobuf.UInt32Value
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 1: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.intermediateCert:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.IntermediateCert
+	3, // 2: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.dpCert:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.DpCert
+	4, // 3: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.autoRotation:type_name -> kuma.plugins.ca.BuiltinCertificateAuthorityConfig.AutoRotation
+	5, // 4: kuma.plugins.ca.BuiltinCertificateAuthorityConfig.CaCert.RSAbits:type_name -> google.protobuf.UInt32Value
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_init() }
@@ -221,6 +463,42 @@ func file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinCertificateAuthorityConfig_IntermediateCert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinCertificateAuthorityConfig_DpCert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinCertificateAuthorityConfig_AutoRotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -228,7 +506,7 @@ func file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_plugins_ca_builtin_config_builtin_ca_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
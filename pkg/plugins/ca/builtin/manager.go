@@ -3,6 +3,9 @@ package builtin
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"sync"
+	"time"
 
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 
@@ -19,11 +22,14 @@ import (
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	core_validators "github.com/kumahq/kuma/pkg/core/validators"
 	"github.com/kumahq/kuma/pkg/plugins/ca/builtin/config"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 type builtinCaManager struct {
 	secretManager manager.ResourceManager
+	// crlMux prevents generating the same CRL concurrently for many Dataplanes
+	crlMux sync.Mutex
 }
 
 func NewBuiltinCaManager(secretManager manager.ResourceManager) core_ca.Manager {
@@ -33,9 +39,12 @@ func NewBuiltinCaManager(secretManager manager.ResourceManager) core_ca.Manager
 }
 
 var _ core_ca.Manager = &builtinCaManager{}
+var _ core_ca.IntermediateCertGetter = &builtinCaManager{}
+var _ core_ca.AutoRotator = &builtinCaManager{}
+var _ core_ca.Revoker = &builtinCaManager{}
 
 func (b *builtinCaManager) Ensure(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
-	_, err := b.getCa(ctx, mesh, backend.Name)
+	_, err := b.getRootCert(ctx, mesh, backend.Name)
 	if core_store.IsResourceNotFound(err) {
 		if err := b.create(ctx, mesh, backend); err != nil {
 			return errors.Wrapf(err, "failed to create CA for mesh %q and backend %q", mesh, backend.Name)
@@ -53,13 +62,79 @@ func (b *builtinCaManager) ValidateBackend(ctx context.Context, mesh string, bac
 		verr.AddViolation("", "could not convert backend config: "+err.Error())
 		return verr.OrNil()
 	}
-	return nil
+	verr.AddErrorAt(core_validators.RootedAt("caCert").Field("keyType"), validateKeyType(cfg.GetCaCert().GetKeyType()))
+	if cfg.GetCaCert().GetRSAbits() != nil && util_tls.KeyType(cfg.GetCaCert().GetKeyType()) == util_tls.ECDSAKeyType {
+		verr.AddViolationAt(core_validators.RootedAt("caCert").Field("RSAbits"), "cannot be set for ECDSA key")
+	}
+	caExpiration := DefaultCACertValidityPeriod
+	if cfg.GetCaCert().GetExpiration() != "" {
+		duration, err := mesh_helper.ParseDuration(cfg.GetCaCert().GetExpiration())
+		if err != nil {
+			verr.AddViolationAt(core_validators.RootedAt("caCert").Field("expiration"), "has to be a valid format")
+		} else {
+			caExpiration = duration
+		}
+	}
+	signingExpiration := caExpiration
+	if cfg.GetIntermediateCert() != nil {
+		signingExpiration = DefaultIntermediateCertValidityPeriod
+	}
+	if cfg.GetIntermediateCert().GetExpiration() != "" {
+		duration, err := mesh_helper.ParseDuration(cfg.GetIntermediateCert().GetExpiration())
+		if err != nil {
+			verr.AddViolationAt(core_validators.RootedAt("intermediateCert").Field("expiration"), "has to be a valid format")
+		} else if duration > caExpiration {
+			verr.AddViolationAt(core_validators.RootedAt("intermediateCert").Field("expiration"), "cannot be longer than expiration of CA certificate")
+		} else {
+			signingExpiration = duration
+		}
+	}
+	// the private key of the root CA is not stored, so the intermediate CA cannot be reissued once it expires
+	if cfg.GetIntermediateCert() != nil && cfg.GetIntermediateCert().GetExpiration() == "" && !cfg.GetAutoRotation().GetEnabled() {
+		verr.AddViolationAt(core_validators.RootedAt("intermediateCert").Field("expiration"), "has to be defined explicitly when automatic rotation is disabled")
+	}
+	verr.AddErrorAt(core_validators.RootedAt("dpCert").Field("keyType"), validateKeyType(cfg.GetDpCert().GetKeyType()))
+	rotationBefore := DefaultAutoRotationBefore
+	if cfg.GetAutoRotation().GetBefore() != "" {
+		duration, err := mesh_helper.ParseDuration(cfg.GetAutoRotation().GetBefore())
+		if err != nil {
+			verr.AddViolationAt(core_validators.RootedAt("autoRotation").Field("before"), "has to be a valid format")
+		}
+		rotationBefore = duration
+	}
+	// otherwise every successor would be rotated right after it is created
+	if cfg.GetAutoRotation().GetEnabled() && rotationBefore >= signingExpiration {
+		verr.AddViolationAt(core_validators.RootedAt("autoRotation").Field("before"), "has to be shorter than expiration of CA certificate")
+	}
+	return verr.OrNil()
+}
+
+func validateKeyType(keyType string) core_validators.ValidationError {
+	var verr core_validators.ValidationError
+	if !util_tls.IsValidKeyType(util_tls.KeyType(keyType)) {
+		verr.AddViolation("", fmt.Sprintf("has to be either %q or %q", util_tls.RSAKeyType, util_tls.ECDSAKeyType))
+	}
+	return verr
 }
 
 func (b *builtinCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	if cfg.GetIntermediateCert() != nil {
+		return []string{
+			certSecretResKey(mesh, backend.Name).Name,
+			intermediateCertSecretResKey(mesh, backend.Name).Name,
+			intermediateKeySecretResKey(mesh, backend.Name).Name,
+			rootCrlSecretResKey(mesh, backend.Name).Name,
+			crlSecretResKey(mesh, backend.Name).Name,
+		}, nil
+	}
 	return []string{
 		certSecretResKey(mesh, backend.Name).Name,
 		keySecretResKey(mesh, backend.Name).Name,
+		crlSecretResKey(mesh, backend.Name).Name,
 	}, nil
 }
 
@@ -77,33 +152,60 @@ func (b *builtinCaManager) create(ctx context.Context, mesh string, backend *mes
 		}
 		opts = append(opts, withExpirationTime(duration))
 	}
-	keyPair, err := newRootCa(mesh, int(cfg.GetCaCert().GetRSAbits().GetValue()), opts...)
+	keyType := util_tls.KeyType(cfg.GetCaCert().GetKeyType())
+	rsaBits := int(cfg.GetCaCert().GetRSAbits().GetValue())
+	keyPair, err := newRootCa(mesh, keyType, rsaBits, opts...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to generate a Root CA cert for Mesh %q", mesh)
 	}
 
-	certSecret := &core_system.SecretResource{
-		Spec: &system_proto.Secret{
-			Data: &wrappers.BytesValue{
-				Value: keyPair.CertPEM,
-			},
-		},
+	// certificate of the root CA is created as the last one, because its presence means that the CA is created
+	if cfg.GetIntermediateCert() == nil {
+		if err := b.createSecret(ctx, keyPair.KeyPEM, keySecretResKey(mesh, backend.Name)); err != nil {
+			return err
+		}
+		return b.createSecret(ctx, keyPair.CertPEM, certSecretResKey(mesh, backend.Name))
+	}
+
+	var intermediateOpts []certOptsFn
+	if cfg.GetIntermediateCert().GetExpiration() != "" {
+		duration, err := mesh_helper.ParseDuration(cfg.GetIntermediateCert().GetExpiration())
+		if err != nil {
+			return err
+		}
+		intermediateOpts = append(intermediateOpts, withExpirationTime(duration))
+	}
+	intermediate, err := newIntermediateCa(*keyPair, mesh, keyType, rsaBits, intermediateOpts...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate an intermediate CA cert for Mesh %q", mesh)
+	}
+	// the private key of the root CA is deliberately not stored, only the intermediate CA signs Dataplane certificates.
+	// The root CA never revokes the intermediate CA, therefore its empty CRL is generated once, while the key is still available.
+	rootCrl, err := newCrl(*keyPair, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate a CRL of the Root CA for Mesh %q", mesh)
+	}
+	if err := b.createSecret(ctx, rootCrl, rootCrlSecretResKey(mesh, backend.Name)); err != nil {
+		return err
 	}
-	if err := b.secretManager.Create(ctx, certSecret, core_store.CreateBy(certSecretResKey(mesh, backend.Name))); err != nil {
+	if err := b.createSecret(ctx, intermediate.KeyPEM, intermediateKeySecretResKey(mesh, backend.Name)); err != nil {
 		return err
 	}
+	if err := b.createSecret(ctx, intermediate.CertPEM, intermediateCertSecretResKey(mesh, backend.Name)); err != nil {
+		return err
+	}
+	return b.createSecret(ctx, keyPair.CertPEM, certSecretResKey(mesh, backend.Name))
+}
 
-	keySecret := &core_system.SecretResource{
+func (b *builtinCaManager) createSecret(ctx context.Context, data []byte, key core_model.ResourceKey) error {
+	secret := &core_system.SecretResource{
 		Spec: &system_proto.Secret{
 			Data: &wrappers.BytesValue{
-				Value: keyPair.KeyPEM,
+				Value: data,
 			},
 		},
 	}
-	if err := b.secretManager.Create(ctx, keySecret, core_store.CreateBy(keySecretResKey(mesh, backend.Name))); err != nil {
-		return err
-	}
-	return nil
+	return b.secretManager.Create(ctx, secret, core_store.CreateBy(key))
 }
 
 func certSecretResKey(mesh string, backendName string) core_model.ResourceKey {
@@ -120,16 +222,141 @@ func keySecretResKey(mesh string, backendName string) core_model.ResourceKey {
 	}
 }
 
+func intermediateCertSecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("%s.ca-builtin-intermediate-cert-%s", mesh, backendName), // we add mesh as a prefix to have uniqueness of Secret names on K8S
+	}
+}
+
+func intermediateKeySecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("%s.ca-builtin-intermediate-key-%s", mesh, backendName), // we add mesh as a prefix to have uniqueness of Secret names on K8S
+	}
+}
+
+func rootCrlSecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("%s.ca-builtin-root-crl-%s", mesh, backendName), // we add mesh as a prefix to have uniqueness of Secret names on K8S
+	}
+}
+
+func crlSecretResKey(mesh string, backendName string) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("%s.ca-builtin-crl-%s", mesh, backendName), // we add mesh as a prefix to have uniqueness of Secret names on K8S
+	}
+}
+
 func (b *builtinCaManager) GetRootCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]core_ca.Cert, error) {
-	ca, err := b.getCa(ctx, mesh, backend.Name)
+	cert, err := b.getRootCert(ctx, mesh, backend.Name)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
 	}
-	return []core_ca.Cert{ca.CertPEM}, nil
+	return []core_ca.Cert{cert}, nil
+}
+
+func (b *builtinCaManager) GetIntermediateCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) (core_ca.Cert, error) {
+	certSecret := core_system.NewSecretResource()
+	err := b.secretManager.Get(ctx, certSecret, core_store.GetBy(intermediateCertSecretResKey(mesh, backend.Name)))
+	if core_store.IsResourceNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load intermediate CA cert for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return certSecret.Spec.Data.Value, nil
+}
+
+func (b *builtinCaManager) AutoRotationBefore(backend *mesh_proto.CertificateAuthorityBackend) (time.Duration, error) {
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return 0, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	if !cfg.GetAutoRotation().GetEnabled() {
+		return 0, nil
+	}
+	if cfg.GetAutoRotation().GetBefore() == "" {
+		return DefaultAutoRotationBefore, nil
+	}
+	return mesh_helper.ParseDuration(cfg.GetAutoRotation().GetBefore())
+}
+
+// GenerateCrls returns the CRL of the CA that signs Dataplane certificates and the CRL of the root CA if the CA has an intermediate CA.
+// The CRL is stored in a Secret and generated again only when revoked serial numbers change, so it is not signed for every Dataplane.
+func (b *builtinCaManager) GenerateCrls(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, serials []*big.Int) ([]core_ca.Crl, error) {
+	crl, err := b.getOrCreateCrl(ctx, mesh, backend.Name, serials)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate a CRL for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	intermediateCert, err := b.GetIntermediateCert(ctx, mesh, backend)
+	if err != nil {
+		return nil, err
+	}
+	if intermediateCert == nil {
+		return []core_ca.Crl{crl}, nil
+	}
+
+	rootCrlSecret := core_system.NewSecretResource()
+	if err := b.secretManager.Get(ctx, rootCrlSecret, core_store.GetBy(rootCrlSecretResKey(mesh, backend.Name))); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return nil, errors.Errorf("CA of Mesh %q and backend %q was created without a CRL of the root CA, rotate the CA to revoke certificates", mesh, backend.Name)
+		}
+		return nil, errors.Wrapf(err, "failed to load a CRL of the root CA for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return []core_ca.Crl{crl, rootCrlSecret.Spec.Data.Value}, nil
+}
+
+func (b *builtinCaManager) getOrCreateCrl(ctx context.Context, mesh string, backendName string, serials []*big.Int) (core_ca.Crl, error) {
+	b.crlMux.Lock()
+	defer b.crlMux.Unlock()
+
+	key := crlSecretResKey(mesh, backendName)
+	crlSecret := core_system.NewSecretResource()
+	err := b.secretManager.Get(ctx, crlSecret, core_store.GetBy(key))
+	switch {
+	case err == nil:
+		if revokesExactly(crlSecret.Spec.GetData().GetValue(), serials) {
+			return crlSecret.Spec.Data.Value, nil
+		}
+	case !core_store.IsResourceNotFound(err):
+		return nil, errors.Wrap(err, "failed to load the CRL")
+	}
+
+	ca, _, err := b.getSigningCa(ctx, mesh, backendName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load CA key pair")
+	}
+	crl, err := newCrl(ca, serials)
+	if err != nil {
+		return nil, err
+	}
+	if crlSecret.GetMeta() == nil {
+		err = b.createSecret(ctx, crl, key)
+	} else {
+		crlSecret.Spec.Data = &wrappers.BytesValue{Value: crl}
+		err = b.secretManager.Update(ctx, crlSecret)
+	}
+	if err != nil {
+		// other instance of the Control Plane could have stored the CRL in the meantime
+		stored := core_system.NewSecretResource()
+		if getErr := b.secretManager.Get(ctx, stored, core_store.GetBy(key)); getErr == nil && revokesExactly(stored.Spec.GetData().GetValue(), serials) {
+			return stored.Spec.Data.Value, nil
+		}
+		return nil, errors.Wrap(err, "failed to store the CRL")
+	}
+	return crl, nil
 }
 
 func (b *builtinCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (core_ca.KeyPair, error) {
-	ca, err := b.getCa(ctx, mesh, backend.Name)
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+
+	ca, chain, err := b.getSigningCa(ctx, mesh, backend.Name)
 	if err != nil {
 		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
 	}
@@ -142,13 +369,47 @@ func (b *builtinCaManager) GenerateDataplaneCert(ctx context.Context, mesh strin
 		}
 		opts = append(opts, ca_issuer.WithExpirationTime(duration))
 	}
-	keyPair, err := ca_issuer.NewWorkloadCert(ca, mesh, tags, opts...)
+	keyPair, err := ca_issuer.NewWorkloadCertWithKeyType(ca, mesh, tags, util_tls.KeyType(cfg.GetDpCert().GetKeyType()), opts...)
 	if err != nil {
 		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to generate a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend)
 	}
+	// intermediate CA certificate has to be presented together with the Dataplane certificate to build a chain to the root CA
+	keyPair.CertPEM = append(keyPair.CertPEM, chain...)
 	return *keyPair, nil
 }
 
+func (b *builtinCaManager) getRootCert(ctx context.Context, mesh string, backendName string) (core_ca.Cert, error) {
+	certSecret := core_system.NewSecretResource()
+	if err := b.secretManager.Get(ctx, certSecret, core_store.GetBy(certSecretResKey(mesh, backendName))); err != nil {
+		return nil, err
+	}
+	return certSecret.Spec.Data.Value, nil
+}
+
+// getSigningCa returns the CA key pair that signs Dataplane certificates and a chain of intermediate CA certificates.
+// The intermediate CA is used if it was created together with the CA, otherwise the root CA signs certificates directly.
+func (b *builtinCaManager) getSigningCa(ctx context.Context, mesh string, backendName string) (core_ca.KeyPair, []byte, error) {
+	certSecret := core_system.NewSecretResource()
+	err := b.secretManager.Get(ctx, certSecret, core_store.GetBy(intermediateCertSecretResKey(mesh, backendName)))
+	if core_store.IsResourceNotFound(err) {
+		ca, err := b.getCa(ctx, mesh, backendName)
+		return ca, nil, err
+	}
+	if err != nil {
+		return core_ca.KeyPair{}, nil, err
+	}
+
+	keySecret := core_system.NewSecretResource()
+	if err := b.secretManager.Get(ctx, keySecret, core_store.GetBy(intermediateKeySecretResKey(mesh, backendName))); err != nil {
+		return core_ca.KeyPair{}, nil, err
+	}
+
+	return core_ca.KeyPair{
+		CertPEM: certSecret.Spec.Data.Value,
+		KeyPEM:  keySecret.Spec.Data.Value,
+	}, certSecret.Spec.Data.Value, nil
+}
+
 func (b *builtinCaManager) getCa(ctx context.Context, mesh string, backendName string) (core_ca.KeyPair, error) {
 	certSecret := core_system.NewSecretResource()
 	if err := b.secretManager.Get(ctx, certSecret, core_store.GetBy(certSecretResKey(mesh, backendName))); err != nil {
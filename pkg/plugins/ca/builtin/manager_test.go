@@ -3,7 +3,9 @@ package builtin_test
 import (
 	"context"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
+	"math/big"
 	"time"
 
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -116,6 +118,302 @@ var _ = Describe("Builtin CA Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(cert.NotAfter).To(Equal(core.Now().UTC().Add(time.Minute).Truncate(time.Second)))
 		})
+
+		It("should create a CA with an intermediate CA", func() {
+			//given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					IntermediateCert: &config.BuiltinCertificateAuthorityConfig_IntermediateCert{
+						Expiration: "30d",
+					},
+				}),
+			}
+
+			// when
+			err := caManager.Ensure(context.Background(), mesh, backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and the private key of the root CA is not stored
+			err = secretManager.Get(context.Background(), system.NewSecretResource(), core_store.GetByKey("default.ca-builtin-key-builtin-1", "default"))
+			Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+
+			// and intermediate CA is signed by the root CA
+			rootRes := system.NewSecretResource()
+			err = secretManager.Get(context.Background(), rootRes, core_store.GetByKey("default.ca-builtin-cert-builtin-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			intermediateRes := system.NewSecretResource()
+			err = secretManager.Get(context.Background(), intermediateRes, core_store.GetByKey("default.ca-builtin-intermediate-cert-builtin-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			err = secretManager.Get(context.Background(), system.NewSecretResource(), core_store.GetByKey("default.ca-builtin-intermediate-key-builtin-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			block, _ := pem.Decode(rootRes.Spec.Data.Value)
+			rootCert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			block, _ = pem.Decode(intermediateRes.Spec.Data.Value)
+			intermediateCert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(intermediateCert.CheckSignatureFrom(rootCert)).To(Succeed())
+			Expect(intermediateCert.IsCA).To(BeTrue())
+			Expect(intermediateCert.MaxPathLenZero).To(BeTrue())
+			Expect(intermediateCert.NotAfter).To(Equal(core.Now().UTC().Add(30 * 24 * time.Hour).Truncate(time.Second)))
+		})
+
+		It("should create a CA with ECDSA key", func() {
+			//given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					CaCert: &config.BuiltinCertificateAuthorityConfig_CaCert{
+						KeyType: "ECDSA",
+					},
+				}),
+			}
+
+			// when
+			err := caManager.Ensure(context.Background(), mesh, backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and CA has ECDSA key
+			secretRes := system.NewSecretResource()
+			err = secretManager.Get(context.Background(), secretRes, core_store.GetByKey("default.ca-builtin-cert-builtin-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(secretRes.Spec.Data.Value)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+		})
+	})
+
+	Context("ValidateBackend", func() {
+		It("should accept valid config", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					CaCert: &config.BuiltinCertificateAuthorityConfig_CaCert{
+						KeyType:    "ECDSA",
+						Expiration: "1y",
+					},
+					IntermediateCert: &config.BuiltinCertificateAuthorityConfig_IntermediateCert{
+						Expiration: "30d",
+					},
+					DpCert: &config.BuiltinCertificateAuthorityConfig_DpCert{
+						KeyType: "RSA",
+					},
+					AutoRotation: &config.BuiltinCertificateAuthorityConfig_AutoRotation{
+						Enabled: true,
+						Before:  "7d",
+					},
+				}),
+			}
+
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject invalid config", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					CaCert: &config.BuiltinCertificateAuthorityConfig_CaCert{
+						KeyType: "ECDSA",
+						RSAbits: &wrappers.UInt32Value{
+							Value: uint32(2048),
+						},
+						Expiration: "30d",
+					},
+					IntermediateCert: &config.BuiltinCertificateAuthorityConfig_IntermediateCert{
+						Expiration: "1y",
+					},
+					DpCert: &config.BuiltinCertificateAuthorityConfig_DpCert{
+						KeyType: "DSA",
+					},
+					AutoRotation: &config.BuiltinCertificateAuthorityConfig_AutoRotation{
+						Enabled: true,
+						Before:  "1y",
+					},
+				}),
+			}
+
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal(`caCert.RSAbits: cannot be set for ECDSA key; intermediateCert.expiration: cannot be longer than expiration of CA certificate; dpCert.keyType: has to be either "RSA" or "ECDSA"; autoRotation.before: has to be shorter than expiration of CA certificate`))
+		})
+
+		It("should reject intermediate CA with the default expiration when automatic rotation is disabled", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					IntermediateCert: &config.BuiltinCertificateAuthorityConfig_IntermediateCert{},
+				}),
+			}
+
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			Expect(err).To(MatchError("intermediateCert.expiration: has to be defined explicitly when automatic rotation is disabled"))
+		})
+	})
+
+	Context("UsedSecrets", func() {
+		It("should return secrets of an intermediate CA", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					IntermediateCert: &config.BuiltinCertificateAuthorityConfig_IntermediateCert{},
+				}),
+			}
+
+			// when
+			secrets, err := caManager.UsedSecrets("default", backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets).To(Equal([]string{
+				"default.ca-builtin-cert-builtin-1",
+				"default.ca-builtin-intermediate-cert-builtin-1",
+				"default.ca-builtin-intermediate-key-builtin-1",
+				"default.ca-builtin-root-crl-builtin-1",
+				"default.ca-builtin-crl-builtin-1",
+			}))
+		})
+	})
+
+	Context("GenerateCrls", func() {
+		parseCert := func(data []byte) *x509.Certificate {
+			block, _ := pem.Decode(data)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			return cert
+		}
+
+		parseCrl := func(data []byte) *pkix.CertificateList {
+			block, _ := pem.Decode(data)
+			Expect(block.Type).To(Equal("X509 CRL"))
+			crl, err := x509.ParseCRL(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			return crl
+		}
+
+		It("should generate a CRL signed by the root CA", func() {
+			// given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+			err := caManager.Ensure(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			crls, err := caManager.(core_ca.Revoker).GenerateCrls(context.Background(), mesh, backend, []*big.Int{big.NewInt(42), big.NewInt(43)})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crls).To(HaveLen(1))
+			crl := parseCrl(crls[0])
+
+			// and
+			roots, err := caManager.GetRootCert(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+			rootCert := parseCert(roots[0])
+			Expect(rootCert.CheckCRLSignature(crl)).To(Succeed())
+			Expect(crl.TBSCertList.NextUpdate).To(Equal(rootCert.NotAfter))
+			Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(2))
+			Expect(crl.TBSCertList.RevokedCertificates[0].SerialNumber).To(Equal(big.NewInt(42)))
+			Expect(crl.TBSCertList.RevokedCertificates[1].SerialNumber).To(Equal(big.NewInt(43)))
+		})
+
+		It("should generate CRLs of an intermediate CA and the root CA", func() {
+			// given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					IntermediateCert: &config.BuiltinCertificateAuthorityConfig_IntermediateCert{},
+				}),
+			}
+			err := caManager.Ensure(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			crls, err := caManager.(core_ca.Revoker).GenerateCrls(context.Background(), mesh, backend, []*big.Int{big.NewInt(42)})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crls).To(HaveLen(2))
+
+			// and CRL of the intermediate CA revokes the certificate
+			intermediateRes := system.NewSecretResource()
+			err = secretManager.Get(context.Background(), intermediateRes, core_store.GetByKey("default.ca-builtin-intermediate-cert-builtin-1", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			crl := parseCrl(crls[0])
+			Expect(parseCert(intermediateRes.Spec.Data.Value).CheckCRLSignature(crl)).To(Succeed())
+			Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+			Expect(crl.TBSCertList.RevokedCertificates[0].SerialNumber).To(Equal(big.NewInt(42)))
+
+			// and CRL of the root CA is empty
+			roots, err := caManager.GetRootCert(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+			rootCrl := parseCrl(crls[1])
+			Expect(parseCert(roots[0]).CheckCRLSignature(rootCrl)).To(Succeed())
+			Expect(rootCrl.TBSCertList.RevokedCertificates).To(BeEmpty())
+		})
+
+		It("should reuse the CRL until revoked serial numbers change", func() {
+			// given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+			err := caManager.Ensure(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+			revoker := caManager.(core_ca.Revoker)
+			first, err := revoker.GenerateCrls(context.Background(), mesh, backend, []*big.Int{big.NewInt(42)})
+			Expect(err).ToNot(HaveOccurred())
+
+			// when the same serial numbers are revoked
+			now = now.Add(time.Second)
+			second, err := revoker.GenerateCrls(context.Background(), mesh, backend, []*big.Int{big.NewInt(42)})
+
+			// then the stored CRL is returned
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second).To(Equal(first))
+
+			// when another serial number is revoked
+			third, err := revoker.GenerateCrls(context.Background(), mesh, backend, []*big.Int{big.NewInt(42), big.NewInt(43)})
+
+			// then a new CRL is generated
+			Expect(err).ToNot(HaveOccurred())
+			Expect(third).ToNot(Equal(first))
+			Expect(parseCrl(third[0]).TBSCertList.RevokedCertificates).To(HaveLen(2))
+		})
 	})
 
 	Context("GetRootCert", func() {
@@ -200,6 +498,61 @@ var _ = Describe("Builtin CA Manager", func() {
 			Expect(cert.NotAfter).To(Equal(now.UTC().Truncate(time.Second).Add(1 * time.Second))) // time in cert is in UTC and truncated to seconds
 		})
 
+		It("should generate dataplane certs signed by an intermediate CA", func() {
+			//given
+			mesh := "default"
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					CaCert: &config.BuiltinCertificateAuthorityConfig_CaCert{
+						KeyType: "ECDSA",
+					},
+					IntermediateCert: &config.BuiltinCertificateAuthorityConfig_IntermediateCert{},
+					DpCert: &config.BuiltinCertificateAuthorityConfig_DpCert{
+						KeyType: "ECDSA",
+					},
+				}),
+			}
+			err := caManager.Ensure(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+			rootCerts, err := caManager.GetRootCert(context.Background(), mesh, backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			tags := map[string]map[string]bool{
+				"kuma.io/service": {
+					"web": true,
+				},
+			}
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), mesh, backend, tags)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and cert is followed by the intermediate CA cert
+			leafBlock, rest := pem.Decode(pair.CertPEM)
+			intermediateBlock, _ := pem.Decode(rest)
+			Expect(intermediateBlock).ToNot(BeNil())
+			leaf, err := x509.ParseCertificate(leafBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(leaf.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+			intermediate, err := x509.ParseCertificate(intermediateBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+
+			// and chain can be verified with the root CA
+			roots := x509.NewCertPool()
+			Expect(roots.AppendCertsFromPEM(rootCerts[0])).To(BeTrue())
+			intermediates := x509.NewCertPool()
+			intermediates.AddCert(intermediate)
+			_, err = leaf.Verify(x509.VerifyOptions{
+				Roots:         roots,
+				Intermediates: intermediates,
+				KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should throw an error on generate dataplane certs on non-existing CA", func() {
 			// given
 			mesh := "default"
@@ -0,0 +1,190 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	"github.com/kumahq/kuma/pkg/plugins/ca/remote/config"
+)
+
+const (
+	DefaultTimeout = 10 * time.Second
+
+	signPath  = "/sign"
+	rootsPath = "/roots"
+
+	// maxErrorBodySize limits the size of the response body included in the error
+	maxErrorBodySize = 1024
+)
+
+// signRequest is a body of the request to the signing endpoint
+type signRequest struct {
+	// Csr is a PEM encoded certificate signing request
+	Csr string `json:"csr"`
+	// NotAfter is a requested expiration time of the certificate in RFC3339 format
+	NotAfter string `json:"notAfter,omitempty"`
+}
+
+// signResponse is a body of the response of the signing endpoint
+type signResponse struct {
+	// Crt is a PEM encoded signed certificate
+	Crt string `json:"crt"`
+	// CertChain is a list of PEM encoded certificates starting with the signed certificate followed by intermediate CAs
+	CertChain []string `json:"certChain,omitempty"`
+}
+
+// rootsResponse is a body of the response of the roots endpoint
+type rootsResponse struct {
+	// Crts is a list of PEM encoded root certificates
+	Crts []string `json:"crts"`
+}
+
+type signingClient struct {
+	url        string
+	httpClient *http.Client
+	settings   signingClientSettings
+}
+
+// signingClientSettings are settings of the signing client with data sources already loaded,
+// so that a change of a Secret referenced by the backend can be detected
+type signingClientSettings struct {
+	url        string
+	timeout    time.Duration
+	caCert     []byte
+	clientCert []byte
+	clientKey  []byte
+}
+
+func (s signingClientSettings) equal(other signingClientSettings) bool {
+	return s.url == other.url &&
+		s.timeout == other.timeout &&
+		bytes.Equal(s.caCert, other.caCert) &&
+		bytes.Equal(s.clientCert, other.clientCert) &&
+		bytes.Equal(s.clientKey, other.clientKey)
+}
+
+func loadSigningClientSettings(ctx context.Context, loader datasource.Loader, mesh string, cfg *config.RemoteCertificateAuthorityConfig) (signingClientSettings, error) {
+	settings := signingClientSettings{
+		url:     strings.TrimSuffix(cfg.GetUrl(), "/"),
+		timeout: DefaultTimeout,
+	}
+	if cfg.GetTimeout() != nil {
+		settings.timeout = cfg.GetTimeout().AsDuration()
+	}
+	if cfg.GetTls().GetCaCert() != nil {
+		caCert, err := loader.Load(ctx, mesh, cfg.GetTls().GetCaCert())
+		if err != nil {
+			return signingClientSettings{}, errors.Wrap(err, "could not load CA cert of the signing endpoint")
+		}
+		settings.caCert = caCert
+	}
+	if cfg.GetTls().GetClientCert() != nil {
+		clientCert, err := loader.Load(ctx, mesh, cfg.GetTls().GetClientCert())
+		if err != nil {
+			return signingClientSettings{}, errors.Wrap(err, "could not load client cert")
+		}
+		clientKey, err := loader.Load(ctx, mesh, cfg.GetTls().GetClientKey())
+		if err != nil {
+			return signingClientSettings{}, errors.Wrap(err, "could not load client key")
+		}
+		settings.clientCert = clientCert
+		settings.clientKey = clientKey
+	}
+	return settings, nil
+}
+
+func newSigningClient(settings signingClientSettings) (*signingClient, error) {
+	tlsConfig := &tls.Config{}
+	if settings.caCert != nil {
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM(settings.caCert); !ok {
+			return nil, errors.New("could not add CA cert of the signing endpoint")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+	if settings.clientCert != nil {
+		cert, err := tls.X509KeyPair(settings.clientCert, settings.clientKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create key pair from client cert and client key")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return &signingClient{
+		url: settings.url,
+		httpClient: &http.Client{
+			Timeout: settings.timeout,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+		settings: settings,
+	}, nil
+}
+
+func (c *signingClient) close() {
+	c.httpClient.CloseIdleConnections()
+}
+
+func (c *signingClient) sign(ctx context.Context, request signRequest) (*signResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url+signPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	response := &signResponse{}
+	if err := c.do(req, response); err != nil {
+		return nil, err
+	}
+	if response.Crt == "" && len(response.CertChain) == 0 {
+		return nil, errors.New("signing endpoint returned no certificate")
+	}
+	return response, nil
+}
+
+func (c *signingClient) roots(ctx context.Context) (*rootsResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+rootsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	response := &rootsResponse{}
+	if err := c.do(req, response); err != nil {
+		return nil, err
+	}
+	if len(response.Crts) == 0 {
+		return nil, errors.New("signing endpoint returned no root certificates")
+	}
+	return response, nil
+}
+
+func (c *signingClient) do(req *http.Request, response interface{}) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not send a request to %s", req.URL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return errors.Errorf("%s returned status code %d: %s", req.URL, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return errors.Wrapf(err, "could not decode a response from %s", req.URL)
+	}
+	return nil
+}
@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.14.0
+// source: pkg/plugins/ca/remote/config/remote_ca_config.proto
+
+package config
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	v1alpha1 "github.com/kumahq/kuma/api/system/v1alpha1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// RemoteCertificateAuthorityConfig defines configuration for Remote CA
+// plugin. Dataplane certificates are issued by an external signing endpoint
+// which receives a certificate signing request over HTTP API.
+type RemoteCertificateAuthorityConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL of the signing endpoint. Certificate signing requests are sent with
+	// POST <url>/sign and root certificates are fetched with GET <url>/roots.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Data source for the root certificates of CA. If not defined, root
+	// certificates are fetched from the signing endpoint.
+	Root *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// Configuration of the connection to the signing endpoint
+	Tls *RemoteCertificateAuthorityConfig_Tls `protobuf:"bytes,3,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Timeout of a request to the signing endpoint. Default: 10s
+	Timeout *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Type of the private key of Dataplane certificates: RSA or ECDSA (P-256).
+	// Default: RSA
+	KeyType string `protobuf:"bytes,5,opt,name=keyType,proto3" json:"keyType,omitempty"`
+}
+
+func (x *RemoteCertificateAuthorityConfig) Reset() {
+	*x = RemoteCertificateAuthorityConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_remote_config_remote_ca_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteCertificateAuthorityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteCertificateAuthorityConfig) ProtoMessage() {}
+
+func (x *RemoteCertificateAuthorityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_remote_config_remote_ca_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteCertificateAuthorityConfig.ProtoReflect.Descriptor instead.
+func (*RemoteCertificateAuthorityConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RemoteCertificateAuthorityConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RemoteCertificateAuthorityConfig) GetRoot() *v1alpha1.DataSource {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *RemoteCertificateAuthorityConfig) GetTls() *RemoteCertificateAuthorityConfig_Tls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *RemoteCertificateAuthorityConfig) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *RemoteCertificateAuthorityConfig) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+// Tls defines configuration of the connection to the signing endpoint.
+type RemoteCertificateAuthorityConfig_Tls struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data source for the certificate of CA that signed the certificate of
+	// the signing endpoint. If not defined, system root certificates are used.
+	CaCert *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	// Data source for the client certificate presented to the signing
+	// endpoint
+	ClientCert *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=clientCert,proto3" json:"clientCert,omitempty"`
+	// Data source for the key of the client certificate
+	ClientKey *v1alpha1.DataSource `protobuf:"bytes,3,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+}
+
+func (x *RemoteCertificateAuthorityConfig_Tls) Reset() {
+	*x = RemoteCertificateAuthorityConfig_Tls{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_remote_config_remote_ca_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteCertificateAuthorityConfig_Tls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteCertificateAuthorityConfig_Tls) ProtoMessage() {}
+
+func (x *RemoteCertificateAuthorityConfig_Tls) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_remote_config_remote_ca_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteCertificateAuthorityConfig_Tls.ProtoReflect.Descriptor instead.
+func (*RemoteCertificateAuthorityConfig_Tls) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *RemoteCertificateAuthorityConfig_Tls) GetCaCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.CaCert
+	}
+	return nil
+}
+
+func (x *RemoteCertificateAuthorityConfig_Tls) GetClientCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.ClientCert
+	}
+	return nil
+}
+
+func (x *RemoteCertificateAuthorityConfig_Tls) GetClientKey() *v1alpha1.DataSource {
+	if x != nil {
+		return x.ClientKey
+	}
+	return nil
+}
+
+var File_pkg_plugins_ca_remote_config_remote_ca_config_proto protoreflect.FileDescriptor
+
+var file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDesc = []byte{
+	0x0a, 0x33, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61,
+	0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x03, 0x0a, 0x20, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x34, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x47, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2e, 0x63, 0x61, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x6c, 0x73, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x33,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xc1, 0x01,
+	0x0a, 0x03, 0x54, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12,
+	0x40, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x12, 0x3e, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65,
+	0x79, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDescOnce sync.Once
+	file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDescData = file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDesc
+)
+
+func file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDescGZIP() []byte {
+	file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDescOnce.Do(func() {
+		file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDescData)
+	})
+	return file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDescData
+}
+
+var file_pkg_plugins_ca_remote_config_remote_ca_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_plugins_ca_remote_config_remote_ca_config_proto_goTypes = []interface{}{
+	(*RemoteCertificateAuthorityConfig)(nil),     // 0: kuma.plugins.ca.RemoteCertificateAuthorityConfig
+	(*RemoteCertificateAuthorityConfig_Tls)(nil), // 1: kuma.plugins.ca.RemoteCertificateAuthorityConfig.Tls
+	(*v1alpha1.DataSource)(nil),                  // 2: kuma.system.v1alpha1.DataSource
+	(*durationpb.Duration)(nil),                  // 3: google.protobuf.Duration
+}
+var file_pkg_plugins_ca_remote_config_remote_ca_config_proto_depIdxs = []int32{
+	2, // 0: kuma.plugins.ca.RemoteCertificateAuthorityConfig.root:type_name -> kuma.system.v1alpha1.DataSource
+	1, // 1: kuma.plugins.ca.RemoteCertificateAuthorityConfig.tls:type_name -> kuma.plugins.ca.RemoteCertificateAuthorityConfig.Tls
+	3, // 2: kuma.plugins.ca.RemoteCertificateAuthorityConfig.timeout:type_name -> google.protobuf.Duration
+	2, // 3: kuma.plugins.ca.RemoteCertificateAuthorityConfig.Tls.caCert:type_name -> kuma.system.v1alpha1.DataSource
+	2, // 4: kuma.plugins.ca.RemoteCertificateAuthorityConfig.Tls.clientCert:type_name -> kuma.system.v1alpha1.DataSource
+	2, // 5: kuma.plugins.ca.RemoteCertificateAuthorityConfig.Tls.clientKey:type_name -> kuma.system.v1alpha1.DataSource
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_pkg_plugins_ca_remote_config_remote_ca_config_proto_init() }
+func file_pkg_plugins_ca_remote_config_remote_ca_config_proto_init() {
+	if File_pkg_plugins_ca_remote_config_remote_ca_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_plugins_ca_remote_config_remote_ca_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteCertificateAuthorityConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_remote_config_remote_ca_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteCertificateAuthorityConfig_Tls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_plugins_ca_remote_config_remote_ca_config_proto_goTypes,
+		DependencyIndexes: file_pkg_plugins_ca_remote_config_remote_ca_config_proto_depIdxs,
+		MessageInfos:      file_pkg_plugins_ca_remote_config_remote_ca_config_proto_msgTypes,
+	}.Build()
+	File_pkg_plugins_ca_remote_config_remote_ca_config_proto = out.File
+	file_pkg_plugins_ca_remote_config_remote_ca_config_proto_rawDesc = nil
+	file_pkg_plugins_ca_remote_config_remote_ca_config_proto_goTypes = nil
+	file_pkg_plugins_ca_remote_config_remote_ca_config_proto_depIdxs = nil
+}
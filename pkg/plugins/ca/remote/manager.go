@@ -0,0 +1,270 @@
+package remote
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/ca"
+	ca_issuer "github.com/kumahq/kuma/pkg/core/ca/issuer"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	mesh_helper "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/plugins/ca/remote/config"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type remoteCaManager struct {
+	dataSourceLoader datasource.Loader
+
+	// clients are signing clients by the Mesh and the name of the backend.
+	// A client is reused as long as the settings of the backend do not change, so that connections to the signing endpoint are reused.
+	sync.Mutex
+	clients map[signingClientKey]*signingClient
+}
+
+type signingClientKey struct {
+	mesh    string
+	backend string
+}
+
+var _ ca.Manager = &remoteCaManager{}
+
+func NewRemoteCaManager(dataSourceLoader datasource.Loader) ca.Manager {
+	return &remoteCaManager{
+		dataSourceLoader: dataSourceLoader,
+		clients:          map[signingClientKey]*signingClient{},
+	}
+}
+
+func (r *remoteCaManager) ValidateBackend(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
+	verr := validators.ValidationError{}
+
+	cfg := &config.RemoteCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		verr.AddViolation("", "could not convert backend config: "+err.Error())
+		return verr.OrNil()
+	}
+
+	if cfg.GetUrl() == "" {
+		verr.AddViolation("url", "has to be defined")
+	} else if u, err := url.Parse(cfg.GetUrl()); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		verr.AddViolation("url", "has to be a valid http or https URL")
+	}
+	if cfg.GetRoot() != nil {
+		verr.AddError("root", datasource.Validate(cfg.GetRoot()))
+	}
+
+	tlsPath := validators.RootedAt("tls")
+	if cfg.GetTls().GetCaCert() != nil {
+		verr.AddErrorAt(tlsPath.Field("caCert"), datasource.Validate(cfg.GetTls().GetCaCert()))
+	}
+	switch {
+	case cfg.GetTls().GetClientCert() != nil && cfg.GetTls().GetClientKey() == nil:
+		verr.AddViolationAt(tlsPath.Field("clientKey"), "has to be defined when clientCert is defined")
+	case cfg.GetTls().GetClientCert() == nil && cfg.GetTls().GetClientKey() != nil:
+		verr.AddViolationAt(tlsPath.Field("clientCert"), "has to be defined when clientKey is defined")
+	case cfg.GetTls().GetClientCert() != nil:
+		verr.AddErrorAt(tlsPath.Field("clientCert"), datasource.Validate(cfg.GetTls().GetClientCert()))
+		verr.AddErrorAt(tlsPath.Field("clientKey"), datasource.Validate(cfg.GetTls().GetClientKey()))
+	}
+
+	if cfg.GetTimeout() != nil && cfg.GetTimeout().AsDuration() <= 0 {
+		verr.AddViolation("timeout", "has to be greater than 0")
+	}
+	if !util_tls.IsValidKeyType(util_tls.KeyType(cfg.GetKeyType())) {
+		verr.AddViolation("keyType", fmt.Sprintf("has to be either %q or %q", util_tls.RSAKeyType, util_tls.ECDSAKeyType))
+	}
+	return verr.OrNil()
+}
+
+func (r *remoteCaManager) Ensure(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
+	return nil // CA is managed by the external PKI
+}
+
+func (r *remoteCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
+	cfg, err := r.config(backend)
+	if err != nil {
+		return nil, err
+	}
+	var secrets []string
+	for _, source := range []*system_proto.DataSource{
+		cfg.GetRoot(),
+		cfg.GetTls().GetCaCert(),
+		cfg.GetTls().GetClientCert(),
+		cfg.GetTls().GetClientKey(),
+	} {
+		if source.GetSecret() != "" {
+			secrets = append(secrets, source.GetSecret())
+		}
+	}
+	return secrets, nil
+}
+
+func (r *remoteCaManager) GetRootCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]ca.Cert, error) {
+	cfg, err := r.config(backend)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.GetRoot() != nil {
+		root, err := r.dataSourceLoader.Load(ctx, mesh, cfg.GetRoot())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load root certificate for Mesh %q and backend %q", mesh, backend.Name)
+		}
+		return []ca.Cert{root}, nil
+	}
+
+	client, err := r.signingClient(ctx, mesh, backend.Name, cfg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.roots(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch root certificates for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	var certs []ca.Cert
+	for _, crt := range resp.Crts {
+		certs = append(certs, ca.Cert(crt))
+	}
+	return certs, nil
+}
+
+func (r *remoteCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (ca.KeyPair, error) {
+	cfg, err := r.config(backend)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+
+	key, err := util_tls.NewKey(util_tls.KeyType(cfg.GetKeyType()), ca_issuer.DefaultRsaBits)
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrap(err, "failed to generate a private key")
+	}
+	csr, err := newCsr(mesh, tags, key)
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrap(err, "failed to generate a certificate signing request")
+	}
+	request := signRequest{
+		Csr: string(csr),
+	}
+	if backend.GetDpCert().GetRotation().GetExpiration() != "" {
+		duration, err := mesh_helper.ParseDuration(backend.GetDpCert().GetRotation().Expiration)
+		if err != nil {
+			return ca.KeyPair{}, err
+		}
+		request.NotAfter = core.Now().Add(duration).UTC().Format(time.RFC3339)
+	}
+
+	client, err := r.signingClient(ctx, mesh, backend.Name, cfg)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+	resp, err := client.sign(ctx, request)
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "failed to sign a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend.Name)
+	}
+
+	certChain := resp.CertChain
+	if len(certChain) == 0 {
+		certChain = []string{resp.Crt}
+	}
+	if err := verifyPublicKey(certChain[0], key); err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "signing endpoint of backend %q returned invalid certificate", backend.Name)
+	}
+	keyPEM, err := util_tls.PemEncodeKey(key)
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrap(err, "failed to PEM encode a private key")
+	}
+	return ca.KeyPair{
+		CertPEM: []byte(joinPEM(certChain)),
+		KeyPEM:  keyPEM,
+	}, nil
+}
+
+func (r *remoteCaManager) signingClient(ctx context.Context, mesh string, backendName string, cfg *config.RemoteCertificateAuthorityConfig) (*signingClient, error) {
+	settings, err := loadSigningClientSettings(ctx, r.dataSourceLoader, mesh, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	key := signingClientKey{mesh: mesh, backend: backendName}
+	if client, ok := r.clients[key]; ok {
+		if client.settings.equal(settings) {
+			return client, nil
+		}
+		client.close()
+		delete(r.clients, key)
+	}
+	client, err := newSigningClient(settings)
+	if err != nil {
+		return nil, err
+	}
+	r.clients[key] = client
+	return client, nil
+}
+
+func (r *remoteCaManager) config(backend *mesh_proto.CertificateAuthorityBackend) (*config.RemoteCertificateAuthorityConfig, error) {
+	cfg := &config.RemoteCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to RemoteCertificateAuthorityConfig")
+	}
+	return cfg, nil
+}
+
+func newCsr(mesh string, tags mesh_proto.MultiValueTagSet, key crypto.Signer) ([]byte, error) {
+	uris, err := ca_issuer.WorkloadURIs(mesh, tags)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.CertificateRequest{
+		// Subject is deliberately left empty, the same as in certificates issued by the builtin CA
+		URIs: uris,
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), nil
+}
+
+// verifyPublicKey verifies that the signed certificate matches the private key used to create the request
+func verifyPublicKey(certPEM string, key crypto.Signer) error {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return errors.New("could not decode PEM certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return errors.Wrap(err, "could not parse certificate")
+	}
+	publicKey, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !publicKey.Equal(key.Public()) {
+		return errors.New("public key of the certificate does not match the private key of the request")
+	}
+	return nil
+}
+
+func joinPEM(certs []string) string {
+	var sb strings.Builder
+	for _, cert := range certs {
+		sb.WriteString(cert)
+		if !strings.HasSuffix(cert, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
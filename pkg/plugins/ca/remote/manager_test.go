@@ -0,0 +1,366 @@
+package remote_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/ghodss/yaml"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	"github.com/kumahq/kuma/pkg/plugins/ca/remote"
+	"github.com/kumahq/kuma/pkg/plugins/ca/remote/config"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// signer is a local stand-in for an external PKI that signs CSRs with its own CA
+type signer struct {
+	ca     util_tls.KeyPair
+	caCert *x509.Certificate
+	caKey  interface{}
+}
+
+func newSigner() *signer {
+	ca, err := util_tls.NewSelfSignedCert("signer", util_tls.ServerCertType)
+	Expect(err).ToNot(HaveOccurred())
+	pair, err := tls.X509KeyPair(ca.CertPEM, ca.KeyPEM)
+	Expect(err).ToNot(HaveOccurred())
+	caCert, err := x509.ParseCertificate(pair.Certificate[0])
+	Expect(err).ToNot(HaveOccurred())
+	return &signer{
+		ca:     ca,
+		caCert: caCert,
+		caKey:  pair.PrivateKey,
+	}
+}
+
+func (s *signer) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	switch req.URL.Path {
+	case "/roots":
+		_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+			"crts": []string{string(s.ca.CertPEM)},
+		})
+	case "/sign":
+		request := struct {
+			Csr      string `json:"csr"`
+			NotAfter string `json:"notAfter"`
+		}{}
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+		block, _ := pem.Decode([]byte(request.Csr))
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil || csr.CheckSignature() != nil {
+			http.Error(writer, "invalid CSR", http.StatusBadRequest)
+			return
+		}
+		notAfter := time.Now().Add(24 * time.Hour)
+		if request.NotAfter != "" {
+			notAfter, _ = time.Parse(time.RFC3339, request.NotAfter)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			URIs:         csr.URIs,
+			NotBefore:    time.Now().Add(-time.Minute),
+			NotAfter:     notAfter,
+			KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, csr.PublicKey, s.caKey)
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+			"crt": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		})
+	default:
+		http.Error(writer, "forbidden", http.StatusForbidden)
+	}
+}
+
+func inlineString(data []byte) *system_proto.DataSource {
+	return &system_proto.DataSource{
+		Type: &system_proto.DataSource_InlineString{
+			InlineString: string(data),
+		},
+	}
+}
+
+var _ = Describe("Remote CA", func() {
+	var caManager core_ca.Manager
+
+	now := time.Now()
+
+	BeforeEach(func() {
+		core.Now = func() time.Time {
+			return now
+		}
+		caManager = remote.NewRemoteCaManager(datasource.NewDataSourceLoader(nil))
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	Context("ValidateBackend", func() {
+		type testCase struct {
+			configYAML string
+			expected   string
+		}
+
+		DescribeTable("should Validate invalid config",
+			func(given testCase) {
+				// given
+				str := structpb.Struct{}
+				err := proto.FromYAML([]byte(given.configYAML), &str)
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := caManager.ValidateBackend(context.Background(), "default", &mesh_proto.CertificateAuthorityBackend{
+					Name: "remote-1",
+					Type: "remote",
+					Conf: &str,
+				})
+
+				// then
+				actual, err := yaml.Marshal(verr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty config", testCase{
+				configYAML: ``,
+				expected: `
+            violations:
+            - field: url
+              message: has to be defined`,
+			}),
+			Entry("config with invalid values", testCase{
+				configYAML: `
+            url: ftp://pki.example.com
+            root: {}
+            tls:
+              clientCert:
+                inlineString: cert
+            timeout: 0s
+            keyType: DSA`,
+				expected: `
+            violations:
+            - field: url
+              message: has to be a valid http or https URL
+            - field: root
+              message: 'data source has to be chosen. Available sources: secret, file, inline'
+            - field: tls.clientKey
+              message: has to be defined when clientCert is defined
+            - field: timeout
+              message: has to be greater than 0
+            - field: keyType
+              message: has to be either "RSA" or "ECDSA"`,
+			}),
+		)
+
+		It("should accept valid config", func() {
+			// given
+			str := structpb.Struct{}
+			err := proto.FromYAML([]byte(`
+            url: https://pki.example.com
+            tls:
+              caCert:
+                secret: pki-ca
+              clientCert:
+                secret: pki-client-cert
+              clientKey:
+                secret: pki-client-key
+            timeout: 5s
+            keyType: ECDSA`), &str)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = caManager.ValidateBackend(context.Background(), "default", &mesh_proto.CertificateAuthorityBackend{
+				Name: "remote-1",
+				Type: "remote",
+				Conf: &str,
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and secrets are used
+			secrets, err := caManager.UsedSecrets("default", &mesh_proto.CertificateAuthorityBackend{
+				Name: "remote-1",
+				Type: "remote",
+				Conf: &str,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets).To(Equal([]string{"pki-ca", "pki-client-cert", "pki-client-key"}))
+		})
+	})
+
+	Context("with a signing endpoint", func() {
+		var server *httptest.Server
+		var stubSigner *signer
+		var clientCert util_tls.KeyPair
+		var serverCert util_tls.KeyPair
+		var newConnections int32
+
+		BeforeEach(func() {
+			stubSigner = newSigner()
+
+			var err error
+			clientCert, err = util_tls.NewSelfSignedCert("kuma-control-plane", util_tls.ClientCertType)
+			Expect(err).ToNot(HaveOccurred())
+			serverCert, err = util_tls.NewSelfSignedCert("signer", util_tls.ServerCertType, "127.0.0.1", "localhost")
+			Expect(err).ToNot(HaveOccurred())
+			serverPair, err := tls.X509KeyPair(serverCert.CertPEM, serverCert.KeyPEM)
+			Expect(err).ToNot(HaveOccurred())
+			clientCAs := x509.NewCertPool()
+			Expect(clientCAs.AppendCertsFromPEM(clientCert.CertPEM)).To(BeTrue())
+
+			newConnections = 0
+			server = httptest.NewUnstartedServer(stubSigner)
+			server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					atomic.AddInt32(&newConnections, 1)
+				}
+			}
+			server.TLS = &tls.Config{
+				Certificates: []tls.Certificate{serverPair},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    clientCAs,
+			}
+			server.StartTLS()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		backend := func(url string, withClientCert bool) *mesh_proto.CertificateAuthorityBackend {
+			cfg := &config.RemoteCertificateAuthorityConfig{
+				Url: url,
+				Tls: &config.RemoteCertificateAuthorityConfig_Tls{
+					CaCert: inlineString(serverCert.CertPEM),
+				},
+			}
+			if withClientCert {
+				cfg.Tls.ClientCert = inlineString(clientCert.CertPEM)
+				cfg.Tls.ClientKey = inlineString(clientCert.KeyPEM)
+			}
+			return &mesh_proto.CertificateAuthorityBackend{
+				Name: "remote-1",
+				Type: "remote",
+				DpCert: &mesh_proto.CertificateAuthorityBackend_DpCert{
+					Rotation: &mesh_proto.CertificateAuthorityBackend_DpCert_Rotation{
+						Expiration: "1h",
+					},
+				},
+				Conf: proto.MustToStruct(cfg),
+			}
+		}
+
+		It("should generate dataplane certs signed by the signing endpoint", func() {
+			// given
+			tags := map[string]map[string]bool{
+				"kuma.io/service": {
+					"web": true,
+				},
+				"version": {
+					"v1": true,
+				},
+			}
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(server.URL, true), tags)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			_, err = tls.X509KeyPair(pair.CertPEM, pair.KeyPEM)
+			Expect(err).ToNot(HaveOccurred())
+
+			block, _ := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.URIs).To(HaveLen(3))
+			Expect(cert.URIs[0].String()).To(Equal("spiffe://default/web"))
+			Expect(cert.URIs[1].String()).To(Equal("kuma://kuma.io/service/web"))
+			Expect(cert.URIs[2].String()).To(Equal("kuma://version/v1"))
+			Expect(cert.NotAfter).To(Equal(now.UTC().Truncate(time.Second).Add(time.Hour)))
+
+			// and cert is signed by root certs of the signing endpoint
+			roots, err := caManager.GetRootCert(context.Background(), "default", backend(server.URL, true))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(roots).To(HaveLen(1))
+			pool := x509.NewCertPool()
+			Expect(pool.AppendCertsFromPEM(roots[0])).To(BeTrue())
+			_, err = cert.Verify(x509.VerifyOptions{
+				Roots:     pool,
+				KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reuse connections to the signing endpoint until the backend changes", func() {
+			// when
+			for i := 0; i < 3; i++ {
+				_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(server.URL, true), mesh_proto.MultiValueTagSet{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// then
+			Expect(atomic.LoadInt32(&newConnections)).To(Equal(int32(1)))
+
+			// when the backend is configured with an equivalent URL
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(server.URL+"/", true), mesh_proto.MultiValueTagSet{})
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(atomic.LoadInt32(&newConnections)).To(Equal(int32(1)))
+
+			// when client cert changes
+			clientCert, err = util_tls.NewSelfSignedCert("kuma-control-plane", util_tls.ClientCertType)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = caManager.GenerateDataplaneCert(context.Background(), "default", backend(server.URL, true), mesh_proto.MultiValueTagSet{})
+
+			// then a new connection is established with the new client cert, which is not trusted by the signing endpoint
+			Expect(err).To(HaveOccurred())
+			Expect(atomic.LoadInt32(&newConnections)).To(Equal(int32(2)))
+		})
+
+		It("should fail when client cert is not presented", func() {
+			// when
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(server.URL, false), mesh_proto.MultiValueTagSet{})
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not send a request to " + server.URL + "/sign"))
+		})
+
+		It("should fail when signing endpoint rejects the request", func() {
+			// when
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(server.URL+"/unknown", true), mesh_proto.MultiValueTagSet{})
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(server.URL + "/unknown/sign returned status code 403: forbidden"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package remote_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCaRemote(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CA Remote Suite")
+}
@@ -2,6 +2,7 @@ package k8s
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -57,6 +58,7 @@ func (s *KubernetesStore) Create(ctx context.Context, r core_model.Resource, fs
 		ObjectMeta: kube_meta.ObjectMeta{
 			Name:      opts.Name,
 			Namespace: s.namespace,
+			Labels:    opts.Labels,
 		},
 		Immutable: nil,
 		Data: map[string]string{
@@ -83,6 +85,7 @@ func (s *KubernetesStore) Update(ctx context.Context, r core_model.Resource, fs
 	if !ok {
 		return newInvalidTypeError()
 	}
+	opts := core_store.NewUpdateOptions(fs...)
 	cm := &kube_core.ConfigMap{
 		TypeMeta: kube_meta.TypeMeta{
 			Kind:       "ConfigMap",
@@ -94,6 +97,9 @@ func (s *KubernetesStore) Update(ctx context.Context, r core_model.Resource, fs
 			configMapKey: configRes.Spec.Config,
 		},
 	}
+	if opts.Labels != nil {
+		cm.SetLabels(opts.Labels)
+	}
 	if err := s.client.Update(context.Background(), cm); err != nil {
 		if kube_apierrs.IsConflict(err) {
 			return core_store.ErrorResourceConflict(r.GetType(), r.GetMeta().GetName(), r.GetMeta().GetMesh())
@@ -147,12 +153,16 @@ func (s *KubernetesStore) List(ctx context.Context, rs core_model.ResourceList,
 	if !ok {
 		return newInvalidTypeError()
 	}
+	opts := core_store.NewListOptions(fs...)
 	cmlist := &kube_core.ConfigMapList{}
 
-	if err := s.client.List(ctx, cmlist, kube_client.InNamespace(s.namespace)); err != nil {
+	if err := s.client.List(ctx, cmlist, kube_client.InNamespace(s.namespace), kube_client.MatchingLabels(opts.LabelSelector)); err != nil {
 		return errors.Wrap(err, "failed to list k8s internal config")
 	}
 	for _, cm := range cmlist.Items {
+		if !strings.Contains(cm.Name, opts.NameContains) {
+			continue
+		}
 		configRes.Items = append(configRes.Items, &config_model.ConfigResource{
 			Spec: &system_proto.Config{
 				Config: cm.Data[configMapKey],
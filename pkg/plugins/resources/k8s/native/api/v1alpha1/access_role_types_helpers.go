@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (cb *AccessRole) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *AccessRole) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *AccessRole) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *AccessRole) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *AccessRole) GetSpec() map[string]interface{} {
+	return cb.Spec
+}
+
+func (cb *AccessRole) SetSpec(spec map[string]interface{}) {
+	cb.Spec = spec
+}
+
+func (cb *AccessRole) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *AccessRoleList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&proto.AccessRole{}, &AccessRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "AccessRole",
+		},
+	})
+	registry.RegisterListType(&proto.AccessRole{}, &AccessRoleList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "AccessRoleList",
+		},
+	})
+}
@@ -50,6 +50,9 @@ func (s *KubernetesStore) Create(ctx context.Context, r core_model.Resource, fs
 	obj.SetMesh(opts.Mesh)
 	obj.GetObjectMeta().SetName(name)
 	obj.GetObjectMeta().SetNamespace(namespace)
+	if len(opts.Labels) != 0 {
+		obj.GetObjectMeta().SetLabels(opts.Labels)
+	}
 
 	if opts.Owner != nil {
 		k8sOwner, err := s.Converter.ToKubernetesObject(opts.Owner)
@@ -98,6 +101,9 @@ func (s *KubernetesStore) Update(ctx context.Context, r core_model.Resource, fs
 	if opts.Synced {
 		markAsSynced(obj)
 	}
+	if opts.Labels != nil {
+		obj.GetObjectMeta().SetLabels(opts.Labels)
+	}
 
 	if err := s.Client.Update(ctx, obj); err != nil {
 		if kube_apierrs.IsConflict(err) {
@@ -173,14 +179,19 @@ func (s *KubernetesStore) List(ctx context.Context, rs core_model.ResourceList,
 	if err != nil {
 		return errors.Wrapf(err, "failed to convert core list model of type %s into k8s counterpart", rs.GetItemType())
 	}
-	if err := s.Client.List(ctx, obj); err != nil {
+	var listOpts []kube_client.ListOption
+	if len(opts.LabelSelector) != 0 {
+		listOpts = append(listOpts, kube_client.MatchingLabels(opts.LabelSelector))
+	}
+	if err := s.Client.List(ctx, obj, listOpts...); err != nil {
 		return errors.Wrap(err, "failed to list k8s resources")
 	}
 	predicate := func(r core_model.Resource) bool {
-		if opts.Mesh != "" {
-			return r.GetMeta().GetMesh() == opts.Mesh
+		if opts.Mesh != "" && r.GetMeta().GetMesh() != opts.Mesh {
+			return false
 		}
-		return true
+		// name is not a supported field selector of custom resources, so it is filtered here
+		return opts.Matches(r.GetMeta())
 	}
 	fullList, err := registry.Global().NewList(rs.GetItemType())
 	if err != nil {
@@ -26,6 +26,7 @@ type memoryStoreRecord struct {
 	Spec             string
 	CreationTime     time.Time
 	ModificationTime time.Time
+	Labels           map[string]string
 	Children         []*resourceKey
 }
 type memoryStoreRecords = []*memoryStoreRecord
@@ -38,6 +39,7 @@ type memoryMeta struct {
 	Version          memoryVersion
 	CreationTime     time.Time
 	ModificationTime time.Time
+	Labels           map[string]string
 }
 
 func (m memoryMeta) GetName() string {
@@ -64,6 +66,10 @@ func (m memoryMeta) GetModificationTime() time.Time {
 	return m.ModificationTime
 }
 
+func (m memoryMeta) GetLabels() map[string]string {
+	return m.Labels
+}
+
 type memoryVersion uint64
 
 func initialVersion() memoryVersion {
@@ -112,6 +118,7 @@ func (c *memoryStore) Create(_ context.Context, r model.Resource, fs ...store.Cr
 		Version:          initialVersion(),
 		CreationTime:     opts.CreationTime,
 		ModificationTime: opts.CreationTime,
+		Labels:           opts.Labels,
 	}
 
 	// fill the meta
@@ -167,6 +174,7 @@ func (c *memoryStore) Update(_ context.Context, r model.Resource, fs ...store.Up
 	}
 	meta.Version = meta.Version.Next()
 	meta.ModificationTime = opts.ModificationTime
+	meta.Labels = opts.LabelsOf(meta)
 
 	record, err := c.marshalRecord(
 		string(r.GetType()),
@@ -267,15 +275,20 @@ func (c *memoryStore) List(_ context.Context, rs model.ResourceList, fs ...store
 
 	records := c.findRecords(string(rs.GetItemType()), opts.Mesh)
 
+	total := 0
 	for i := 0; i < len(records); i++ {
 		r := rs.NewItem()
 		if err := c.unmarshalRecord(records[i], r); err != nil {
 			return err
 		}
+		if !opts.Matches(r.GetMeta()) {
+			continue
+		}
 		_ = rs.AddItem(r)
+		total++
 	}
 
-	rs.GetPagination().SetTotal(uint32(len(records)))
+	rs.GetPagination().SetTotal(uint32(total))
 
 	return nil
 }
@@ -321,6 +334,7 @@ func (c *memoryStore) marshalRecord(resourceType string, meta memoryMeta, spec m
 		Spec:             string(content),
 		CreationTime:     meta.CreationTime,
 		ModificationTime: meta.ModificationTime,
+		Labels:           copyLabels(meta.Labels),
 	}, nil
 }
 
@@ -331,6 +345,18 @@ func (c *memoryStore) unmarshalRecord(s *memoryStoreRecord, r model.Resource) er
 		Version:          s.Version,
 		CreationTime:     s.CreationTime,
 		ModificationTime: s.ModificationTime,
+		Labels:           copyLabels(s.Labels),
 	})
 	return util_proto.FromJSON([]byte(s.Spec), r.GetSpec())
 }
+
+func copyLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+	result := make(map[string]string, len(labels))
+	for key, value := range labels {
+		result[key] = value
+	}
+	return result
+}
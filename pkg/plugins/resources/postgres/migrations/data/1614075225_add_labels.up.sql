@@ -0,0 +1,2 @@
+ALTER TABLE resources ADD COLUMN labels JSONB NOT NULL DEFAULT '{}'::jsonb;
+CREATE INDEX resources_labels_idx ON resources USING GIN (labels);
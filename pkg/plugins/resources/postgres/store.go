@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -64,10 +65,15 @@ func (r *postgresResourceStore) Create(_ context.Context, resource model.Resourc
 		ownerType = ptr(string(opts.Owner.GetType()))
 	}
 
+	labels, err := marshalLabels(opts.Labels)
+	if err != nil {
+		return err
+	}
+
 	version := 0
-	statement := `INSERT INTO resources VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);`
+	statement := `INSERT INTO resources VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);`
 	_, err = r.db.Exec(statement, opts.Name, opts.Mesh, resource.GetType(), version, string(bytes),
-		opts.CreationTime.UTC(), opts.CreationTime.UTC(), ownerName, ownerMesh, ownerType)
+		opts.CreationTime.UTC(), opts.CreationTime.UTC(), ownerName, ownerMesh, ownerType, labels)
 	if err != nil {
 		if strings.Contains(err.Error(), duplicateKeyErrorMsg) {
 			return store.ErrorResourceAlreadyExists(resource.GetType(), opts.Name, opts.Mesh)
@@ -81,6 +87,7 @@ func (r *postgresResourceStore) Create(_ context.Context, resource model.Resourc
 		Version:          strconv.Itoa(version),
 		CreationTime:     opts.CreationTime,
 		ModificationTime: opts.CreationTime,
+		Labels:           opts.Labels,
 	})
 	return nil
 }
@@ -98,12 +105,18 @@ func (r *postgresResourceStore) Update(_ context.Context, resource model.Resourc
 	if err != nil {
 		return errors.Wrap(err, "failed to convert meta version to int")
 	}
-	statement := `UPDATE resources SET spec=$1, version=$2, modification_time=$3 WHERE name=$4 AND mesh=$5 AND type=$6 AND version=$7;`
+	labels := opts.LabelsOf(resource.GetMeta())
+	labelsJSON, err := marshalLabels(labels)
+	if err != nil {
+		return err
+	}
+	statement := `UPDATE resources SET spec=$1, version=$2, modification_time=$3, labels=$4 WHERE name=$5 AND mesh=$6 AND type=$7 AND version=$8;`
 	result, err := r.db.Exec(
 		statement,
 		string(bytes),
 		newVersion,
 		opts.ModificationTime.UTC(),
+		labelsJSON,
 		resource.GetMeta().GetName(),
 		resource.GetMeta().GetMesh(),
 		resource.GetType(),
@@ -122,6 +135,7 @@ func (r *postgresResourceStore) Update(_ context.Context, resource model.Resourc
 		Mesh:             resource.GetMeta().GetMesh(),
 		Version:          strconv.Itoa(newVersion),
 		ModificationTime: opts.ModificationTime,
+		Labels:           labels,
 	})
 
 	return nil
@@ -145,13 +159,13 @@ func (r *postgresResourceStore) Delete(_ context.Context, resource model.Resourc
 func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
 	opts := store.NewGetOptions(fs...)
 
-	statement := `SELECT spec, version, creation_time, modification_time FROM resources WHERE name=$1 AND mesh=$2 AND type=$3;`
+	statement := `SELECT spec, version, creation_time, modification_time, labels FROM resources WHERE name=$1 AND mesh=$2 AND type=$3;`
 	row := r.db.QueryRow(statement, opts.Name, opts.Mesh, resource.GetType())
 
-	var spec string
+	var spec, labelsJSON string
 	var version int
 	var creationTime, modificationTime time.Time
-	err := row.Scan(&spec, &version, &creationTime, &modificationTime)
+	err := row.Scan(&spec, &version, &creationTime, &modificationTime, &labelsJSON)
 	if err == sql.ErrNoRows {
 		return store.ErrorResourceNotFound(resource.GetType(), opts.Name, opts.Mesh)
 	}
@@ -162,6 +176,10 @@ func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource,
 	if err := proto.FromJSON([]byte(spec), resource.GetSpec()); err != nil {
 		return errors.Wrap(err, "failed to convert json to spec")
 	}
+	labels, err := unmarshalLabels(labelsJSON)
+	if err != nil {
+		return err
+	}
 
 	meta := &resourceMetaObject{
 		Name:             opts.Name,
@@ -169,6 +187,7 @@ func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource,
 		Version:          strconv.Itoa(version),
 		CreationTime:     creationTime.Local(),
 		ModificationTime: modificationTime.Local(),
+		Labels:           labels,
 	}
 	resource.SetMeta(meta)
 
@@ -181,7 +200,7 @@ func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource,
 func (r *postgresResourceStore) List(_ context.Context, resources model.ResourceList, args ...store.ListOptionsFunc) error {
 	opts := store.NewListOptions(args...)
 
-	statement := `SELECT name, mesh, spec, version, creation_time, modification_time FROM resources WHERE type=$1`
+	statement := `SELECT name, mesh, spec, version, creation_time, modification_time, labels FROM resources WHERE type=$1`
 	var statementArgs []interface{}
 	statementArgs = append(statementArgs, resources.GetItemType())
 	argsIndex := 1
@@ -190,6 +209,20 @@ func (r *postgresResourceStore) List(_ context.Context, resources model.Resource
 		statement += fmt.Sprintf(" AND mesh=$%d", argsIndex)
 		statementArgs = append(statementArgs, opts.Mesh)
 	}
+	if len(opts.LabelSelector) != 0 {
+		selector, err := marshalLabels(opts.LabelSelector)
+		if err != nil {
+			return err
+		}
+		argsIndex++
+		statement += fmt.Sprintf(" AND labels @> $%d::jsonb", argsIndex)
+		statementArgs = append(statementArgs, selector)
+	}
+	if opts.NameContains != "" {
+		argsIndex++
+		statement += fmt.Sprintf(" AND strpos(name, $%d) > 0", argsIndex)
+		statementArgs = append(statementArgs, opts.NameContains)
+	}
 	statement += " ORDER BY name, mesh"
 
 	rows, err := r.db.Query(statement, statementArgs...)
@@ -215,10 +248,10 @@ func (r *postgresResourceStore) List(_ context.Context, resources model.Resource
 }
 
 func rowToItem(resources model.ResourceList, rows *sql.Rows) (model.Resource, error) {
-	var name, mesh, spec string
+	var name, mesh, spec, labelsJSON string
 	var version int
 	var creationTime, modificationTime time.Time
-	if err := rows.Scan(&name, &mesh, &spec, &version, &creationTime, &modificationTime); err != nil {
+	if err := rows.Scan(&name, &mesh, &spec, &version, &creationTime, &modificationTime, &labelsJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve elements from query")
 	}
 
@@ -226,6 +259,10 @@ func rowToItem(resources model.ResourceList, rows *sql.Rows) (model.Resource, er
 	if err := proto.FromJSON([]byte(spec), item.GetSpec()); err != nil {
 		return nil, errors.Wrap(err, "failed to convert json to spec")
 	}
+	labels, err := unmarshalLabels(labelsJSON)
+	if err != nil {
+		return nil, err
+	}
 
 	meta := &resourceMetaObject{
 		Name:             name,
@@ -233,6 +270,7 @@ func rowToItem(resources model.ResourceList, rows *sql.Rows) (model.Resource, er
 		Version:          strconv.Itoa(version),
 		CreationTime:     creationTime.Local(),
 		ModificationTime: modificationTime.Local(),
+		Labels:           labels,
 	}
 	item.SetMeta(meta)
 
@@ -249,6 +287,7 @@ type resourceMetaObject struct {
 	Mesh             string
 	CreationTime     time.Time
 	ModificationTime time.Time
+	Labels           map[string]string
 }
 
 var _ model.ResourceMeta = &resourceMetaObject{}
@@ -277,6 +316,32 @@ func (r *resourceMetaObject) GetModificationTime() time.Time {
 	return r.ModificationTime
 }
 
+func (r *resourceMetaObject) GetLabels() map[string]string {
+	return r.Labels
+}
+
+func marshalLabels(labels map[string]string) (string, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	bytes, err := json.Marshal(labels)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert labels to json")
+	}
+	return string(bytes), nil
+}
+
+func unmarshalLabels(labelsJSON string) (map[string]string, error) {
+	labels := map[string]string{}
+	if err := json.Unmarshal([]byte(labelsJSON), &labels); err != nil {
+		return nil, errors.Wrap(err, "failed to convert json to labels")
+	}
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	return labels, nil
+}
+
 func registerMetrics(metrics core_metrics.Metrics, db *sql.DB) error {
 	postgresCurrentConnectionMetric := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
 		Name: "store_postgres_connections",
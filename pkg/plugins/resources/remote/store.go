@@ -34,9 +34,10 @@ type remoteStore struct {
 func (s *remoteStore) Create(ctx context.Context, res model.Resource, fs ...store.CreateOptionsFunc) error {
 	opts := store.NewCreateOptions(fs...)
 	meta := rest.ResourceMeta{
-		Type: string(res.GetType()),
-		Name: opts.Name,
-		Mesh: opts.Mesh,
+		Type:   string(res.GetType()),
+		Name:   opts.Name,
+		Mesh:   opts.Mesh,
+		Labels: opts.Labels,
 	}
 	if err := s.upsert(ctx, res, meta); err != nil {
 		return err
@@ -45,10 +46,12 @@ func (s *remoteStore) Create(ctx context.Context, res model.Resource, fs ...stor
 }
 
 func (s *remoteStore) Update(ctx context.Context, res model.Resource, fs ...store.UpdateOptionsFunc) error {
+	opts := store.NewUpdateOptions(fs...)
 	meta := rest.ResourceMeta{
-		Type: string(res.GetType()),
-		Name: res.GetMeta().GetName(),
-		Mesh: res.GetMeta().GetMesh(),
+		Type:   string(res.GetType()),
+		Name:   res.GetMeta().GetName(),
+		Mesh:   res.GetMeta().GetMesh(),
+		Labels: opts.LabelsOf(res.GetMeta()),
 	}
 	if err := s.upsert(ctx, res, meta); err != nil {
 		return err
@@ -89,6 +92,7 @@ func (s *remoteStore) upsert(ctx context.Context, res model.Resource, meta rest.
 		Name:    meta.Name,
 		Mesh:    meta.Mesh,
 		Version: "",
+		Labels:  meta.Labels,
 	})
 	return nil
 }
@@ -160,6 +164,12 @@ func (s *remoteStore) List(ctx context.Context, rs model.ResourceList, fs ...sto
 	if opts.PageSize != 0 {
 		query.Add("size", strconv.Itoa(opts.PageSize))
 	}
+	if len(opts.LabelSelector) != 0 {
+		query.Add("labelSelector", store.FormatLabelSelector(opts.LabelSelector))
+	}
+	if opts.NameContains != "" {
+		query.Add("nameContains", opts.NameContains)
+	}
 	req.URL.RawQuery = query.Encode()
 
 	statusCode, b, err := s.doRequest(ctx, req)
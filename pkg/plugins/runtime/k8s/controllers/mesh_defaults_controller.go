@@ -11,15 +11,17 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	defaults_mesh "github.com/kumahq/kuma/pkg/defaults/mesh"
 	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
 // MeshDefaultsReconciler creates default resources for created Mesh
 type MeshDefaultsReconciler struct {
-	ResourceManager manager.ResourceManager
+	ResourceManager      manager.ResourceManager
+	DpTokenSigningMethod issuer.SigningMethod
 }
 
 func (r *MeshDefaultsReconciler) Reconcile(req kube_ctrl.Request) (kube_ctrl.Result, error) {
-	if err := defaults_mesh.EnsureDefaultMeshResources(r.ResourceManager, req.Name); err != nil {
+	if err := defaults_mesh.EnsureDefaultMeshResources(r.ResourceManager, req.Name, r.DpTokenSigningMethod); err != nil {
 		return kube_ctrl.Result{}, errors.Wrap(err, "could not create default mesh resources")
 	}
 	return kube_ctrl.Result{}, nil
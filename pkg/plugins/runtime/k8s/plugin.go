@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/kumahq/kuma/pkg/core/managers/apis/ratelimit"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/zone"
 	"github.com/kumahq/kuma/pkg/dns"
 	"github.com/kumahq/kuma/pkg/dns/vips"
@@ -137,7 +138,8 @@ func addMeshReconciler(mgr kube_ctrl.Manager, rt core_runtime.Runtime, converter
 		return errors.Wrap(err, "could not setup mesh reconciller")
 	}
 	defaultsReconciller := &k8s_controllers.MeshDefaultsReconciler{
-		ResourceManager: rt.ResourceManager(),
+		ResourceManager:      rt.ResourceManager(),
+		DpTokenSigningMethod: rt.Config().DpServer.Auth.DpTokenSigningMethod,
 	}
 	if err := defaultsReconciller.SetupWithManager(mgr); err != nil {
 		return errors.Wrap(err, "could not setup mesh defaults reconciller")
@@ -250,6 +252,10 @@ func addValidators(mgr kube_ctrl.Manager, rt core_runtime.Runtime, converter k8s
 	k8sMeshValidator := k8s_webhooks.NewMeshValidatorWebhook(coreMeshValidator, converter, rt.ResourceManager())
 	composite.AddValidator(k8sMeshValidator)
 
+	coreRateLimitValidator := ratelimit.Validator{Store: rt.ResourceStore()}
+	k8sRateLimitValidator := k8s_webhooks.NewRateLimitValidatorWebhook(coreRateLimitValidator, converter)
+	composite.AddValidator(k8sRateLimitValidator)
+
 	coreZoneValidator := zone.Validator{Store: rt.ResourceStore()}
 	k8sZoneValidator := k8s_webhooks.NewZoneValidatorWebhook(coreZoneValidator)
 	composite.AddValidator(k8sZoneValidator)
@@ -0,0 +1,68 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/api/admission/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kumahq/kuma/pkg/core/managers/apis/ratelimit"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	k8s_common "github.com/kumahq/kuma/pkg/plugins/common/k8s"
+	mesh_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/api/v1alpha1"
+)
+
+func NewRateLimitValidatorWebhook(validator ratelimit.Validator, converter k8s_common.Converter) k8s_common.AdmissionValidator {
+	return &RateLimitValidator{
+		validator: validator,
+		converter: converter,
+	}
+}
+
+type RateLimitValidator struct {
+	validator ratelimit.Validator
+	converter k8s_common.Converter
+	decoder   *admission.Decoder
+}
+
+func (h *RateLimitValidator) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+func (h *RateLimitValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	switch req.Operation {
+	case v1beta1.Create, v1beta1.Update:
+		return h.ValidateCreateOrUpdate(ctx, req)
+	}
+	return admission.Allowed("")
+}
+
+func (h *RateLimitValidator) ValidateCreateOrUpdate(ctx context.Context, req admission.Request) admission.Response {
+	coreRes := core_mesh.NewRateLimitResource()
+	k8sRes := &mesh_k8s.RateLimit{}
+	if err := h.decoder.Decode(req, k8sRes); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	// synced resources were already validated by the Global CP
+	if k8sRes.GetAnnotations()[k8s_common.K8sSynced] == "true" {
+		return admission.Allowed("")
+	}
+	if err := h.converter.ToCoreResource(k8sRes, coreRes); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if err := h.validator.ValidateCreateOrUpdate(ctx, k8sRes.GetMesh(), coreRes); err != nil {
+		if kumaErr, ok := err.(*validators.ValidationError); ok {
+			return convertSpecValidationError(kumaErr, k8sRes)
+		}
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
+func (h *RateLimitValidator) Supports(req admission.Request) bool {
+	gvk := mesh_k8s.GroupVersion.WithKind("RateLimit")
+	return req.Kind.Kind == gvk.Kind && req.Kind.Version == gvk.Version && req.Kind.Group == gvk.Group
+}
@@ -3,6 +3,7 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -53,10 +54,14 @@ func (s *KubernetesStore) Create(ctx context.Context, r core_model.Resource, fs
 	}
 	secret.Namespace = s.namespace
 	secret.Name = opts.Name
+	labels := map[string]string{}
+	for key, value := range opts.Labels {
+		labels[key] = value
+	}
 	if r.GetType() == secret_model.SecretType {
-		labels := map[string]string{
-			meshLabel: opts.Mesh,
-		}
+		labels[meshLabel] = opts.Mesh
+	}
+	if len(labels) != 0 {
 		secret.SetLabels(labels)
 	}
 
@@ -73,11 +78,22 @@ func (s *KubernetesStore) Create(ctx context.Context, r core_model.Resource, fs
 	return nil
 }
 func (s *KubernetesStore) Update(ctx context.Context, r core_model.Resource, fs ...core_store.UpdateOptionsFunc) error {
+	opts := core_store.NewUpdateOptions(fs...)
 	secret, err := s.converter.ToKubernetesObject(r)
 	if err != nil {
 		return errors.Wrap(err, "failed to convert core Secret into k8s counterpart")
 	}
 	secret.Namespace = s.namespace
+	if opts.Labels != nil {
+		labels := map[string]string{}
+		for key, value := range opts.Labels {
+			labels[key] = value
+		}
+		if r.GetType() == secret_model.SecretType {
+			labels[meshLabel] = r.GetMeta().GetMesh()
+		}
+		secret.SetLabels(labels)
+	}
 	if err := s.writer.Update(ctx, secret); err != nil {
 		if kube_apierrs.IsConflict(err) {
 			return core_store.ErrorResourceConflict(r.GetType(), secret.Name, r.GetMeta().GetMesh())
@@ -154,6 +170,9 @@ func (s *KubernetesStore) List(ctx context.Context, rs core_model.ResourceList,
 
 	fields := kube_client.MatchingFields{} // list only Kuma System secrets
 	labels := kube_client.MatchingLabels{}
+	for key, value := range opts.LabelSelector {
+		labels[key] = value
+	}
 	switch rs.GetItemType() {
 	case secret_model.SecretType:
 		fields = kube_client.MatchingFields{ // list only Kuma System secrets
@@ -170,6 +189,15 @@ func (s *KubernetesStore) List(ctx context.Context, rs core_model.ResourceList,
 	if err := s.reader.List(ctx, secrets, kube_client.InNamespace(s.namespace), labels, fields); err != nil {
 		return errors.Wrap(err, "failed to list k8s Secrets")
 	}
+	if opts.NameContains != "" {
+		var items []kube_core.Secret
+		for _, secret := range secrets.Items {
+			if strings.Contains(secret.Name, opts.NameContains) {
+				items = append(items, secret)
+			}
+		}
+		secrets.Items = items
+	}
 	if err := s.converter.ToCoreList(secrets, rs); err != nil {
 		return errors.Wrap(err, "failed to convert k8s Secret into core counterpart")
 	}
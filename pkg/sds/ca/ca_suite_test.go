@@ -0,0 +1,13 @@
+package ca_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCaProvider(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SDS CA Provider Suite")
+}
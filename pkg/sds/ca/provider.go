@@ -14,7 +14,8 @@ import (
 )
 
 type Provider interface {
-	Get(ctx context.Context, mesh string) (*core_xds.CaSecret, error)
+	// Get returns root certificates and CRLs of the given CA backends of the Mesh
+	Get(ctx context.Context, mesh string, backends []string) (*core_xds.CaSecret, error)
 }
 
 func NewProvider(resourceManager core_manager.ResourceManager, caManagers core_ca.Managers) Provider {
@@ -33,28 +34,56 @@ func (s *meshCaProvider) RequiresIdentity() bool {
 	return false
 }
 
-func (s *meshCaProvider) Get(ctx context.Context, mesh string) (*core_xds.CaSecret, error) {
+func (s *meshCaProvider) Get(ctx context.Context, mesh string, backends []string) (*core_xds.CaSecret, error) {
 	meshRes := core_mesh.NewMeshResource()
 	if err := s.resourceManager.Get(ctx, meshRes, core_store.GetByKey(mesh, model.NoMesh)); err != nil {
 		return nil, errors.Wrapf(err, "failed to find a Mesh %q", mesh)
 	}
 
-	backend := meshRes.GetEnabledCertificateAuthorityBackend()
-	if backend == nil {
-		return nil, errors.New("CA backend is nil")
+	revokedSerials, err := meshRes.GetRevokedSerials()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse revoked serial numbers")
 	}
 
-	caManager, exist := s.caManagers[backend.Type]
-	if !exist {
-		return nil, errors.Errorf("CA manager of type %s not exist", backend.Type)
+	var certs []core_ca.Cert
+	revokers := map[string]core_ca.Revoker{}
+	for _, name := range backends {
+		backend := meshRes.GetCertificateAuthorityBackend(name)
+		if backend == nil {
+			return nil, errors.Errorf("CA backend %q does not exist", name)
+		}
+
+		caManager, exist := s.caManagers[backend.Type]
+		if !exist {
+			return nil, errors.Errorf("CA manager of type %s not exist", backend.Type)
+		}
+
+		backendCerts, err := caManager.GetRootCert(ctx, mesh, backend)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get root certs of backend %q", name)
+		}
+		certs = append(certs, backendCerts...)
+
+		if revoker, ok := caManager.(core_ca.Revoker); ok {
+			revokers[name] = revoker
+		}
 	}
 
-	certs, err := caManager.GetRootCert(ctx, mesh, backend)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get root certs")
+	// Envoy checks CRLs of all trusted CAs once any CRL is provided, so certificates of CAs that cannot revoke them
+	// would be rejected. In this case, CRLs are not provided at all and revoked Dataplanes are only not issued new certificates.
+	var crls []core_ca.Crl
+	if len(revokedSerials) > 0 && len(revokers) == len(backends) {
+		for _, name := range backends {
+			backendCrls, err := revokers[name].GenerateCrls(ctx, mesh, meshRes.GetCertificateAuthorityBackend(name), revokedSerials)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not generate CRLs of backend %q", name)
+			}
+			crls = append(crls, backendCrls...)
+		}
 	}
 
 	return &core_xds.CaSecret{
 		PemCerts: certs,
+		PemCrls:  crls,
 	}, nil
 }
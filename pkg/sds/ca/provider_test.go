@@ -0,0 +1,87 @@
+package ca_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
+	"github.com/kumahq/kuma/pkg/plugins/ca/builtin"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	sds_ca "github.com/kumahq/kuma/pkg/sds/ca"
+)
+
+// staticCaManager is a CA manager that cannot revoke certificates, like the provided CA
+type staticCaManager struct {
+	core_ca.Manager
+	cert core_ca.Cert
+}
+
+func (s *staticCaManager) GetRootCert(context.Context, string, *mesh_proto.CertificateAuthorityBackend) ([]core_ca.Cert, error) {
+	return []core_ca.Cert{s.cert}, nil
+}
+
+var _ = Describe("Mesh CA Provider", func() {
+
+	var provider sds_ca.Provider
+	var resManager manager.ResourceManager
+
+	BeforeEach(func() {
+		memStore := memory.NewStore()
+		resManager = manager.NewResourceManager(memStore)
+		secretManager := secret_manager.NewSecretManager(secret_store.NewSecretStore(memStore), cipher.None(), nil)
+		caManagers := core_ca.Managers{
+			"builtin": builtin.NewBuiltinCaManager(secretManager),
+			"static":  &staticCaManager{cert: []byte("static-cert")},
+		}
+		provider = sds_ca.NewProvider(resManager, caManagers)
+
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+			EnabledBackend: "builtin-1",
+			Backends: []*mesh_proto.CertificateAuthorityBackend{
+				{Name: "builtin-1", Type: "builtin"},
+				{Name: "static-1", Type: "static"},
+			},
+			Revocation: &mesh_proto.Mesh_Mtls_Revocation{
+				Serials: []string{"2a"},
+			},
+		}
+		err := resManager.Create(context.Background(), mesh, core_store.CreateByKey(model.DefaultMesh, model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		err = caManagers["builtin"].Ensure(context.Background(), model.DefaultMesh, mesh.Spec.Mtls.Backends[0])
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should provide CRLs when all CA backends can revoke certificates", func() {
+		// when
+		secret, err := provider.Get(context.Background(), model.DefaultMesh, []string{"builtin-1"})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.PemCerts).To(HaveLen(1))
+		Expect(secret.PemCrls).To(HaveLen(1))
+	})
+
+	It("should not provide CRLs when any CA backend cannot revoke certificates", func() {
+		// when
+		secret, err := provider.Get(context.Background(), model.DefaultMesh, []string{"builtin-1", "static-1"})
+
+		// then certificates of both backends are trusted
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.PemCerts).To(HaveLen(2))
+		Expect(secret.PemCerts[1]).To(Equal([]byte("static-cert")))
+
+		// and CRLs are not provided, otherwise Envoy would reject certificates of the backend without a CRL
+		Expect(secret.PemCrls).To(BeEmpty())
+	})
+})
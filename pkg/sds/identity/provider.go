@@ -21,7 +21,8 @@ type Identity struct {
 }
 
 type Provider interface {
-	Get(ctx context.Context, requestor Identity) (*core_xds.IdentitySecret, error)
+	// Get generates a certificate for the requestor issued by the given CA backend of the Mesh
+	Get(ctx context.Context, requestor Identity, backendName string) (*core_xds.IdentitySecret, error)
 }
 
 func NewProvider(resourceManager core_manager.ResourceManager, caManagers core_ca.Managers) Provider {
@@ -36,7 +37,7 @@ type identityCertProvider struct {
 	caManagers      core_ca.Managers
 }
 
-func (s *identityCertProvider) Get(ctx context.Context, requestor Identity) (*core_xds.IdentitySecret, error) {
+func (s *identityCertProvider) Get(ctx context.Context, requestor Identity, backendName string) (*core_xds.IdentitySecret, error) {
 	meshName := requestor.Mesh
 
 	meshRes := core_mesh.NewMeshResource()
@@ -44,9 +45,9 @@ func (s *identityCertProvider) Get(ctx context.Context, requestor Identity) (*co
 		return nil, errors.Wrapf(err, "failed to find a Mesh %q", meshName)
 	}
 
-	backend := meshRes.GetEnabledCertificateAuthorityBackend()
+	backend := meshRes.GetCertificateAuthorityBackend(backendName)
 	if backend == nil {
-		return nil, errors.Errorf("CA default backend in mesh %q has to be defined", meshName)
+		return nil, errors.Errorf("CA backend %q in mesh %q has to be defined", backendName, meshName)
 	}
 
 	caManager, exist := s.caManagers[backend.Type]
@@ -50,13 +50,29 @@ type DataplaneReconciler struct {
 	// This can be kept in memory and not synced between instances of CP because the state of the stream is local to the control plane
 	// When DP reconnects to the CP, snapshot will be regenerated anyways, because the stream is reinitialized.
 	proxySnapshotInfo map[string]snapshotInfo
+
+	// meshCaRotationTTL is how long a stage of the CA rotation of a Mesh is reused by its Dataplanes before it is computed again.
+	// Computing it requires listing DataplaneInsights of all Dataplanes in the Mesh, which should not be done on every tick of every Dataplane.
+	meshCaRotationTTL  time.Duration
+	meshCaRotationsMux sync.Mutex
+	meshCaRotations    map[string]cachedMeshCaRotation
+}
+
+type cachedMeshCaRotation struct {
+	rotation    meshCaRotation
+	meshVersion string
+	expiration  time.Time
 }
 
 type snapshotInfo struct {
-	tags       mesh_proto.MultiValueTagSet
-	mtls       *mesh_proto.Mesh_Mtls
-	expiration time.Time
-	generation time.Time
+	tags           mesh_proto.MultiValueTagSet
+	mtls           *mesh_proto.Mesh_Mtls
+	revocation     *mesh_proto.Mesh_Mtls_Revocation
+	expiration     time.Time
+	generation     time.Time
+	serial         string
+	rotation       caRotation
+	identitySecret *core_xds.IdentitySecret
 }
 
 func (d *DataplaneReconciler) Reconcile(dataplaneId core_model.ResourceKey) error {
@@ -81,20 +97,28 @@ func (d *DataplaneReconciler) Reconcile(dataplaneId core_model.ResourceKey) erro
 
 	if !mesh.MTLSEnabled() {
 		sdsServerLog.V(1).Info("mTLS for Mesh disabled. Clearing the Snapshot.", "dataplaneId", dataplaneId)
-		if err := d.Cleanup(dataplaneId); err != nil {
-			return errors.Wrap(err, "could not cleanup snapshot")
-		}
-		return nil
+		return d.cleanupWithInsights(proxyID, dataplaneId)
+	}
+
+	if mesh.IsDataplaneRevoked(dataplane.GetMeta().GetName()) {
+		// certificate of the Dataplane is already in the CRL, new certificates cannot be issued
+		sdsServerLog.V(1).Info("Dataplane is revoked. Clearing the Snapshot.", "dataplaneId", dataplaneId)
+		return d.cleanupWithInsights(proxyID, dataplaneId)
+	}
+
+	rotation, err := d.caRotation(mesh, dataplane.GetMeta().GetName())
+	if err != nil {
+		return err
 	}
 
-	generateSnapshot, reason, err := d.shouldGenerateSnapshot(proxyID, mesh, dataplane)
+	generateSnapshot, reason, err := d.shouldGenerateSnapshot(proxyID, mesh, dataplane, rotation)
 	if err != nil {
 		return err
 	}
 
 	if generateSnapshot {
 		sdsServerLog.Info("Generating the Snapshot.", "dataplaneId", dataplaneId, "reason", reason)
-		snapshot, info, err := d.generateSnapshot(dataplane, mesh)
+		snapshot, info, err := d.generateSnapshot(dataplane, mesh, rotation)
 		if err != nil {
 			return err
 		}
@@ -109,6 +133,20 @@ func (d *DataplaneReconciler) Reconcile(dataplaneId core_model.ResourceKey) erro
 			// do not stop updating Envoy even if insights update fails
 			sdsServerLog.Error(err, "Could not update Dataplane Insights", "dataplaneId", dataplaneId)
 		}
+		return nil
+	}
+
+	if info := d.snapshotInfo(proxyID); !proto.Equal(info.revocation, mesh.Spec.Mtls.GetRevocation()) {
+		// the certificate of the Dataplane is still valid, only CRLs of trusted CAs have to be updated
+		sdsServerLog.Info("Updating CRLs of the Snapshot.", "dataplaneId", dataplaneId, "reason", "Revoked certificates have changed")
+		snapshot, info, err := d.updateCrls(dataplane, mesh, info)
+		if err != nil {
+			return err
+		}
+		if err := d.cache.SetSnapshot(proxyID, snapshot); err != nil {
+			return err
+		}
+		d.setSnapshotInfo(proxyID, info)
 	}
 	return nil
 }
@@ -124,18 +162,38 @@ func (d *DataplaneReconciler) Cleanup(dataplaneId core_model.ResourceKey) error
 	return nil
 }
 
-func (d *DataplaneReconciler) shouldGenerateSnapshot(proxyID string, mesh *mesh_helper.MeshResource, dataplane *mesh_helper.DataplaneResource) (bool, string, error) {
+// cleanupWithInsights clears the Snapshot and removes information about CA backends from insights of a Dataplane
+// that no longer receives certificates
+func (d *DataplaneReconciler) cleanupWithInsights(proxyID string, dataplaneId core_model.ResourceKey) error {
+	_, hadSnapshot := d.proxySnapshotInfoExists(proxyID)
+	if err := d.Cleanup(dataplaneId); err != nil {
+		return errors.Wrap(err, "could not cleanup snapshot")
+	}
+	if hadSnapshot {
+		if err := d.clearInsights(dataplaneId); err != nil {
+			sdsServerLog.Error(err, "Could not update Dataplane Insights", "dataplaneId", dataplaneId)
+		}
+	}
+	return nil
+}
+
+func (d *DataplaneReconciler) shouldGenerateSnapshot(proxyID string, mesh *mesh_helper.MeshResource, dataplane *mesh_helper.DataplaneResource, rotation caRotation) (bool, string, error) {
 	_, err := d.cache.GetSnapshot(proxyID)
 	if err != nil {
 		return true, "Snapshot does not exist", nil
 	}
 	info := d.snapshotInfo(proxyID)
-	if !proto.Equal(info.mtls, mesh.Spec.Mtls) {
+	// change of revoked certificates does not require a new certificate, CRLs are updated separately
+	if !proto.Equal(withoutRevocation(info.mtls), withoutRevocation(mesh.Spec.Mtls)) {
 		return true, "Mesh mTLS settings has changed", nil
 	}
 	if dataplane.Spec.TagSet().String() != info.tags.String() {
 		return true, "Dataplane tags have changed", nil
 	}
+	if !info.rotation.equal(rotation) {
+		reason := fmt.Sprintf("mTLS CA rotation has progressed. Certificate issued by %q, trusted CA backends %q", rotation.issuedBackend, rotation.supportedBackends)
+		return true, reason, nil
+	}
 
 	// generate snapshot if cert expired
 	lifetime := info.expiration.Sub(info.generation)
@@ -147,9 +205,15 @@ func (d *DataplaneReconciler) shouldGenerateSnapshot(proxyID string, mesh *mesh_
 }
 
 func (d *DataplaneReconciler) snapshotInfo(proxyID string) snapshotInfo {
+	info, _ := d.proxySnapshotInfoExists(proxyID)
+	return info
+}
+
+func (d *DataplaneReconciler) proxySnapshotInfoExists(proxyID string) (snapshotInfo, bool) {
 	d.RLock()
 	defer d.RUnlock()
-	return d.proxySnapshotInfo[proxyID]
+	info, exists := d.proxySnapshotInfo[proxyID]
+	return info, exists
 }
 
 func (d *DataplaneReconciler) setSnapshotInfo(proxyID string, info snapshotInfo) {
@@ -158,17 +222,62 @@ func (d *DataplaneReconciler) setSnapshotInfo(proxyID string, info snapshotInfo)
 	d.proxySnapshotInfo[proxyID] = info
 }
 
-func (d *DataplaneReconciler) generateSnapshot(dataplane *mesh_core.DataplaneResource, mesh *mesh_core.MeshResource) (envoy_cache.Snapshot, snapshotInfo, error) {
+// caRotation returns the desired state of mTLS certificates of the Dataplane of a given name
+func (d *DataplaneReconciler) caRotation(mesh *mesh_core.MeshResource, dataplaneName string) (caRotation, error) {
+	if len(mesh.Spec.GetMtls().GetBackends()) == 1 {
+		// insights of Dataplanes with certificates issued by backends that no longer exist are ignored,
+		// so there is no rotation in progress and the insights do not have to be listed
+		return computeMeshCaRotation(mesh, nil).forDataplane(dataplaneName), nil
+	}
+	rotation, err := d.meshCaRotation(mesh)
+	if err != nil {
+		return caRotation{}, err
+	}
+	return rotation.forDataplane(dataplaneName), nil
+}
+
+// meshCaRotation returns a stage of the CA rotation of a Mesh. It is computed once per meshCaRotationTTL
+// and shared by all Dataplanes in the Mesh unless the Mesh changes in the meantime.
+func (d *DataplaneReconciler) meshCaRotation(mesh *mesh_core.MeshResource) (meshCaRotation, error) {
+	d.meshCaRotationsMux.Lock()
+	defer d.meshCaRotationsMux.Unlock()
+
+	now := core.Now()
+	meshName := mesh.GetMeta().GetName()
+	if cached, ok := d.meshCaRotations[meshName]; ok && cached.meshVersion == mesh.GetMeta().GetVersion() && now.Before(cached.expiration) {
+		return cached.rotation, nil
+	}
+
+	insights := &mesh_core.DataplaneInsightResourceList{}
+	if err := d.readOnlyResManager.List(context.Background(), insights, core_store.ListByMesh(meshName)); err != nil {
+		return meshCaRotation{}, errors.Wrap(err, "could not retrieve dataplane insights")
+	}
+	rotation := computeMeshCaRotation(mesh, insights.Items)
+
+	for name, cached := range d.meshCaRotations {
+		if !now.Before(cached.expiration) {
+			delete(d.meshCaRotations, name)
+		}
+	}
+	d.meshCaRotations[meshName] = cachedMeshCaRotation{
+		rotation:    rotation,
+		meshVersion: mesh.GetMeta().GetVersion(),
+		expiration:  now.Add(d.meshCaRotationTTL),
+	}
+	return rotation, nil
+}
+
+func (d *DataplaneReconciler) generateSnapshot(dataplane *mesh_core.DataplaneResource, mesh *mesh_core.MeshResource, rotation caRotation) (envoy_cache.Snapshot, snapshotInfo, error) {
 	requestor := sds_identity.Identity{
 		Services: dataplane.Spec.TagSet(),
 		Mesh:     dataplane.GetMeta().GetMesh(),
 	}
-	identitySecret, err := d.identityProvider.Get(context.Background(), requestor)
+	identitySecret, err := d.identityProvider.Get(context.Background(), requestor, rotation.issuedBackend)
 	if err != nil {
 		return envoy_cache.Snapshot{}, snapshotInfo{}, errors.Wrap(err, "could not get Dataplane cert pair")
 	}
 
-	caSecret, err := d.meshCaProvider.Get(context.Background(), dataplane.GetMeta().GetMesh())
+	caSecret, err := d.meshCaProvider.Get(context.Background(), dataplane.GetMeta().GetMesh(), rotation.supportedBackends)
 	if err != nil {
 		return envoy_cache.Snapshot{}, snapshotInfo{}, errors.Wrap(err, "could not get mesh CA cert")
 	}
@@ -180,27 +289,65 @@ func (d *DataplaneReconciler) generateSnapshot(dataplane *mesh_core.DataplaneRes
 	}
 
 	info := snapshotInfo{
-		tags:       dataplane.Spec.TagSet(),
-		mtls:       mesh.Spec.Mtls,
-		expiration: cert.NotAfter,
-		generation: core.Now(),
+		tags:           dataplane.Spec.TagSet(),
+		mtls:           mesh.Spec.Mtls,
+		revocation:     mesh.Spec.Mtls.GetRevocation(),
+		expiration:     cert.NotAfter,
+		generation:     core.Now(),
+		serial:         cert.SerialNumber.Text(16),
+		rotation:       rotation,
+		identitySecret: identitySecret,
+	}
+	return newSnapshot(identitySecret, caSecret), info, nil
+}
+
+// updateCrls generates a Snapshot with the current CRLs of trusted CAs and the previously generated certificate of the Dataplane
+func (d *DataplaneReconciler) updateCrls(dataplane *mesh_core.DataplaneResource, mesh *mesh_core.MeshResource, info snapshotInfo) (envoy_cache.Snapshot, snapshotInfo, error) {
+	caSecret, err := d.meshCaProvider.Get(context.Background(), dataplane.GetMeta().GetMesh(), info.rotation.supportedBackends)
+	if err != nil {
+		return envoy_cache.Snapshot{}, snapshotInfo{}, errors.Wrap(err, "could not get mesh CA cert")
 	}
+	info.mtls = mesh.Spec.Mtls
+	info.revocation = mesh.Spec.Mtls.GetRevocation()
+	return newSnapshot(info.identitySecret, caSecret), info, nil
+}
 
+func newSnapshot(identitySecret *core_xds.IdentitySecret, caSecret *core_xds.CaSecret) envoy_cache.Snapshot {
 	resources := envoy_cache.SnapshotResources{
 		Secrets: []envoy_types.Resource{
 			envoy_secrets.CreateIdentitySecret(identitySecret),
 			envoy_secrets.CreateCaSecret(caSecret),
 		},
 	}
-	snaphot := envoy_cache.NewSnapshotWithResources(core.NewUUID(), resources)
-	return snaphot, info, nil
+	return envoy_cache.NewSnapshotWithResources(core.NewUUID(), resources)
+}
+
+// withoutRevocation returns mTLS settings without revoked certificates
+func withoutRevocation(mtls *mesh_proto.Mesh_Mtls) *mesh_proto.Mesh_Mtls {
+	if mtls.GetRevocation() == nil {
+		return mtls
+	}
+	clone := proto.Clone(mtls).(*mesh_proto.Mesh_Mtls)
+	clone.Revocation = nil
+	return clone
 }
 
 func (d *DataplaneReconciler) updateInsights(dataplaneId core_model.ResourceKey, info snapshotInfo) error {
 	return core_manager.Upsert(d.resManager, dataplaneId, mesh_core.NewDataplaneInsightResource(), func(resource core_model.Resource) {
 		insight := resource.(*mesh_core.DataplaneInsightResource)
-		if err := insight.Spec.UpdateCert(core.Now(), info.expiration); err != nil {
+		if err := insight.Spec.UpdateCert(core.Now(), info.expiration, info.serial, info.rotation.issuedBackend, info.rotation.supportedBackends); err != nil {
 			sdsServerLog.Error(err, "could not update the certificate", "dataplaneId", dataplaneId)
 		}
 	}, core_manager.WithConflictRetry(d.upsertConfig.ConflictRetryBaseBackoff, d.upsertConfig.ConflictRetryMaxTimes)) // retry because DataplaneInsight could be updated from other parts of the code
 }
+
+// clearInsights removes information about CA backends of a Dataplane, so it does not take part in the CA rotation once mTLS is enabled again
+func (d *DataplaneReconciler) clearInsights(dataplaneId core_model.ResourceKey) error {
+	return core_manager.Upsert(d.resManager, dataplaneId, mesh_core.NewDataplaneInsightResource(), func(resource core_model.Resource) {
+		insight := resource.(*mesh_core.DataplaneInsightResource)
+		if insight.Spec.MTLS != nil {
+			insight.Spec.MTLS.IssuedBackend = ""
+			insight.Spec.MTLS.SupportedBackends = nil
+		}
+	}, core_manager.WithConflictRetry(d.upsertConfig.ConflictRetryBaseBackoff, d.upsertConfig.ConflictRetryMaxTimes))
+}
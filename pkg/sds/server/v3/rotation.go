@@ -0,0 +1,143 @@
+package v3
+
+import (
+	"sort"
+
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+// caRotation is a desired state of mTLS certificates of a single Dataplane.
+//
+// Switching Mesh.mtls.enabledBackend is performed in stages, so that there is no moment in which one Dataplane
+// presents a certificate that another Dataplane does not trust:
+// 1) every Dataplane trusts roots of both the previous and the new CA, certificates are still issued by the previous CA
+// 2) once all Dataplanes trust the new CA, certificates are reissued by the new CA
+// 3) once all Dataplanes have certificates issued by the new CA, the root of the previous CA is dropped
+//
+// The progress of the rotation is derived from DataplaneInsights of online Dataplanes,
+// which is why it does not have to be kept in sync between instances of the Control Plane.
+type caRotation struct {
+	// issuedBackend is a name of the CA backend that should issue the certificate of a Dataplane
+	issuedBackend string
+	// supportedBackends are names of the CA backends whose root certificates should be trusted by a Dataplane
+	supportedBackends []string
+}
+
+func (r caRotation) equal(other caRotation) bool {
+	if r.issuedBackend != other.issuedBackend || len(r.supportedBackends) != len(other.supportedBackends) {
+		return false
+	}
+	for i := range r.supportedBackends {
+		if r.supportedBackends[i] != other.supportedBackends[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// computeCaRotation computes the desired state of mTLS certificates of the Dataplane of a given name
+// based on the Mesh and DataplaneInsights of all Dataplanes in this Mesh.
+func computeCaRotation(mesh *mesh_core.MeshResource, dataplaneName string, insights []*mesh_core.DataplaneInsightResource) caRotation {
+	return computeMeshCaRotation(mesh, insights).forDataplane(dataplaneName)
+}
+
+// meshCaRotation is a stage of the CA rotation of a Mesh shared by all its Dataplanes.
+// It is computed once from DataplaneInsights of all Dataplanes in the Mesh,
+// so that the desired state of every Dataplane can be derived from it without listing insights again.
+type meshCaRotation struct {
+	enabledBackend string
+	// previousBackends are names of the CA backends other than the enabled one that issued certificates of online Dataplanes
+	previousBackends []string
+	// allSupportEnabled is true if every online Dataplane trusts the root certificate of the enabled backend
+	allSupportEnabled bool
+	// trustedPreviousBackend is a name of the previous CA backend trusted by every online Dataplane.
+	// It issues certificates of Dataplanes that have not received a certificate yet until the rotation progresses.
+	trustedPreviousBackend string
+	// issuedBackends are names of the CA backends that issued certificates of online Dataplanes by the name of a Dataplane
+	issuedBackends map[string]string
+}
+
+func computeMeshCaRotation(mesh *mesh_core.MeshResource, insights []*mesh_core.DataplaneInsightResource) meshCaRotation {
+	enabledBackend := mesh.Spec.GetMtls().GetEnabledBackend()
+	existingBackends := map[string]bool{}
+	for _, backend := range mesh.Spec.GetMtls().GetBackends() {
+		existingBackends[backend.Name] = true
+	}
+
+	rotation := meshCaRotation{
+		enabledBackend:    enabledBackend,
+		allSupportEnabled: true,
+		issuedBackends:    map[string]string{},
+	}
+	var online []*mesh_core.DataplaneInsightResource
+	usedBackends := map[string]bool{}
+	for _, insight := range insights {
+		mtls := insight.Spec.GetMTLS()
+		if !insight.Spec.IsOnline() || !existingBackends[mtls.GetIssuedBackend()] {
+			continue
+		}
+		online = append(online, insight)
+		usedBackends[mtls.GetIssuedBackend()] = true
+		if !contains(mtls.GetSupportedBackends(), enabledBackend) {
+			rotation.allSupportEnabled = false
+		}
+		rotation.issuedBackends[insight.GetMeta().GetName()] = mtls.GetIssuedBackend()
+	}
+
+	for backend := range usedBackends {
+		if backend != enabledBackend {
+			rotation.previousBackends = append(rotation.previousBackends, backend)
+		}
+	}
+	sort.Strings(rotation.previousBackends)
+
+	if !rotation.allSupportEnabled {
+		for _, backend := range rotation.previousBackends {
+			trusted := true
+			for _, insight := range online {
+				if !contains(insight.Spec.GetMTLS().GetSupportedBackends(), backend) {
+					trusted = false
+					break
+				}
+			}
+			if trusted {
+				rotation.trustedPreviousBackend = backend
+				break
+			}
+		}
+		if rotation.trustedPreviousBackend == "" && len(rotation.previousBackends) > 0 {
+			// no backend is trusted by every online Dataplane, which can happen when the enabled backend is switched
+			// again before the previous rotation is finished. Pick one that is trusted by some of them.
+			rotation.trustedPreviousBackend = rotation.previousBackends[0]
+		}
+	}
+	return rotation
+}
+
+// forDataplane computes the desired state of mTLS certificates of the Dataplane of a given name.
+func (r meshCaRotation) forDataplane(dataplaneName string) caRotation {
+	rotation := caRotation{
+		issuedBackend:     r.enabledBackend,
+		supportedBackends: []string{r.enabledBackend},
+	}
+	if !r.allSupportEnabled {
+		// not every Dataplane trusts the enabled backend yet, so a certificate still has to be issued by a previous backend,
+		// including a Dataplane that has just connected and does not have a certificate yet
+		if current := r.issuedBackends[dataplaneName]; current != "" {
+			rotation.issuedBackend = current
+		} else if r.trustedPreviousBackend != "" {
+			rotation.issuedBackend = r.trustedPreviousBackend
+		}
+	}
+	rotation.supportedBackends = append(rotation.supportedBackends, r.previousBackends...)
+	return rotation
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package v3
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+var _ = Describe("computeCaRotation()", func() {
+
+	mesh := &mesh_core.MeshResource{
+		Meta: &test_model.ResourceMeta{Name: "default"},
+		Spec: &mesh_proto.Mesh{
+			Mtls: &mesh_proto.Mesh_Mtls{
+				EnabledBackend: "ca-2",
+				Backends: []*mesh_proto.CertificateAuthorityBackend{
+					{Name: "ca-1", Type: "builtin"},
+					{Name: "ca-2", Type: "builtin"},
+				},
+			},
+		},
+	}
+
+	type insight struct {
+		name      string
+		online    bool
+		issued    string
+		supported []string
+	}
+
+	type testCase struct {
+		insights []insight
+		expected caRotation
+	}
+
+	DescribeTable("should compute the stage of the CA rotation",
+		func(given testCase) {
+			// given
+			var insights []*mesh_core.DataplaneInsightResource
+			for _, i := range given.insights {
+				subscription := &mesh_proto.DiscoverySubscription{
+					ConnectTime: ptypes.TimestampNow(),
+				}
+				if !i.online {
+					subscription.DisconnectTime = ptypes.TimestampNow()
+				}
+				insights = append(insights, &mesh_core.DataplaneInsightResource{
+					Meta: &test_model.ResourceMeta{Name: i.name, Mesh: "default"},
+					Spec: &mesh_proto.DataplaneInsight{
+						Subscriptions: []*mesh_proto.DiscoverySubscription{subscription},
+						MTLS: &mesh_proto.DataplaneInsight_MTLS{
+							CertificateExpirationTime: ptypes.TimestampNow(),
+							IssuedBackend:             i.issued,
+							SupportedBackends:         i.supported,
+						},
+					},
+				})
+			}
+
+			// when
+			rotation := computeCaRotation(mesh, "dp-1", insights)
+
+			// then
+			Expect(rotation).To(Equal(given.expected))
+		},
+		Entry("no insights", testCase{
+			expected: caRotation{
+				issuedBackend:     "ca-2",
+				supportedBackends: []string{"ca-2"},
+			},
+		}),
+		Entry("all dataplanes are issued by the enabled backend", testCase{
+			insights: []insight{
+				{name: "dp-1", online: true, issued: "ca-2", supported: []string{"ca-2"}},
+				{name: "dp-2", online: true, issued: "ca-2", supported: []string{"ca-2"}},
+			},
+			expected: caRotation{
+				issuedBackend:     "ca-2",
+				supportedBackends: []string{"ca-2"},
+			},
+		}),
+		Entry("enabled backend has just changed", testCase{
+			insights: []insight{
+				{name: "dp-1", online: true, issued: "ca-1", supported: []string{"ca-1"}},
+				{name: "dp-2", online: true, issued: "ca-1", supported: []string{"ca-1"}},
+			},
+			expected: caRotation{
+				issuedBackend:     "ca-1",
+				supportedBackends: []string{"ca-2", "ca-1"},
+			},
+		}),
+		Entry("not every dataplane trusts the enabled backend yet", testCase{
+			insights: []insight{
+				{name: "dp-1", online: true, issued: "ca-1", supported: []string{"ca-2", "ca-1"}},
+				{name: "dp-2", online: true, issued: "ca-1", supported: []string{"ca-1"}},
+			},
+			expected: caRotation{
+				issuedBackend:     "ca-1",
+				supportedBackends: []string{"ca-2", "ca-1"},
+			},
+		}),
+		Entry("every dataplane trusts the enabled backend", testCase{
+			insights: []insight{
+				{name: "dp-1", online: true, issued: "ca-1", supported: []string{"ca-2", "ca-1"}},
+				{name: "dp-2", online: true, issued: "ca-1", supported: []string{"ca-2", "ca-1"}},
+			},
+			expected: caRotation{
+				issuedBackend:     "ca-2",
+				supportedBackends: []string{"ca-2", "ca-1"},
+			},
+		}),
+		Entry("every dataplane is issued by the enabled backend", testCase{
+			insights: []insight{
+				{name: "dp-1", online: true, issued: "ca-2", supported: []string{"ca-2", "ca-1"}},
+				{name: "dp-2", online: true, issued: "ca-2", supported: []string{"ca-2", "ca-1"}},
+			},
+			expected: caRotation{
+				issuedBackend:     "ca-2",
+				supportedBackends: []string{"ca-2"},
+			},
+		}),
+		Entry("new dataplane is issued by the previous backend until every dataplane trusts the enabled backend", testCase{
+			insights: []insight{
+				{name: "dp-2", online: true, issued: "ca-1", supported: []string{"ca-2", "ca-1"}},
+				{name: "dp-3", online: true, issued: "ca-1", supported: []string{"ca-1"}},
+			},
+			expected: caRotation{
+				issuedBackend:     "ca-1",
+				supportedBackends: []string{"ca-2", "ca-1"},
+			},
+		}),
+		Entry("new dataplane is issued by the enabled backend once every dataplane trusts it", testCase{
+			insights: []insight{
+				{name: "dp-2", online: true, issued: "ca-1", supported: []string{"ca-2", "ca-1"}},
+				{name: "dp-3", online: true, issued: "ca-1", supported: []string{"ca-2", "ca-1"}},
+			},
+			expected: caRotation{
+				issuedBackend:     "ca-2",
+				supportedBackends: []string{"ca-2", "ca-1"},
+			},
+		}),
+		Entry("offline dataplanes do not take part in the rotation", testCase{
+			insights: []insight{
+				{name: "dp-1", online: true, issued: "ca-2", supported: []string{"ca-2", "ca-1"}},
+				{name: "dp-2", online: false, issued: "ca-1", supported: []string{"ca-1"}},
+			},
+			expected: caRotation{
+				issuedBackend:     "ca-2",
+				supportedBackends: []string{"ca-2"},
+			},
+		}),
+		Entry("removed backends are not trusted", testCase{
+			insights: []insight{
+				{name: "dp-1", online: true, issued: "ca-0", supported: []string{"ca-0"}},
+			},
+			expected: caRotation{
+				issuedBackend:     "ca-2",
+				supportedBackends: []string{"ca-2"},
+			},
+		}),
+	)
+})
+
+var _ = Describe("caRotation", func() {
+	It("should compare rotations", func() {
+		rotation := caRotation{issuedBackend: "ca-1", supportedBackends: []string{"ca-2", "ca-1"}}
+		Expect(rotation.equal(caRotation{issuedBackend: "ca-1", supportedBackends: []string{"ca-2", "ca-1"}})).To(BeTrue())
+		Expect(rotation.equal(caRotation{issuedBackend: "ca-2", supportedBackends: []string{"ca-2", "ca-1"}})).To(BeFalse())
+		Expect(rotation.equal(caRotation{issuedBackend: "ca-1", supportedBackends: []string{"ca-2"}})).To(BeFalse())
+	})
+})
@@ -52,6 +52,8 @@ func RegisterSDS(rt core_runtime.Runtime, sdsMetrics *sds_metrics.Metrics) error
 		upsertConfig:       rt.Config().Store.Upsert,
 		sdsMetrics:         sdsMetrics,
 		proxySnapshotInfo:  map[string]snapshotInfo{},
+		meshCaRotationTTL:  rt.Config().SdsServer.DataplaneConfigurationRefreshInterval,
+		meshCaRotations:    map[string]cachedMeshCaRotation{},
 	}
 
 	syncTracker, err := syncTracker(&reconciler, rt.Config().SdsServer.DataplaneConfigurationRefreshInterval, sdsMetrics)
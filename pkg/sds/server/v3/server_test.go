@@ -2,11 +2,14 @@ package v3_test
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"path/filepath"
 	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 	prometheus_client "github.com/prometheus/client_model/go"
 
@@ -14,6 +17,7 @@ import (
 	"github.com/kumahq/kuma/pkg/xds/envoy/tls"
 
 	envoy_api_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	envoy_service_secret "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
 	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
@@ -126,12 +130,12 @@ var _ = Describe("SDS Server", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// retrieve example DP token
-		tokenIssuer, err := tokens_builtin.NewDataplaneTokenIssuer(runtime.ReadOnlyResourceManager())
+		tokenIssuer, err := tokens_builtin.NewDataplaneTokenIssuer(runtime.ReadOnlyResourceManager(), tokens_issuer.SigningMethodHS256)
 		Expect(err).ToNot(HaveOccurred())
 		dpCredential, err = tokenIssuer.Generate(tokens_issuer.DataplaneIdentity{
 			Name: dpRes.GetMeta().GetName(),
 			Mesh: dpRes.GetMeta().GetMesh(),
-		})
+		}, 0)
 		Expect(err).ToNot(HaveOccurred())
 
 		// start the runtime
@@ -280,8 +284,6 @@ var _ = Describe("SDS Server", func() {
 			// when
 			meshRes := mesh_core.NewMeshResource()
 			Expect(resManager.Get(context.Background(), meshRes, core_store.GetByKey(model.DefaultMesh, model.NoMesh))).To(Succeed())
-			meshRes.Spec.Mtls.EnabledBackend = "" // we need to first disable mTLS
-			Expect(resManager.Update(context.Background(), meshRes)).To(Succeed())
 			meshRes.Spec.Mtls.EnabledBackend = "ca-2"
 			Expect(resManager.Update(context.Background(), meshRes)).To(Succeed())
 
@@ -355,6 +357,60 @@ var _ = Describe("SDS Server", func() {
 			Expect(firstExchangeResponse.Resources).ToNot(Equal(resp.Resources))
 
 		}))
+
+		It("should return CRL when certificate is revoked", test.Within(time.Minute, func() {
+			// given serial of the issued certificate
+			dpInsight := mesh_core.NewDataplaneInsightResource()
+			Eventually(func() (string, error) {
+				err := resManager.Get(context.Background(), dpInsight, core_store.GetByKey("backend-01", "default"))
+				return dpInsight.Spec.GetMTLS().GetCertificateSerial(), err
+			}, "30s", "1s").ShouldNot(BeEmpty())
+			serial := dpInsight.Spec.MTLS.CertificateSerial
+
+			// when
+			meshRes := mesh_core.NewMeshResource()
+			Expect(resManager.Get(context.Background(), meshRes, core_store.GetByKey(model.DefaultMesh, model.NoMesh))).To(Succeed())
+			meshRes.Spec.Mtls.Revocation = &mesh_proto.Mesh_Mtls_Revocation{
+				Serials: []string{serial},
+			}
+			Expect(resManager.Update(context.Background(), meshRes)).To(Succeed())
+			defer func() {
+				Expect(resManager.Get(context.Background(), meshRes, core_store.GetByKey(model.DefaultMesh, model.NoMesh))).To(Succeed())
+				meshRes.Spec.Mtls.Revocation = nil
+				Expect(resManager.Update(context.Background(), meshRes)).To(Succeed())
+			}()
+
+			// and when send a request with version previously fetched
+			req := newRequestForSecrets()
+			req.VersionInfo = firstExchangeResponse.VersionInfo
+			req.ResponseNonce = firstExchangeResponse.Nonce
+			err := stream.Send(&req)
+			Expect(err).ToNot(HaveOccurred())
+			resp, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+
+			// then CA secret contains CRL with the revoked serial
+			secrets := map[string]*envoy_auth.Secret{}
+			for _, res := range resp.Resources {
+				secret := &envoy_auth.Secret{}
+				Expect(ptypes.UnmarshalAny(res, secret)).To(Succeed())
+				secrets[secret.Name] = secret
+			}
+			block, _ := pem.Decode(secrets[tls.MeshCaResource].GetValidationContext().GetCrl().GetInlineBytes())
+			Expect(block).ToNot(BeNil())
+			crl, err := x509.ParseCRL(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+			Expect(crl.TBSCertList.RevokedCertificates[0].SerialNumber.Text(16)).To(Equal(serial))
+
+			// and the certificate of the Dataplane is not regenerated
+			identityCert := secrets[tls.IdentityCertResource].GetTlsCertificate().GetCertificateChain().GetInlineBytes()
+			block, _ = pem.Decode(identityCert)
+			Expect(block).ToNot(BeNil())
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cert.SerialNumber.Text(16)).To(Equal(serial))
+		}))
 	})
 
 	It("should not return certs when DP is not authorized", test.Within(time.Minute, func() {
@@ -4,6 +4,7 @@ import (
 	"net"
 
 	"github.com/kumahq/kuma/pkg/api-server/customization"
+	"github.com/kumahq/kuma/pkg/audit"
 	"github.com/kumahq/kuma/pkg/dp-server/server"
 	kds_context "github.com/kumahq/kuma/pkg/kds/context"
 	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
@@ -79,6 +80,7 @@ func BuilderFor(cfg kuma_cp.Config) (*core_runtime.Builder, error) {
 	builder.WithXDSHooks(&xds_hooks.Hooks{})
 	builder.WithDpServer(server.NewDpServer(*cfg.DpServer, metrics))
 	builder.WithKDSContext(kds_context.DefaultContext(builder.ResourceManager(), cfg.Multizone.Zone.Name))
+	builder.WithAuditLog(audit.NewLog(cfg.Audit.BufferSize, nil))
 
 	_ = initializeConfigManager(cfg, builder)
 	_ = initializeDNSResolver(cfg, builder)
@@ -104,7 +106,7 @@ func newResourceManager(builder *core_runtime.Builder) core_manager.Customizable
 	validator := mesh_managers.MeshValidator{
 		CaManagers: builder.CaManagers(),
 	}
-	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), validator)
+	meshManager := mesh_managers.NewMeshManager(builder.ResourceStore(), customizableManager, builder.CaManagers(), registry.Global(), validator, builder.Config().DpServer.Auth.DpTokenSigningMethod)
 	customManagers[core_mesh.MeshType] = meshManager
 
 	secretManager := secret_manager.NewSecretManager(builder.SecretStore(), secret_cipher.None(), nil)
@@ -0,0 +1,64 @@
+package cipher
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+)
+
+// NewGrpcPluginKeyProviderServer serves the gRPC transport of the key provider plugin protocol on top of the given KeyProvider.
+// It is a reference implementation of the protocol that can be used as a local stand-in of a KMS.
+func NewGrpcPluginKeyProviderServer(provider cipher.KeyProvider, token string) system_proto.KeyProviderPluginServiceServer {
+	return &grpcPluginKeyProviderServer{
+		provider: provider,
+		token:    token,
+	}
+}
+
+type grpcPluginKeyProviderServer struct {
+	provider cipher.KeyProvider
+	token    string
+}
+
+var _ system_proto.KeyProviderPluginServiceServer = &grpcPluginKeyProviderServer{}
+
+func (s *grpcPluginKeyProviderServer) WrapKey(ctx context.Context, req *system_proto.WrapKeyRequest) (*system_proto.WrapKeyResponse, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	ciphertext, err := s.provider.WrapKey(req.GetPlaintext())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &system_proto.WrapKeyResponse{Ciphertext: ciphertext}, nil
+}
+
+func (s *grpcPluginKeyProviderServer) UnwrapKey(ctx context.Context, req *system_proto.UnwrapKeyRequest) (*system_proto.UnwrapKeyResponse, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	plaintext, err := s.provider.UnwrapKey(req.GetCiphertext())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &system_proto.UnwrapKeyResponse{Plaintext: plaintext}, nil
+}
+
+func (s *grpcPluginKeyProviderServer) authenticate(ctx context.Context) error {
+	if s.token == "" {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if subtle.ConstantTimeCompare([]byte(value), []byte("Bearer "+s.token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "unauthorized")
+}
@@ -0,0 +1,69 @@
+package cipher
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+)
+
+// NewPluginKeyProviderHandler serves the key provider plugin protocol on top of the given KeyProvider.
+// It is a reference implementation of the protocol that can be used as a local stand-in of a KMS.
+func NewPluginKeyProviderHandler(provider cipher.KeyProvider, token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(cipher.PluginWrapPath, pluginHandler(token, func(body []byte) (interface{}, error) {
+		req := cipher.PluginWrapRequest{}
+		if err := json.Unmarshal(body, &req); err != nil {
+			return nil, err
+		}
+		ciphertext, err := provider.WrapKey(req.Plaintext)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.PluginWrapResponse{Ciphertext: ciphertext}, nil
+	}))
+	mux.HandleFunc(cipher.PluginUnwrapPath, pluginHandler(token, func(body []byte) (interface{}, error) {
+		req := cipher.PluginUnwrapRequest{}
+		if err := json.Unmarshal(body, &req); err != nil {
+			return nil, err
+		}
+		plaintext, err := provider.UnwrapKey(req.Ciphertext)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.PluginUnwrapResponse{Plaintext: plaintext}, nil
+	}))
+	return mux
+}
+
+func pluginHandler(token string, fn func([]byte) (interface{}, error)) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost {
+			writePluginResponse(writer, http.StatusMethodNotAllowed, cipher.PluginErrorResponse{Error: "method not allowed"})
+			return
+		}
+		if token != "" && subtle.ConstantTimeCompare([]byte(request.Header.Get("Authorization")), []byte("Bearer "+token)) != 1 {
+			writePluginResponse(writer, http.StatusUnauthorized, cipher.PluginErrorResponse{Error: "unauthorized"})
+			return
+		}
+		body, err := ioutil.ReadAll(request.Body)
+		if err != nil {
+			writePluginResponse(writer, http.StatusBadRequest, cipher.PluginErrorResponse{Error: err.Error()})
+			return
+		}
+		res, err := fn(body)
+		if err != nil {
+			writePluginResponse(writer, http.StatusBadRequest, cipher.PluginErrorResponse{Error: err.Error()})
+			return
+		}
+		writePluginResponse(writer, http.StatusOK, res)
+	}
+}
+
+func writePluginResponse(writer http.ResponseWriter, status int, res interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(res)
+}
@@ -153,6 +153,36 @@ func ExecuteStoreTests(
 			}
 		})
 
+		It("should update labels of the resource", func() {
+			// given
+			name := "labels-to-be-updated.demo"
+			resource := sample_model.TrafficRouteResource{
+				Spec: &sample_proto.TrafficRoute{Path: "demo"},
+			}
+			err := s.Create(context.Background(), &resource, store.CreateByKey(name, mesh), store.CreatedAt(time.Now()),
+				store.CreateWithLabels(map[string]string{"team": "payments"}))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when labels are not passed
+			resource.Spec.Path = "new-path"
+			err = s.Update(context.Background(), &resource, store.ModifiedAt(time.Now()))
+
+			// then labels are kept
+			Expect(err).ToNot(HaveOccurred())
+			res := sample_model.NewTrafficRouteResource()
+			Expect(s.Get(context.Background(), res, store.GetByKey(name, mesh))).To(Succeed())
+			Expect(res.Meta.GetLabels()).To(HaveKeyWithValue("team", "payments"))
+
+			// when labels are passed
+			err = s.Update(context.Background(), res, store.ModifiedAt(time.Now()), store.UpdateWithLabels(map[string]string{"team": "orders"}))
+
+			// then labels are replaced
+			Expect(err).ToNot(HaveOccurred())
+			res = sample_model.NewTrafficRouteResource()
+			Expect(s.Get(context.Background(), res, store.GetByKey(name, mesh))).To(Succeed())
+			Expect(res.Meta.GetLabels()).To(HaveKeyWithValue("team", "orders"))
+		})
+
 		// todo(jakubdyszkiewicz) write tests for optimistic locking
 	})
 
@@ -335,6 +365,77 @@ func ExecuteStoreTests(
 			Expect(list.Items).To(HaveLen(0))
 		})
 
+		Describe("Filtering", func() {
+			createResourceWithLabels := func(name string, labels map[string]string) {
+				res := sample_model.TrafficRouteResource{
+					Spec: &sample_proto.TrafficRoute{Path: "demo"},
+				}
+				err := s.Create(context.Background(), &res, store.CreateByKey(name, mesh), store.CreatedAt(time.Now()), store.CreateWithLabels(labels))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			BeforeEach(func() {
+				createResourceWithLabels("payments-1.demo", map[string]string{"team": "payments", "env": "prod"})
+				createResourceWithLabels("payments-2.demo", map[string]string{"team": "payments", "env": "dev"})
+				createResourceWithLabels("orders-1.demo", map[string]string{"team": "orders", "env": "prod"})
+				createResourceWithLabels("unlabeled.demo", nil)
+			})
+
+			names := func(list *sample_model.TrafficRouteResourceList) []string {
+				var names []string
+				for _, item := range list.Items {
+					names = append(names, item.Meta.GetName())
+				}
+				return names
+			}
+
+			It("should list resources by labels", func() {
+				// when
+				list := sample_model.TrafficRouteResourceList{}
+				err := s.List(context.Background(), &list, store.ListByMesh(mesh), store.ListByLabels(map[string]string{"team": "payments"}))
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(list.Pagination.Total).To(Equal(uint32(2)))
+				Expect(names(&list)).To(ConsistOf("payments-1.demo", "payments-2.demo"))
+				for _, item := range list.Items {
+					Expect(item.Meta.GetLabels()).To(HaveKeyWithValue("team", "payments"))
+				}
+			})
+
+			It("should list resources matching all of the labels", func() {
+				// when
+				list := sample_model.TrafficRouteResourceList{}
+				err := s.List(context.Background(), &list, store.ListByLabels(map[string]string{"team": "payments", "env": "prod"}))
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(names(&list)).To(ConsistOf("payments-1.demo"))
+			})
+
+			It("should list resources by name", func() {
+				// when
+				list := sample_model.TrafficRouteResourceList{}
+				err := s.List(context.Background(), &list, store.ListByNameContains("-1"))
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(names(&list)).To(ConsistOf("payments-1.demo", "orders-1.demo"))
+			})
+
+			It("should paginate filtered resources", func() {
+				// when
+				list := sample_model.TrafficRouteResourceList{}
+				err := s.List(context.Background(), &list, store.ListByLabels(map[string]string{"env": "prod"}), store.ListByPage(1, ""))
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(list.Pagination.Total).To(Equal(uint32(2)))
+				Expect(names(&list)).To(Equal([]string{"orders-1.demo"}))
+				Expect(list.Pagination.NextOffset).To(Equal("1"))
+			})
+		})
+
 		Describe("Pagination", func() {
 			It("should list all resources using pagination", func() {
 				// given
@@ -0,0 +1,50 @@
+package tls
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+
+	"github.com/pkg/errors"
+)
+
+type KeyType string
+
+const (
+	RSAKeyType   KeyType = "RSA"
+	ECDSAKeyType KeyType = "ECDSA"
+)
+
+// KeyTypes are all supported types of private keys
+var KeyTypes = []KeyType{RSAKeyType, ECDSAKeyType}
+
+// NewKey generates a private key of a given type.
+// RSA key is used when type is empty, ECDSA keys use the P-256 curve.
+func NewKey(keyType KeyType, rsaBits int) (crypto.Signer, error) {
+	switch keyType {
+	case "", RSAKeyType:
+		if rsaBits == 0 {
+			rsaBits = DefaultRsaBits
+		}
+		return rsa.GenerateKey(rand.Reader, rsaBits)
+	case ECDSAKeyType:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, errors.Errorf("unsupported key type %q", keyType)
+	}
+}
+
+// IsValidKeyType returns true when the key type is empty (default) or one of the supported types
+func IsValidKeyType(keyType KeyType) bool {
+	if keyType == "" {
+		return true
+	}
+	for _, t := range KeyTypes {
+		if t == keyType {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package tls
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -15,7 +16,7 @@ type KeyPair struct {
 }
 
 func ToKeyPair(key interface{}, cert []byte) (*KeyPair, error) {
-	keyPem, err := pemEncodeKey(key)
+	keyPem, err := PemEncodeKey(key)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to PEM encode a private key")
 	}
@@ -29,11 +30,18 @@ func ToKeyPair(key interface{}, cert []byte) (*KeyPair, error) {
 	}, nil
 }
 
-func pemEncodeKey(priv interface{}) ([]byte, error) {
+// PemEncodeKey encodes RSA or ECDSA private key in PEM format
+func PemEncodeKey(priv interface{}) ([]byte, error) {
 	var block *pem.Block
 	switch k := priv.(type) {
 	case *rsa.PrivateKey:
 		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
 	default:
 		return nil, errors.Errorf("unsupported private key type %T", priv)
 	}
@@ -5,8 +5,14 @@ import (
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 )
 
-func NewDataplaneTokenIssuer(resManager manager.ReadOnlyResourceManager) (issuer.DataplaneTokenIssuer, error) {
-	return issuer.NewDataplaneTokenIssuer(func(meshName string) ([]byte, error) {
-		return issuer.GetSigningKey(resManager, issuer.DataplaneTokenPrefix, meshName)
-	}), nil
+func NewDataplaneTokenIssuer(resManager manager.ReadOnlyResourceManager, signingMethod issuer.SigningMethod) (issuer.DataplaneTokenIssuer, error) {
+	return issuer.NewDataplaneTokenIssuer(
+		issuer.NewSigningKeyAccessor(resManager, issuer.DataplaneTokenPrefix),
+		issuer.NewSecretRevocations(resManager),
+		signingMethod,
+	), nil
+}
+
+func NewUserTokenIssuer(resManager manager.ReadOnlyResourceManager) issuer.UserTokenIssuer {
+	return issuer.NewUserTokenIssuer(resManager)
 }
@@ -1,10 +1,14 @@
 package issuer
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/dgrijalva/jwt-go"
 	"github.com/pkg/errors"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
 )
 
 type Token = string
@@ -26,8 +30,11 @@ type DataplaneIdentity struct {
 // DataplaneTokenIssuer issues Dataplane Tokens used then for proving identity of the dataplanes.
 // Issued token can be bound by name, mesh or tags so you can pick your level of security.
 // See pkg/sds/auth/universal/authenticator.go to check algorithm for authentication
+//
+// Every token has a unique ID (jti claim) which can be placed on the revocation list of the mesh to invalidate the token.
+// If validFor is greater than zero, the token expires after this duration, otherwise it is valid until it is revoked.
 type DataplaneTokenIssuer interface {
-	Generate(identity DataplaneIdentity) (Token, error)
+	Generate(identity DataplaneIdentity, validFor time.Duration) (Token, error)
 	Validate(token Token, meshName string) (DataplaneIdentity, error)
 }
 
@@ -39,30 +46,87 @@ type claims struct {
 	jwt.StandardClaims
 }
 
-type SigningKeyAccessor func(meshName string) ([]byte, error)
+// SigningKeyAccessor provides the keys of the Mesh. There can be many signing keys in the Mesh at once,
+// so the key can be rotated without invalidating tokens signed with the previous key.
+type SigningKeyAccessor interface {
+	// GetSigningKey returns the key that is used to sign new tokens.
+	GetSigningKey(meshName string) (SigningKey, error)
+	// GetValidationKey returns the key of the given serial number that is used to validate the token signed with HS256.
+	GetValidationKey(meshName string, serial int) ([]byte, error)
+	// GetPublicKey returns PEM encoded public key of the given serial number that is used to validate the token signed with RS256 or ES256.
+	GetPublicKey(meshName string, serial int) ([]byte, error)
+}
+
+const keyIDHeader = "kid"
 
-func NewDataplaneTokenIssuer(signingKeyAccessor SigningKeyAccessor) DataplaneTokenIssuer {
-	return &jwtTokenIssuer{signingKeyAccessor}
+// NewDataplaneTokenIssuer creates an issuer that signs tokens with the given signing method.
+// Tokens signed with any of the supported methods are accepted as long as the Control Plane has the key to validate them.
+func NewDataplaneTokenIssuer(signingKeyAccessor SigningKeyAccessor, revocations Revocations, signingMethod SigningMethod) DataplaneTokenIssuer {
+	return &jwtTokenIssuer{
+		signingKeyAccessor: signingKeyAccessor,
+		revocations:        revocations,
+		signingMethod:      signingMethod,
+	}
 }
 
 var _ DataplaneTokenIssuer = &jwtTokenIssuer{}
 
 type jwtTokenIssuer struct {
 	signingKeyAccessor SigningKeyAccessor
+	revocations        Revocations
+	signingMethod      SigningMethod
 }
 
-func (i *jwtTokenIssuer) signingKey(meshName string) ([]byte, error) {
-	signingKey, err := i.signingKeyAccessor(meshName)
+func (i *jwtTokenIssuer) signingKey(meshName string) (SigningKey, error) {
+	signingKey, err := i.signingKeyAccessor.GetSigningKey(meshName)
 	if err != nil {
-		return nil, err
+		return SigningKey{}, err
 	}
-	if len(signingKey) == 0 {
-		return nil, SigningKeyNotFound(meshName)
+	if len(signingKey.Key) == 0 {
+		return SigningKey{}, SigningKeyNotFound(meshName)
 	}
 	return signingKey, nil
 }
 
-func (i *jwtTokenIssuer) Generate(identity DataplaneIdentity) (Token, error) {
+func (i *jwtTokenIssuer) validationKey(meshName string, token *jwt.Token) (interface{}, error) {
+	serial := 0
+	if kid, ok := token.Header[keyIDHeader]; ok {
+		kidStr, ok := kid.(string)
+		if !ok {
+			return nil, errors.New("kid header has to be a string")
+		}
+		s, err := strconv.Atoi(kidStr)
+		if err != nil {
+			return nil, errors.Wrap(err, "kid header has to be a serial number of the signing key")
+		}
+		serial = s
+	}
+	// the key is picked by the algorithm of the token, so the public key is never used as HMAC secret
+	switch token.Method {
+	case jwt.SigningMethodHS256:
+		key, err := i.signingKeyAccessor.GetValidationKey(meshName, serial)
+		if err != nil && !IsSigningKeyNotFoundErr(err) {
+			return nil, err
+		}
+		if len(key) == 0 {
+			return nil, errors.Errorf("there is no Signing Key with serial number %d for Mesh %q. The key might have been retired", serial, meshName)
+		}
+		return key, nil
+	case jwt.SigningMethodRS256, jwt.SigningMethodES256:
+		key, err := i.signingKeyAccessor.GetPublicKey(meshName, serial)
+		if err != nil && !IsSigningKeyNotFoundErr(err) {
+			return nil, err
+		}
+		if len(key) == 0 {
+			return nil, errors.Errorf("there is no Public Key with serial number %d for Mesh %q. The key might have been retired", serial, meshName)
+		}
+		return validationPublicKeyFor(token.Method, key)
+	default:
+		return nil, errors.Errorf("unsupported signing method %q", token.Method.Alg())
+	}
+}
+
+func (i *jwtTokenIssuer) Generate(identity DataplaneIdentity, validFor time.Duration) (Token, error) {
 	signingKey, err := i.signingKey(identity.Mesh)
 	if err != nil {
 		return "", err
@@ -73,16 +137,34 @@ func (i *jwtTokenIssuer) Generate(identity DataplaneIdentity) (Token, error) {
 		tags[tagName] = identity.Tags.Values(tagName)
 	}
 
+	now := core.Now()
 	c := claims{
-		Name:           identity.Name,
-		Mesh:           identity.Mesh,
-		Tags:           tags,
-		Type:           identity.Type,
-		StandardClaims: jwt.StandardClaims{},
+		Name: identity.Name,
+		Mesh: identity.Mesh,
+		Tags: tags,
+		Type: identity.Type,
+		StandardClaims: jwt.StandardClaims{
+			Id:       core.NewUUID(),
+			IssuedAt: now.Unix(),
+		},
+	}
+	if validFor > 0 {
+		c.ExpiresAt = now.Add(validFor).Unix()
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
-	tokenString, err := token.SignedString(signingKey)
+	method, err := signingMethodOf(i.signingMethod)
+	if err != nil {
+		return "", err
+	}
+	key, err := signingKeyFor(i.signingMethod, signingKey.Key)
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(method, c)
+	if signingKey.Serial != 0 {
+		token.Header[keyIDHeader] = strconv.Itoa(signingKey.Serial)
+	}
+	tokenString, err := token.SignedString(key)
 	if err != nil {
 		return "", errors.Wrap(err, "could not sign a token")
 	}
@@ -90,15 +172,10 @@ func (i *jwtTokenIssuer) Generate(identity DataplaneIdentity) (Token, error) {
 }
 
 func (i *jwtTokenIssuer) Validate(rawToken Token, meshName string) (DataplaneIdentity, error) {
-	signingKey, err := i.signingKey(meshName)
-	if err != nil {
-		return DataplaneIdentity{}, err
-	}
-
 	c := &claims{}
 
-	token, err := jwt.ParseWithClaims(rawToken, c, func(*jwt.Token) (interface{}, error) {
-		return signingKey, nil
+	token, err := jwt.ParseWithClaims(rawToken, c, func(token *jwt.Token) (interface{}, error) {
+		return i.validationKey(meshName, token)
 	})
 	if err != nil {
 		return DataplaneIdentity{}, errors.Wrap(err, "could not parse token")
@@ -107,6 +184,14 @@ func (i *jwtTokenIssuer) Validate(rawToken Token, meshName string) (DataplaneIde
 		return DataplaneIdentity{}, errors.New("token is not valid")
 	}
 
+	revoked, err := i.revocations.IsRevoked(meshName, c.Id)
+	if err != nil {
+		return DataplaneIdentity{}, errors.Wrap(err, "could not check if the token is revoked")
+	}
+	if revoked {
+		return DataplaneIdentity{}, errors.New("token is revoked")
+	}
+
 	id := DataplaneIdentity{
 		Mesh: c.Mesh,
 		Name: c.Name,
@@ -0,0 +1,79 @@
+package issuer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+const DataplaneTokenRevocationsPrefix = "dataplane-token-revocations"
+
+// Revocations checks whether a token of the given ID was revoked in the Mesh.
+type Revocations interface {
+	IsRevoked(meshName string, tokenID string) (bool, error)
+}
+
+func RevocationsResourceKey(meshName string) model.ResourceKey {
+	return model.ResourceKey{
+		Mesh: meshName,
+		Name: fmt.Sprintf("%s-%s", DataplaneTokenRevocationsPrefix, meshName),
+	}
+}
+
+// NewSecretRevocations returns Revocations backed by a Secret in the Mesh.
+// The data of the Secret is a list of revoked token IDs separated by comma or new line.
+// Secret does not have to exist, then none of the tokens are revoked.
+func NewSecretRevocations(manager manager.ReadOnlyResourceManager) Revocations {
+	return &secretRevocations{
+		manager: manager,
+	}
+}
+
+type secretRevocations struct {
+	manager manager.ReadOnlyResourceManager
+}
+
+var _ Revocations = &secretRevocations{}
+
+func (s *secretRevocations) IsRevoked(meshName string, tokenID string) (bool, error) {
+	if tokenID == "" {
+		return false, nil
+	}
+	secret := system.NewSecretResource()
+	if err := s.manager.Get(context.Background(), secret, store.GetBy(RevocationsResourceKey(meshName))); err != nil {
+		if store.IsResourceNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "could not retrieve token revocations")
+	}
+	return ContainsRevokedID(secret.Spec.GetData().GetValue(), tokenID), nil
+}
+
+// ContainsRevokedID checks if token ID is on the revocation list in the format of the revocations Secret.
+func ContainsRevokedID(revocations []byte, tokenID string) bool {
+	ids := strings.FieldsFunc(string(revocations), func(r rune) bool {
+		return r == ',' || r == '\n'
+	})
+	for _, id := range ids {
+		if strings.TrimSpace(id) == tokenID {
+			return true
+		}
+	}
+	return false
+}
+
+// NoRevocations can be used when the token revocation is not supported.
+var NoRevocations Revocations = noRevocations{}
+
+type noRevocations struct{}
+
+func (noRevocations) IsRevoked(string, string) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,116 @@
+package issuer_test
+
+import (
+	"context"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("Secret Revocations", func() {
+
+	var resManager manager.ResourceManager
+	var tokenIssuer issuer.DataplaneTokenIssuer
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		err := resManager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		key, err := issuer.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Create(context.Background(), key, store.CreateBy(issuer.SigningKeyResourceKey(issuer.DataplaneTokenPrefix, "default")))
+		Expect(err).ToNot(HaveOccurred())
+		tokenIssuer = issuer.NewDataplaneTokenIssuer(
+			issuer.NewSigningKeyAccessor(resManager, issuer.DataplaneTokenPrefix),
+			issuer.NewSecretRevocations(resManager),
+			issuer.SigningMethodHS256,
+		)
+	})
+
+	tokenID := func(token issuer.Token) string {
+		c := &jwt.StandardClaims{}
+		_, _, err := new(jwt.Parser).ParseUnverified(token, c)
+		Expect(err).ToNot(HaveOccurred())
+		return c.Id
+	}
+
+	It("should reject token revoked in the Secret of the Mesh", func() {
+		// given
+		token, err := tokenIssuer.Generate(issuer.DataplaneIdentity{Mesh: "default", Name: "dp-1"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		otherToken, err := tokenIssuer.Generate(issuer.DataplaneIdentity{Mesh: "default", Name: "dp-2"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and the tokens are valid before the revocation
+		_, err = tokenIssuer.Validate(token, "default")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		revocations := &system.SecretResource{
+			Spec: &system_proto.Secret{
+				Data: &wrappers.BytesValue{Value: []byte("some-id,\n" + tokenID(token))},
+			},
+		}
+		err = resManager.Create(context.Background(), revocations, store.CreateBy(issuer.RevocationsResourceKey("default")))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		_, err = tokenIssuer.Validate(token, "default")
+		Expect(err).To(MatchError("token is revoked"))
+		// and the other token is still valid
+		identity, err := tokenIssuer.Validate(otherToken, "default")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(identity.Name).To(Equal("dp-2"))
+	})
+})
+
+var _ = Describe("ContainsRevokedID", func() {
+	type testCase struct {
+		revocations string
+		id          string
+		expected    bool
+	}
+
+	DescribeTable("should check the revocation list",
+		func(given testCase) {
+			Expect(issuer.ContainsRevokedID([]byte(given.revocations), given.id)).To(Equal(given.expected))
+		},
+		Entry("id separated by comma", testCase{
+			revocations: "a,b,c",
+			id:          "b",
+			expected:    true,
+		}),
+		Entry("id separated by new line", testCase{
+			revocations: "a\nb\n",
+			id:          "b",
+			expected:    true,
+		}),
+		Entry("id with whitespaces", testCase{
+			revocations: "a, b ,c",
+			id:          "b",
+			expected:    true,
+		}),
+		Entry("id not on the list", testCase{
+			revocations: "a,bc",
+			id:          "b",
+			expected:    false,
+		}),
+		Entry("empty list", testCase{
+			revocations: "",
+			id:          "b",
+			expected:    false,
+		}),
+	)
+})
@@ -6,6 +6,8 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/kumahq/kuma/pkg/core"
@@ -40,6 +42,14 @@ func IsSigningKeyNotFoundErr(err error) bool {
 	return strings.HasPrefix(err.Error(), "there is no Signing Key in the Control Plane for Mesh")
 }
 
+// SigningKey is a versioned key used to sign the tokens.
+// Serial 0 is the default key created with the Mesh. Tokens signed with it do not have the "kid" header.
+// Every rotated key has a serial number greater than the previous key and it's placed in the "kid" header of the token.
+type SigningKey struct {
+	Serial int
+	Key    []byte
+}
+
 func SigningKeyResourceKey(prefix, meshName string) model.ResourceKey {
 	return model.ResourceKey{
 		Mesh: meshName,
@@ -47,6 +57,41 @@ func SigningKeyResourceKey(prefix, meshName string) model.ResourceKey {
 	}
 }
 
+// SigningKeySerialResourceKey returns the key of the signing key with the given serial number.
+// Rotated keys are named "<prefix>-rotated-signing-key-<mesh>-<serial>". The name cannot start with the name of the default key
+// ("<prefix>-signing-key-<mesh>"), otherwise a rotated key of Mesh "demo" would be the default key of Mesh "demo-1".
+// Secrets of all Meshes are stored in one namespace on Kubernetes, so such keys would overwrite each other.
+func SigningKeySerialResourceKey(prefix, meshName string, serial int) model.ResourceKey {
+	if serial == 0 {
+		return SigningKeyResourceKey(prefix, meshName)
+	}
+	return model.ResourceKey{
+		Mesh: meshName,
+		Name: fmt.Sprintf("%s-%s-%d", rotatedSigningKeyPrefix(prefix), meshName, serial),
+	}
+}
+
+func rotatedSigningKeyPrefix(prefix string) string {
+	return fmt.Sprintf("%s-rotated-signing-key", prefix)
+}
+
+// signingKeySerial returns the serial number of the signing key of the given name or false if the name is not a name of a signing key.
+func signingKeySerial(prefix, meshName, name string) (int, bool) {
+	if name == SigningKeyResourceKey(prefix, meshName).Name {
+		return 0, true
+	}
+	rotatedName := fmt.Sprintf("%s-%s-", rotatedSigningKeyPrefix(prefix), meshName)
+	if !strings.HasPrefix(name, rotatedName) {
+		return 0, false
+	}
+	suffix := strings.TrimPrefix(name, rotatedName)
+	serial, err := strconv.Atoi(suffix)
+	if err != nil || serial <= 0 || strconv.Itoa(serial) != suffix {
+		return 0, false
+	}
+	return serial, true
+}
+
 func CreateSigningKey() (*system.SecretResource, error) {
 	res := system.NewSecretResource()
 	key, err := rsa.GenerateKey(rand.Reader, defaultRsaBits)
@@ -62,8 +107,12 @@ func CreateSigningKey() (*system.SecretResource, error) {
 }
 
 func GetSigningKey(manager manager.ReadOnlyResourceManager, prefix, meshName string) ([]byte, error) {
+	return GetSigningKeyBySerial(manager, prefix, meshName, 0)
+}
+
+func GetSigningKeyBySerial(manager manager.ReadOnlyResourceManager, prefix, meshName string, serial int) ([]byte, error) {
 	resource := system.NewSecretResource()
-	if err := manager.Get(context.Background(), resource, store.GetBy(SigningKeyResourceKey(prefix, meshName))); err != nil {
+	if err := manager.Get(context.Background(), resource, store.GetBy(SigningKeySerialResourceKey(prefix, meshName, serial))); err != nil {
 		if store.IsResourceNotFound(err) {
 			return nil, SigningKeyNotFound(meshName)
 		}
@@ -71,3 +120,87 @@ func GetSigningKey(manager manager.ReadOnlyResourceManager, prefix, meshName str
 	}
 	return resource.Spec.GetData().GetValue(), nil
 }
+
+// ListSigningKeys returns all signing keys of the Mesh sorted by the serial number.
+func ListSigningKeys(manager manager.ReadOnlyResourceManager, prefix, meshName string) ([]SigningKey, error) {
+	secrets := &system.SecretResourceList{}
+	if err := manager.List(context.Background(), secrets, store.ListByMesh(meshName)); err != nil {
+		return nil, errors.Wrap(err, "could not list signing keys from secret manager")
+	}
+	return SigningKeysFromSecrets(secrets.Items, prefix, meshName), nil
+}
+
+// SigningKeysFromSecrets picks signing keys out of the Secrets of the Mesh and sorts them by the serial number.
+func SigningKeysFromSecrets(secrets []*system.SecretResource, prefix, meshName string) []SigningKey {
+	var keys []SigningKey
+	for _, secret := range secrets {
+		if secret.GetMeta().GetMesh() != meshName {
+			continue
+		}
+		serial, ok := signingKeySerial(prefix, meshName, secret.GetMeta().GetName())
+		if !ok {
+			continue
+		}
+		keys = append(keys, SigningKey{
+			Serial: serial,
+			Key:    secret.Spec.GetData().GetValue(),
+		})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Serial < keys[j].Serial
+	})
+	return keys
+}
+
+// GetLatestSigningKey returns the signing key with the highest serial number. This key is used to sign new tokens.
+func GetLatestSigningKey(manager manager.ReadOnlyResourceManager, prefix, meshName string) (SigningKey, error) {
+	keys, err := ListSigningKeys(manager, prefix, meshName)
+	if err != nil {
+		return SigningKey{}, err
+	}
+	if len(keys) == 0 {
+		return SigningKey{}, SigningKeyNotFound(meshName)
+	}
+	return keys[len(keys)-1], nil
+}
+
+// NewSigningKeyAccessor returns SigningKeyAccessor backed by Secrets in the Mesh.
+func NewSigningKeyAccessor(manager manager.ReadOnlyResourceManager, prefix string) SigningKeyAccessor {
+	return &secretSigningKeyAccessor{
+		manager: manager,
+		prefix:  prefix,
+	}
+}
+
+type secretSigningKeyAccessor struct {
+	manager manager.ReadOnlyResourceManager
+	prefix  string
+}
+
+var _ SigningKeyAccessor = &secretSigningKeyAccessor{}
+
+func (s *secretSigningKeyAccessor) GetSigningKey(meshName string) (SigningKey, error) {
+	return GetLatestSigningKey(s.manager, s.prefix, meshName)
+}
+
+func (s *secretSigningKeyAccessor) GetValidationKey(meshName string, serial int) ([]byte, error) {
+	return GetSigningKeyBySerial(s.manager, s.prefix, meshName, serial)
+}
+
+// GetPublicKey returns the public key synced from the Global CP.
+// If there is no such key, the public key is derived from the signing key which is available on the Control Plane that issued the token.
+func (s *secretSigningKeyAccessor) GetPublicKey(meshName string, serial int) ([]byte, error) {
+	resource := system.NewSecretResource()
+	err := s.manager.Get(context.Background(), resource, store.GetBy(PublicKeyResourceKey(s.prefix, meshName, serial)))
+	if err == nil {
+		return resource.Spec.GetData().GetValue(), nil
+	}
+	if !store.IsResourceNotFound(err) {
+		return nil, errors.Wrap(err, "could not retrieve public key from secret manager")
+	}
+	signingKey, err := GetSigningKeyBySerial(s.manager, s.prefix, meshName, serial)
+	if err != nil {
+		return nil, err
+	}
+	return PublicKeyFromSigningKey(signingKey)
+}
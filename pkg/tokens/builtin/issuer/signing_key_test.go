@@ -0,0 +1,147 @@
+package issuer_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("Signing Key rotation", func() {
+
+	var resManager manager.ResourceManager
+	var tokenIssuer issuer.DataplaneTokenIssuer
+
+	createKey := func(serial int) {
+		key, err := issuer.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Create(context.Background(), key, store.CreateBy(issuer.SigningKeySerialResourceKey(issuer.DataplaneTokenPrefix, "default", serial)))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	retireKey := func(serial int) {
+		key, err := issuer.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Delete(context.Background(), key, store.DeleteBy(issuer.SigningKeySerialResourceKey(issuer.DataplaneTokenPrefix, "default", serial)))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		tokenIssuer = issuer.NewDataplaneTokenIssuer(
+			issuer.NewSigningKeyAccessor(resManager, issuer.DataplaneTokenPrefix),
+			issuer.NoRevocations,
+			issuer.SigningMethodHS256,
+		)
+		err := resManager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		createKey(0)
+	})
+
+	It("should validate tokens signed by all active keys", func() {
+		// given token signed by the default key
+		legacyToken, err := tokenIssuer.Generate(issuer.DataplaneIdentity{Mesh: "default"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when key is rotated
+		createKey(1)
+		token, err := tokenIssuer.Generate(issuer.DataplaneIdentity{Mesh: "default"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then both tokens are valid
+		_, err = tokenIssuer.Validate(legacyToken, "default")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = tokenIssuer.Validate(token, "default")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when the default key is retired
+		retireKey(0)
+
+		// then only token signed with the new key is valid
+		_, err = tokenIssuer.Validate(legacyToken, "default")
+		Expect(err).To(MatchError(`could not parse token: there is no Signing Key with serial number 0 for Mesh "default". The key might have been retired`))
+		_, err = tokenIssuer.Validate(token, "default")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should sign tokens with the key of the highest serial number", func() {
+		// given
+		createKey(2)
+		createKey(10)
+
+		// when
+		keys, err := issuer.ListSigningKeys(resManager, issuer.DataplaneTokenPrefix, "default")
+		Expect(err).ToNot(HaveOccurred())
+		latest, err := issuer.GetLatestSigningKey(resManager, issuer.DataplaneTokenPrefix, "default")
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(keys).To(HaveLen(3))
+		Expect(keys[0].Serial).To(Equal(0))
+		Expect(keys[1].Serial).To(Equal(2))
+		Expect(keys[2].Serial).To(Equal(10))
+		Expect(latest.Serial).To(Equal(10))
+	})
+
+	It("should ignore secrets that are not signing keys", func() {
+		// given
+		key, err := issuer.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Create(context.Background(), key, store.CreateByKey("dataplane-token-signing-key-default-abc", "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		keys, err := issuer.ListSigningKeys(resManager, issuer.DataplaneTokenPrefix, "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keys).To(HaveLen(1))
+	})
+	It("should not mix signing keys of Meshes with similar names", func() {
+		// given meshes "demo" and "demo-1"
+		var names []string
+		for _, meshName := range []string{"demo", "demo-1"} {
+			for serial := 0; serial <= 2; serial++ {
+				names = append(names, issuer.SigningKeySerialResourceKey(issuer.DataplaneTokenPrefix, meshName, serial).Name)
+			}
+		}
+
+		// then names of the keys are unique across Meshes, because on Kubernetes Secrets of all Meshes are in the same namespace
+		Expect(names).To(ConsistOf(
+			"dataplane-token-signing-key-demo",
+			"dataplane-token-rotated-signing-key-demo-1",
+			"dataplane-token-rotated-signing-key-demo-2",
+			"dataplane-token-signing-key-demo-1",
+			"dataplane-token-rotated-signing-key-demo-1-1",
+			"dataplane-token-rotated-signing-key-demo-1-2",
+		))
+
+		// when the keys are stored with the same Mesh, as if names were the only identity
+		secrets := &system.SecretResourceList{}
+		for _, name := range names {
+			key, err := issuer.CreateSigningKey()
+			Expect(err).ToNot(HaveOccurred())
+			key.SetMeta(&test_model.ResourceMeta{Mesh: "demo", Name: name})
+			Expect(secrets.AddItem(key)).To(Succeed())
+		}
+		keys := issuer.SigningKeysFromSecrets(secrets.Items, issuer.DataplaneTokenPrefix, "demo")
+
+		// then only keys of Mesh "demo" are picked
+		Expect(keys).To(HaveLen(3))
+		Expect(keys[0].Serial).To(Equal(0))
+		Expect(keys[0].Key).To(Equal(secrets.Items[0].Spec.GetData().GetValue()))
+		Expect(keys[1].Serial).To(Equal(1))
+		Expect(keys[1].Key).To(Equal(secrets.Items[1].Spec.GetData().GetValue()))
+		Expect(keys[2].Serial).To(Equal(2))
+		Expect(keys[2].Key).To(Equal(secrets.Items[2].Spec.GetData().GetValue()))
+	})
+})
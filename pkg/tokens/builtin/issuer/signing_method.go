@@ -0,0 +1,194 @@
+package issuer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+type SigningMethod = string
+
+const (
+	// SigningMethodHS256 signs tokens with a secret shared between all Control Planes that validate the tokens.
+	SigningMethodHS256 SigningMethod = "HS256"
+	// SigningMethodRS256 signs tokens with RSA private key. Tokens can be validated with the public key only.
+	SigningMethodRS256 SigningMethod = "RS256"
+	// SigningMethodES256 signs tokens with ECDSA P-256 private key. Tokens can be validated with the public key only.
+	SigningMethodES256 SigningMethod = "ES256"
+)
+
+var SigningMethods = []SigningMethod{SigningMethodHS256, SigningMethodRS256, SigningMethodES256}
+
+func IsAsymmetric(method SigningMethod) bool {
+	return method == SigningMethodRS256 || method == SigningMethodES256
+}
+
+type KeyType = string
+
+const (
+	KeyTypeRSA   KeyType = "rsa"
+	KeyTypeECDSA KeyType = "ecdsa"
+)
+
+// KeyTypeForSigningMethod returns the type of the key that has to be used with the signing method.
+func KeyTypeForSigningMethod(method SigningMethod) KeyType {
+	if method == SigningMethodES256 {
+		return KeyTypeECDSA
+	}
+	return KeyTypeRSA
+}
+
+// CreateSigningKeyOfType creates a Secret with a private key of the given type.
+// RSA keys are used both as a shared secret for HS256 and as a private key for RS256.
+func CreateSigningKeyOfType(keyType KeyType) (*system.SecretResource, error) {
+	switch keyType {
+	case KeyTypeRSA:
+		return CreateSigningKey()
+	case KeyTypeECDSA:
+		res := system.NewSecretResource()
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return res, errors.Wrap(err, "failed to generate ecdsa key")
+		}
+		bytes, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return res, errors.Wrap(err, "failed to marshal ecdsa key")
+		}
+		res.Spec = &system_proto.Secret{
+			Data: &wrappers.BytesValue{
+				Value: bytes,
+			},
+		}
+		return res, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", keyType)
+	}
+}
+
+// PublicKeyResourceKey returns the key of the public key of the signing key with the given serial number.
+// Like rotated signing keys, public keys of rotated signing keys have a separate prefix so they do not collide with keys of other Meshes.
+func PublicKeyResourceKey(prefix, meshName string, serial int) model.ResourceKey {
+	name := fmt.Sprintf("%s-public-key-%s", prefix, meshName)
+	if serial != 0 {
+		name = fmt.Sprintf("%s-rotated-public-key-%s-%d", prefix, meshName, serial)
+	}
+	return model.ResourceKey{
+		Mesh: meshName,
+		Name: name,
+	}
+}
+
+func parsePrivateKey(key []byte) (crypto.Signer, error) {
+	if rsaKey, err := x509.ParsePKCS1PrivateKey(key); err == nil {
+		return rsaKey, nil
+	}
+	if ecKey, err := x509.ParseECPrivateKey(key); err == nil {
+		return ecKey, nil
+	}
+	return nil, errors.New("signing key is neither a PKCS1 RSA private key nor an EC private key")
+}
+
+// PublicKeyFromSigningKey returns PEM encoded public key of the signing key.
+func PublicKeyFromSigningKey(signingKey []byte) ([]byte, error) {
+	privateKey, err := parsePrivateKey(signingKey)
+	if err != nil {
+		return nil, err
+	}
+	bytes, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal public key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: bytes}), nil
+}
+
+// PublicKeySecret converts a Secret with the signing key into a Secret with the public key of the signing key.
+// Returns false if the Secret is not a signing key.
+func PublicKeySecret(prefix string, secret *system.SecretResource) (*system.SecretResource, model.ResourceKey, bool, error) {
+	meshName := secret.GetMeta().GetMesh()
+	serial, ok := signingKeySerial(prefix, meshName, secret.GetMeta().GetName())
+	if !ok {
+		return nil, model.ResourceKey{}, false, nil
+	}
+	publicKey, err := PublicKeyFromSigningKey(secret.Spec.GetData().GetValue())
+	if err != nil {
+		return nil, model.ResourceKey{}, false, err
+	}
+	res := system.NewSecretResource()
+	res.Spec = &system_proto.Secret{
+		Data: &wrappers.BytesValue{
+			Value: publicKey,
+		},
+	}
+	return res, PublicKeyResourceKey(prefix, meshName, serial), true, nil
+}
+
+func signingMethodOf(method SigningMethod) (jwt.SigningMethod, error) {
+	switch method {
+	case SigningMethodHS256, "":
+		return jwt.SigningMethodHS256, nil
+	case SigningMethodRS256:
+		return jwt.SigningMethodRS256, nil
+	case SigningMethodES256:
+		return jwt.SigningMethodES256, nil
+	default:
+		return nil, errors.Errorf("unsupported signing method %q", method)
+	}
+}
+
+// signingKeyFor converts the raw signing key into the form required by the signing method.
+func signingKeyFor(method SigningMethod, key []byte) (interface{}, error) {
+	switch method {
+	case SigningMethodHS256, "":
+		return key, nil
+	case SigningMethodRS256:
+		rsaKey, err := x509.ParsePKCS1PrivateKey(key)
+		if err != nil {
+			return nil, errors.Errorf("signing key is not a PKCS1 RSA private key required by the %s signing method. Rotate the signing key with the RSA key", method)
+		}
+		return rsaKey, nil
+	case SigningMethodES256:
+		ecKey, err := x509.ParseECPrivateKey(key)
+		if err != nil {
+			return nil, errors.Errorf("signing key is not an EC private key required by the %s signing method. Rotate the signing key with the ECDSA key", method)
+		}
+		return ecKey, nil
+	default:
+		return nil, errors.Errorf("unsupported signing method %q", method)
+	}
+}
+
+// validationPublicKeyFor parses PEM encoded public key and checks that it matches the algorithm of the token.
+func validationPublicKeyFor(method jwt.SigningMethod, publicKeyPEM []byte) (interface{}, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return nil, errors.New("public key is not PEM encoded")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse public key")
+	}
+	switch method.(type) {
+	case *jwt.SigningMethodRSA:
+		if key, ok := publicKey.(*rsa.PublicKey); ok {
+			return key, nil
+		}
+	case *jwt.SigningMethodECDSA:
+		if key, ok := publicKey.(*ecdsa.PublicKey); ok {
+			return key, nil
+		}
+	}
+	return nil, errors.Errorf("public key cannot be used to validate token signed with %s", method.Alg())
+}
@@ -0,0 +1,109 @@
+package issuer_test
+
+import (
+	"context"
+
+	"github.com/dgrijalva/jwt-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("Asymmetric signing methods", func() {
+
+	newManager := func() manager.ResourceManager {
+		resManager := manager.NewResourceManager(memory.NewStore())
+		err := resManager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		return resManager
+	}
+
+	newIssuer := func(resManager manager.ResourceManager, method issuer.SigningMethod) issuer.DataplaneTokenIssuer {
+		return issuer.NewDataplaneTokenIssuer(
+			issuer.NewSigningKeyAccessor(resManager, issuer.DataplaneTokenPrefix),
+			issuer.NoRevocations,
+			method,
+		)
+	}
+
+	DescribeTable("should validate tokens with the public key only",
+		func(method issuer.SigningMethod) {
+			// given Global CP with the signing key
+			globalManager := newManager()
+			signingKey, err := issuer.CreateSigningKeyOfType(issuer.KeyTypeForSigningMethod(method))
+			Expect(err).ToNot(HaveOccurred())
+			err = globalManager.Create(context.Background(), signingKey, store.CreateBy(issuer.SigningKeyResourceKey(issuer.DataplaneTokenPrefix, "default")))
+			Expect(err).ToNot(HaveOccurred())
+
+			// and Zone CP with the public key only
+			zoneManager := newManager()
+			publicKey, key, ok, err := issuer.PublicKeySecret(issuer.DataplaneTokenPrefix, signingKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(key.Name).To(Equal("dataplane-token-public-key-default"))
+			err = zoneManager.Create(context.Background(), publicKey, store.CreateBy(key))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			token, err := newIssuer(globalManager, method).Generate(issuer.DataplaneIdentity{Mesh: "default", Name: "dp-1"}, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			parsed, _, err := new(jwt.Parser).ParseUnverified(token, jwt.MapClaims{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed.Method.Alg()).To(Equal(method))
+
+			// and token is valid on both CPs
+			id, err := newIssuer(zoneManager, method).Validate(token, "default")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id.Name).To(Equal("dp-1"))
+			_, err = newIssuer(globalManager, method).Validate(token, "default")
+			Expect(err).ToNot(HaveOccurred())
+		},
+		Entry("RS256", issuer.SigningMethodRS256),
+		Entry("ES256", issuer.SigningMethodES256),
+	)
+
+	It("should not use the public key as a HMAC secret", func() {
+		// given Zone CP with the public key only
+		zoneManager := newManager()
+		signingKey, err := issuer.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		signingKey.Meta = &test_model.ResourceMeta{Mesh: "default", Name: issuer.SigningKeyResourceKey(issuer.DataplaneTokenPrefix, "default").Name}
+		publicKey, key, _, err := issuer.PublicKeySecret(issuer.DataplaneTokenPrefix, signingKey)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(zoneManager.Create(context.Background(), publicKey, store.CreateBy(key))).To(Succeed())
+
+		// and a token signed with HS256 with the public key as a secret
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"Mesh": "default"}).SignedString(publicKey.Spec.GetData().GetValue())
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = newIssuer(zoneManager, issuer.SigningMethodRS256).Validate(token, "default")
+
+		// then
+		Expect(err).To(MatchError(`could not parse token: there is no Signing Key with serial number 0 for Mesh "default". The key might have been retired`))
+	})
+
+	It("should fail to sign with ES256 when the signing key is RSA", func() {
+		// given
+		resManager := newManager()
+		signingKey, err := issuer.CreateSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resManager.Create(context.Background(), signingKey, store.CreateBy(issuer.SigningKeyResourceKey(issuer.DataplaneTokenPrefix, "default")))).To(Succeed())
+
+		// when
+		_, err = newIssuer(resManager, issuer.SigningMethodES256).Generate(issuer.DataplaneIdentity{Mesh: "default"}, 0)
+
+		// then
+		Expect(err).To(MatchError("signing key is not an EC private key required by the ES256 signing method. Rotate the signing key with the ECDSA key"))
+	})
+})
@@ -0,0 +1,155 @@
+package issuer
+
+import (
+	"context"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+const (
+	UserTokenSigningKeyName  = "user-token-signing-key"
+	UserTokenRevocationsName = "user-token-revocations"
+)
+
+var UserTokenSigningKeyResourceKey = model.ResourceKey{Name: UserTokenSigningKeyName}
+
+var UserTokenRevocationsResourceKey = model.ResourceKey{Name: UserTokenRevocationsName}
+
+var UserTokenSigningKeyNotFound = errors.New("there is no User Token Signing Key in the Control Plane. Make sure the Control Plane finished the startup.")
+
+type UserIdentity struct {
+	Name   string
+	Groups []string
+}
+
+// UserTokenValidator validates User Tokens and returns the identity of the user.
+type UserTokenValidator interface {
+	Validate(token Token) (UserIdentity, error)
+}
+
+// UserTokenIssuer issues User Tokens used for authenticating users of the API Server.
+// The token is signed with HS256 using the key from the "user-token-signing-key" GlobalSecret,
+// therefore it can be validated only by the Control Plane that issued it.
+//
+// Every token has a unique ID (jti claim) which can be placed on the "user-token-revocations" GlobalSecret to invalidate the token.
+type UserTokenIssuer interface {
+	UserTokenValidator
+	Generate(identity UserIdentity, validFor time.Duration) (Token, error)
+}
+
+type userClaims struct {
+	Name   string
+	Groups []string
+	jwt.StandardClaims
+}
+
+func NewUserTokenIssuer(resManager manager.ReadOnlyResourceManager) UserTokenIssuer {
+	return &userTokenIssuer{
+		resManager: resManager,
+	}
+}
+
+type userTokenIssuer struct {
+	resManager manager.ReadOnlyResourceManager
+}
+
+var _ UserTokenIssuer = &userTokenIssuer{}
+
+func (u *userTokenIssuer) signingKey() ([]byte, error) {
+	secret := system.NewGlobalSecretResource()
+	if err := u.resManager.Get(context.Background(), secret, store.GetBy(UserTokenSigningKeyResourceKey)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return nil, UserTokenSigningKeyNotFound
+		}
+		return nil, errors.Wrap(err, "could not retrieve signing key from secret manager")
+	}
+	return secret.Spec.GetData().GetValue(), nil
+}
+
+func (u *userTokenIssuer) Generate(identity UserIdentity, validFor time.Duration) (Token, error) {
+	key, err := u.signingKey()
+	if err != nil {
+		return "", err
+	}
+	now := core.Now()
+	c := userClaims{
+		Name:   identity.Name,
+		Groups: identity.Groups,
+		StandardClaims: jwt.StandardClaims{
+			Id:       core.NewUUID(),
+			IssuedAt: now.Unix(),
+		},
+	}
+	if validFor > 0 {
+		c.ExpiresAt = now.Add(validFor).Unix()
+	}
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(key)
+	if err != nil {
+		return "", errors.Wrap(err, "could not sign a token")
+	}
+	return tokenString, nil
+}
+
+func (u *userTokenIssuer) Validate(rawToken Token) (UserIdentity, error) {
+	c := &userClaims{}
+	token, err := jwt.ParseWithClaims(rawToken, c, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, errors.Errorf("unsupported signing method %q", token.Method.Alg())
+		}
+		return u.signingKey()
+	})
+	if err != nil {
+		return UserIdentity{}, errors.Wrap(err, "could not parse token")
+	}
+	if !token.Valid {
+		return UserIdentity{}, errors.New("token is not valid")
+	}
+	if c.Name == "" {
+		return UserIdentity{}, errors.New("token does not contain the name of the user")
+	}
+
+	revoked, err := u.isRevoked(c.Id)
+	if err != nil {
+		return UserIdentity{}, errors.Wrap(err, "could not check if the token is revoked")
+	}
+	if revoked {
+		return UserIdentity{}, errors.New("token is revoked")
+	}
+	return UserIdentity{
+		Name:   c.Name,
+		Groups: c.Groups,
+	}, nil
+}
+
+func (u *userTokenIssuer) isRevoked(tokenID string) (bool, error) {
+	if tokenID == "" {
+		return false, nil
+	}
+	secret := system.NewGlobalSecretResource()
+	if err := u.resManager.Get(context.Background(), secret, store.GetBy(UserTokenRevocationsResourceKey)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "could not retrieve token revocations")
+	}
+	return ContainsRevokedID(secret.Spec.GetData().GetValue(), tokenID), nil
+}
+
+// CreateUserTokenSigningKey creates a GlobalSecret with the key that signs User Tokens.
+func CreateUserTokenSigningKey() (*system.GlobalSecretResource, error) {
+	signingKey, err := CreateSigningKey()
+	if err != nil {
+		return nil, err
+	}
+	res := system.NewGlobalSecretResource()
+	res.Spec = signingKey.Spec
+	return res, nil
+}
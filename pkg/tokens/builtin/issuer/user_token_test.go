@@ -0,0 +1,129 @@
+package issuer_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("User Token Issuer", func() {
+
+	var resManager manager.ResourceManager
+	var tokenIssuer issuer.UserTokenIssuer
+	var now time.Time
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		tokenIssuer = issuer.NewUserTokenIssuer(resManager)
+
+		key, err := issuer.CreateUserTokenSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Create(context.Background(), key, store.CreateBy(issuer.UserTokenSigningKeyResourceKey))
+		Expect(err).ToNot(HaveOccurred())
+
+		now = time.Now()
+		core.Now = func() time.Time {
+			return now
+		}
+		jwt.TimeFunc = func() time.Time {
+			return now
+		}
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+		jwt.TimeFunc = time.Now
+	})
+
+	It("should generate and validate the token", func() {
+		// given
+		id := issuer.UserIdentity{
+			Name:   "john",
+			Groups: []string{"team-a", "team-b"},
+		}
+
+		// when
+		token, err := tokenIssuer.Generate(id, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		validated, err := tokenIssuer.Validate(token)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validated).To(Equal(id))
+	})
+
+	It("should reject expired token", func() {
+		// given
+		token, err := tokenIssuer.Generate(issuer.UserIdentity{Name: "john"}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		now = now.Add(time.Hour + time.Second)
+		_, err = tokenIssuer.Validate(token)
+
+		// then
+		Expect(err).To(MatchError("could not parse token: token is expired by 1s"))
+	})
+
+	It("should reject revoked token", func() {
+		// given
+		token, err := tokenIssuer.Generate(issuer.UserIdentity{Name: "john"}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		c := &jwt.StandardClaims{}
+		_, _, err = new(jwt.Parser).ParseUnverified(token, c)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		revocations := &system.GlobalSecretResource{
+			Spec: &system_proto.Secret{
+				Data: &wrappers.BytesValue{Value: []byte(c.Id)},
+			},
+		}
+		err = resManager.Create(context.Background(), revocations, store.CreateBy(issuer.UserTokenRevocationsResourceKey))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = tokenIssuer.Validate(token)
+
+		// then
+		Expect(err).To(MatchError("token is revoked"))
+	})
+
+	It("should reject token signed by other key", func() {
+		// given
+		otherResManager := manager.NewResourceManager(memory.NewStore())
+		key, err := issuer.CreateUserTokenSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		err = otherResManager.Create(context.Background(), key, store.CreateBy(issuer.UserTokenSigningKeyResourceKey))
+		Expect(err).ToNot(HaveOccurred())
+		token, err := issuer.NewUserTokenIssuer(otherResManager).Generate(issuer.UserIdentity{Name: "john"}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = tokenIssuer.Validate(token)
+
+		// then
+		Expect(err).To(MatchError("could not parse token: signature is invalid"))
+	})
+
+	It("should fail to generate the token without the signing key", func() {
+		// given
+		tokenIssuer = issuer.NewUserTokenIssuer(manager.NewResourceManager(memory.NewStore()))
+
+		// when
+		_, err := tokenIssuer.Generate(issuer.UserIdentity{Name: "john"}, time.Hour)
+
+		// then
+		Expect(err).To(Equal(issuer.UserTokenSigningKeyNotFound))
+	})
+})
@@ -5,4 +5,6 @@ type DataplaneTokenRequest struct {
 	Mesh string              `json:"mesh"`
 	Tags map[string][]string `json:"tags"`
 	Type string              `json:"type"`
+	// ValidFor is a duration (for example "24h") after which the token expires. Token without it does not expire.
+	ValidFor string `json:"validFor,omitempty"`
 }
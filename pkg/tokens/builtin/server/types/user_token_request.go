@@ -0,0 +1,8 @@
+package types
+
+type UserTokenRequest struct {
+	Name   string   `json:"name"`
+	Groups []string `json:"groups,omitempty"`
+	// ValidFor is a duration (for example "24h") after which the token expires.
+	ValidFor string `json:"validFor"`
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/api-server/authz"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+)
+
+type userTokenWebService struct {
+	issuer issuer.UserTokenIssuer
+	access authz.AccessControl
+}
+
+func NewUserTokenWebservice(issuer issuer.UserTokenIssuer, access authz.AccessControl) *restful.WebService {
+	ws := userTokenWebService{
+		issuer: issuer,
+		access: access,
+	}
+	return ws.createWs()
+}
+
+func (u *userTokenWebService) createWs() *restful.WebService {
+	ws := new(restful.WebService).
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Path("/tokens/user").
+		Route(ws.POST("").To(u.handleIdentityRequest).Filter(u.access.Filter(system.AccessRoleActionCreate, authz.UserTokenType, true)))
+	return ws
+}
+
+func (u *userTokenWebService) handleIdentityRequest(request *restful.Request, response *restful.Response) {
+	idReq := types.UserTokenRequest{}
+	if err := request.ReadEntity(&idReq); err != nil {
+		log.Error(err, "Could not read a request")
+		response.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	verr := validators.ValidationError{}
+	if idReq.Name == "" {
+		verr.AddViolation("name", "cannot be empty")
+	}
+	for i, group := range idReq.Groups {
+		if group == "" {
+			verr.AddViolationAt(validators.RootedAt("groups").Index(i), "cannot be empty")
+		}
+	}
+	// every User Token has to expire, because User Token gives access to the Control Plane and it is not bound to any Mesh
+	var validFor time.Duration
+	if idReq.ValidFor == "" {
+		verr.AddViolation("validFor", "cannot be empty")
+	} else {
+		dur, err := time.ParseDuration(idReq.ValidFor)
+		switch {
+		case err != nil:
+			verr.AddViolation("validFor", "must be a valid duration, for example \"24h\"")
+		case dur <= 0:
+			verr.AddViolation("validFor", "must be positive")
+		default:
+			validFor = dur
+		}
+	}
+	if err := verr.OrNil(); err != nil {
+		errors.HandleError(response, err, "Invalid request")
+		return
+	}
+	identity := issuer.UserIdentity{
+		Name:   idReq.Name,
+		Groups: idReq.Groups,
+	}
+	if err := u.validateIdentity(request, identity); err != nil {
+		authz.WriteAccessDenied(response, err)
+		return
+	}
+
+	token, err := u.issuer.Generate(identity, validFor)
+	if err != nil {
+		errors.HandleError(response, err, "Could not issue a token")
+		return
+	}
+
+	response.Header().Set("content-type", "text/plain")
+	if _, err := response.Write([]byte(token)); err != nil {
+		log.Error(err, "Could write a response")
+	}
+}
+
+// validateIdentity checks that the user requesting the token generates a token of themselves.
+// The name has to be the name of the user and the user has to be a member of every requested group.
+// Otherwise, a user allowed to generate tokens could generate a token of the admin or of any user with a broader AccessRole.
+// Admins can request any identity.
+func (u *userTokenWebService) validateIdentity(request *restful.Request, identity issuer.UserIdentity) error {
+	subject, err := u.access.Subject(request)
+	if err != nil {
+		return err
+	}
+	if subject.InGroup(authz.AdminGroup) {
+		return nil
+	}
+	if identity.Name != subject.Name {
+		return &authz.AccessDeniedError{
+			Reason: fmt.Sprintf("user %q cannot generate a token of other user %q", subject.Name, identity.Name),
+		}
+	}
+	for _, group := range identity.Groups {
+		if !subject.InGroup(group) {
+			return &authz.AccessDeniedError{
+				Reason: fmt.Sprintf("user %q cannot generate a token with group %q that the user is not a member of", subject.Name, group),
+			}
+		}
+	}
+	return nil
+}
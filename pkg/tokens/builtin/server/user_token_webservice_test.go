@@ -0,0 +1,195 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/authz"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
+)
+
+var _ = Describe("User Token Webservice", func() {
+
+	var url string
+	var tokenIssuer issuer.UserTokenIssuer
+
+	BeforeEach(func() {
+		resManager := manager.NewResourceManager(memory.NewStore())
+		key, err := issuer.CreateUserTokenSigningKey()
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Create(context.Background(), key, store.CreateBy(issuer.UserTokenSigningKeyResourceKey))
+		Expect(err).ToNot(HaveOccurred())
+		tokenIssuer = issuer.NewUserTokenIssuer(resManager)
+
+		access := authz.NewAdminAccessControl(authz.AdminAuth{
+			AllowFromLocalhost: true,
+			UserTokenValidator: tokenIssuer,
+		})
+		container := restful.NewContainer()
+		container.Add(server.NewUserTokenWebservice(tokenIssuer, access))
+		srv := httptest.NewServer(container)
+		url = srv.URL
+
+		// wait for the server
+		Eventually(func() error {
+			_, err := http.DefaultClient.Get(fmt.Sprintf("%s/tokens/user", srv.URL))
+			return err
+		}).ShouldNot(HaveOccurred())
+	})
+
+	generate := func(body string, token string) *http.Response {
+		req, err := http.NewRequest("POST", fmt.Sprintf("%s/tokens/user", url), strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Add("content-type", "application/json")
+		if token != "" {
+			req.Header.Add("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		return resp
+	}
+
+	It("should respond with generated token", func() {
+		// given
+		reqBytes, err := json.Marshal(types.UserTokenRequest{
+			Name:     "john",
+			Groups:   []string{"team-a"},
+			ValidFor: "24h",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp := generate(string(reqBytes), "")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(200))
+		respBody, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		identity, err := tokenIssuer.Validate(string(respBody))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(identity).To(Equal(issuer.UserIdentity{
+			Name:   "john",
+			Groups: []string{"team-a"},
+		}))
+	})
+
+	DescribeTable("should authenticate the request with the user token",
+		func(groups []string, expectedStatus int) {
+			// given
+			token, err := tokenIssuer.Generate(issuer.UserIdentity{Name: "john", Groups: groups}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			resp := generate(`{"name": "ci", "validFor": "1h"}`, token)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(expectedStatus))
+		},
+		Entry("member of the admin group", []string{authz.AdminGroup}, 200),
+		Entry("not a member of the admin group", []string{"team-a"}, 403),
+	)
+
+	It("should reject invalid user token", func() {
+		// when
+		resp := generate(`{"name": "ci", "validFor": "1h"}`, "invalid-token")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(401))
+	})
+
+	DescribeTable("should return bad request on invalid request",
+		func(json string) {
+			// when
+			resp := generate(json, "")
+
+			// then
+			Expect(resp.StatusCode).To(Equal(400))
+		},
+		Entry("not valid json", `not-valid-json`),
+		Entry("without name", `{"validFor": "1h"}`),
+		Entry("without validFor", `{"name": "john"}`),
+		Entry("invalid validFor", `{"name": "john", "validFor": "not-a-duration"}`),
+		Entry("negative validFor", `{"name": "john", "validFor": "-1h"}`),
+		Entry("empty group", `{"name": "john", "validFor": "1h", "groups": [""]}`),
+	)
+
+	Context("with RBAC", func() {
+
+		BeforeEach(func() {
+			resManager := manager.NewResourceManager(memory.NewStore())
+			key, err := issuer.CreateUserTokenSigningKey()
+			Expect(err).ToNot(HaveOccurred())
+			err = resManager.Create(context.Background(), key, store.CreateBy(issuer.UserTokenSigningKeyResourceKey))
+			Expect(err).ToNot(HaveOccurred())
+			tokenIssuer = issuer.NewUserTokenIssuer(resManager)
+
+			role := &system.AccessRoleResource{
+				Spec: &system_proto.AccessRole{
+					Rules: []*system_proto.AccessRole_Rule{
+						{Actions: []string{system.AccessRoleActionCreate}, Types: []string{string(authz.UserTokenType)}},
+					},
+					Subjects: []*system_proto.AccessRole_Subject{
+						{Group: "team-a"},
+					},
+				},
+			}
+			err = resManager.Create(context.Background(), role, store.CreateByKey("token-generator", model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+
+			access := authz.NewRBACAccessControl(authz.NewRoleAuthorizer(resManager, 0), authz.Authenticator{
+				UserTokenValidator: tokenIssuer,
+			})
+			container := restful.NewContainer()
+			container.Add(server.NewUserTokenWebservice(tokenIssuer, access))
+			srv := httptest.NewServer(container)
+			url = srv.URL
+		})
+
+		DescribeTable("should validate requested identity",
+			func(requestedName string, requestedGroups []string, expectedStatus int) {
+				// given
+				token, err := tokenIssuer.Generate(issuer.UserIdentity{Name: "john", Groups: []string{"team-a"}}, time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				reqBytes, err := json.Marshal(types.UserTokenRequest{
+					Name:     requestedName,
+					Groups:   requestedGroups,
+					ValidFor: "1h",
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				resp := generate(string(reqBytes), token)
+
+				// then
+				Expect(resp.StatusCode).To(Equal(expectedStatus))
+			},
+			Entry("without groups", "john", nil, 200),
+			Entry("group of the user", "john", []string{"team-a"}, 200),
+			Entry("admin group", "john", []string{authz.AdminGroup}, 403),
+			Entry("group that the user is not a member of", "john", []string{"team-a", "team-b"}, 403),
+			Entry("name of other user", "admin", nil, 403),
+			Entry("name of other user with group of the user", "jane", []string{"team-a"}, 403),
+		)
+	})
+})
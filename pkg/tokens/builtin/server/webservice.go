@@ -2,11 +2,14 @@ package server
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/emicklei/go-restful"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/api-server/authz"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/rest/errors"
 	"github.com/kumahq/kuma/pkg/core/validators"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
@@ -17,11 +20,13 @@ var log = core.Log.WithName("dataplane-token-ws")
 
 type dataplaneTokenWebService struct {
 	issuer issuer.DataplaneTokenIssuer
+	access authz.AccessControl
 }
 
-func NewWebservice(issuer issuer.DataplaneTokenIssuer) *restful.WebService {
+func NewWebservice(issuer issuer.DataplaneTokenIssuer, access authz.AccessControl) *restful.WebService {
 	ws := dataplaneTokenWebService{
 		issuer: issuer,
+		access: access,
 	}
 	return ws.createWs()
 }
@@ -43,10 +48,30 @@ func (d *dataplaneTokenWebService) handleIdentityRequest(request *restful.Reques
 		return
 	}
 
+	verr := validators.ValidationError{}
 	if idReq.Mesh == "" {
-		verr := validators.ValidationError{}
 		verr.AddViolation("mesh", "cannot be empty")
-		errors.HandleError(response, verr.OrNil(), "Invalid request")
+	}
+	var validFor time.Duration
+	if idReq.ValidFor != "" {
+		dur, err := time.ParseDuration(idReq.ValidFor)
+		switch {
+		case err != nil:
+			verr.AddViolation("validFor", "must be a valid duration, for example \"24h\"")
+		case dur < 0:
+			verr.AddViolation("validFor", "must not be negative")
+		default:
+			validFor = dur
+		}
+	}
+	if err := verr.OrNil(); err != nil {
+		errors.HandleError(response, err, "Invalid request")
+		return
+	}
+
+	// access is validated after reading the request, because the mesh is defined in the body
+	if err := d.access.Validate(request, system.AccessRoleActionCreate, authz.DataplaneTokenType, idReq.Mesh, true); err != nil {
+		errors.HandleError(response, err, "Could not issue a token")
 		return
 	}
 
@@ -55,7 +80,7 @@ func (d *dataplaneTokenWebService) handleIdentityRequest(request *restful.Reques
 		Name: idReq.Name,
 		Type: idReq.Type,
 		Tags: mesh_proto.MultiValueTagSetFrom(idReq.Tags),
-	})
+	}, validFor)
 	if err != nil {
 		errors.HandleError(response, err, "Could not issue a token")
 		return
@@ -2,12 +2,14 @@ package server_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"time"
 
 	"github.com/emicklei/go-restful"
 	. "github.com/onsi/ginkgo"
@@ -15,6 +17,8 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 
+	"github.com/kumahq/kuma/pkg/api-server/authz"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/server"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/server/types"
@@ -26,7 +30,7 @@ type staticTokenIssuer struct {
 
 var _ issuer.DataplaneTokenIssuer = &staticTokenIssuer{}
 
-func (s *staticTokenIssuer) Generate(identity issuer.DataplaneIdentity) (issuer.Token, error) {
+func (s *staticTokenIssuer) Generate(identity issuer.DataplaneIdentity, validFor time.Duration) (issuer.Token, error) {
 	return s.resp, nil
 }
 
@@ -34,13 +38,27 @@ func (s *staticTokenIssuer) Validate(token issuer.Token, meshName string) (issue
 	return issuer.DataplaneIdentity{}, errors.New("not implemented")
 }
 
+type meshAuthorizer struct {
+	mesh string
+}
+
+func (m *meshAuthorizer) Authorize(_ context.Context, _ authz.Subject, action string, resourceType model.ResourceType, mesh string) (bool, error) {
+	return action == "create" && resourceType == authz.DataplaneTokenType && mesh == m.mesh, nil
+}
+
 var _ = Describe("Dataplane Token Webservice", func() {
 
 	const credentials = "test"
 	var url string
 
+	var access authz.AccessControl
+
 	BeforeEach(func() {
-		ws := server.NewWebservice(&staticTokenIssuer{credentials})
+		access = authz.NewAdminAccessControl(authz.AdminAuth{AllowFromLocalhost: true})
+	})
+
+	JustBeforeEach(func() {
+		ws := server.NewWebservice(&staticTokenIssuer{credentials}, access)
 
 		container := restful.NewContainer()
 		container.Add(ws)
@@ -96,5 +114,36 @@ var _ = Describe("Dataplane Token Webservice", func() {
 			Expect(resp.StatusCode).To(Equal(400))
 		},
 		Entry("not valid json", `not-valid-json`),
+		Entry("invalid validFor", `{"mesh": "default", "validFor": "not-a-duration"}`),
+		Entry("negative validFor", `{"mesh": "default", "validFor": "-1h"}`),
 	)
+
+	Context("with RBAC", func() {
+		BeforeEach(func() {
+			access = authz.NewRBACAccessControl(&meshAuthorizer{mesh: "demo"}, authz.Authenticator{})
+		})
+
+		DescribeTable("should authorize generating a token for the mesh",
+			func(mesh string, expectedStatus int) {
+				// given
+				reqBytes, err := json.Marshal(types.DataplaneTokenRequest{
+					Mesh: mesh,
+					Name: "dp-1",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				req, err := http.NewRequest("POST", fmt.Sprintf("%s/tokens", url), bytes.NewReader(reqBytes))
+				Expect(err).ToNot(HaveOccurred())
+				req.Header.Add("content-type", "application/json")
+
+				// when
+				resp, err := http.DefaultClient.Do(req)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.StatusCode).To(Equal(expectedStatus))
+			},
+			Entry("allowed mesh", "demo", 200),
+			Entry("not allowed mesh", "default", 403),
+		)
+	})
 })
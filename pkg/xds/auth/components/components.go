@@ -25,7 +25,7 @@ func NewKubeAuthenticator(rt core_runtime.Runtime) (auth.Authenticator, error) {
 }
 
 func NewUniversalAuthenticator(rt core_runtime.Runtime) (auth.Authenticator, error) {
-	issuer, err := builtin.NewDataplaneTokenIssuer(rt.ReadOnlyResourceManager())
+	issuer, err := builtin.NewDataplaneTokenIssuer(rt.ReadOnlyResourceManager(), rt.Config().DpServer.Auth.DpTokenSigningMethod)
 	if err != nil {
 		return nil, err
 	}
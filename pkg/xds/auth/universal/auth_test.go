@@ -2,6 +2,7 @@ package universal_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -20,9 +21,8 @@ import (
 var _ = Describe("Authentication flow", func() {
 	var privateKey = []byte("testPrivateKey")
 
-	issuer := builtin_issuer.NewDataplaneTokenIssuer(func(string) ([]byte, error) {
-		return privateKey, nil
-	})
+	revocations := &staticRevocations{}
+	issuer := builtin_issuer.NewDataplaneTokenIssuer(&staticSigningKeyAccessor{key: privateKey}, revocations, builtin_issuer.SigningMethodHS256)
 	var authenticator auth.Authenticator
 	var resStore core_store.ResourceStore
 
@@ -79,6 +79,7 @@ var _ = Describe("Authentication flow", func() {
 
 	BeforeEach(func() {
 		resStore = memory.NewStore()
+		revocations.revokeAll = false
 		authenticator = universal.NewAuthenticator(issuer)
 
 		err := resStore.Create(context.Background(), &dpRes, core_store.CreateByKey("dp-1", "default"))
@@ -93,7 +94,7 @@ var _ = Describe("Authentication flow", func() {
 	DescribeTable("should correctly authenticate dataplane",
 		func(given testCase) {
 			// when
-			credential, err := issuer.Generate(given.id)
+			credential, err := issuer.Generate(given.id, 0)
 
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -140,7 +141,7 @@ var _ = Describe("Authentication flow", func() {
 	DescribeTable("should fail auth",
 		func(given testCase) {
 			// when
-			token, err := issuer.Generate(given.id)
+			token, err := issuer.Generate(given.id, 0)
 
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -233,16 +234,71 @@ var _ = Describe("Authentication flow", func() {
 
 	It("should throw an error when signing key is not found", func() {
 		// given
-		issuer := builtin_issuer.NewDataplaneTokenIssuer(func(string) ([]byte, error) {
-			return nil, nil
-		})
+		issuer := builtin_issuer.NewDataplaneTokenIssuer(&staticSigningKeyAccessor{}, builtin_issuer.NoRevocations, builtin_issuer.SigningMethodHS256)
 
 		// when
 		_, err := issuer.Generate(builtin_issuer.DataplaneIdentity{
 			Mesh: "demo",
-		})
+		}, 0)
 
 		// then
 		Expect(err).To(MatchError(`there is no Signing Key in the Control Plane for Mesh "demo". Make sure the Mesh exist. If you run multi-zone setup, make sure Zone CP is connected to the Global before generating tokens.`))
 	})
+
+	It("should throw an error on expired token", func() {
+		// given
+		token, err := issuer.Generate(builtin_issuer.DataplaneIdentity{
+			Mesh: "default",
+		}, time.Second)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		Eventually(func() string {
+			err := authenticator.Authenticate(context.Background(), &dpRes, token)
+			if err == nil {
+				return ""
+			}
+			return err.Error()
+		}, "5s", "100ms").Should(HavePrefix("could not parse token: token is expired by"))
+	})
+
+	It("should throw an error on revoked token", func() {
+		// given
+		token, err := issuer.Generate(builtin_issuer.DataplaneIdentity{
+			Mesh: "default",
+		}, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(authenticator.Authenticate(context.Background(), &dpRes, token)).To(Succeed())
+
+		// when
+		revocations.revokeAll = true
+		err = authenticator.Authenticate(context.Background(), &dpRes, token)
+
+		// then
+		Expect(err).To(MatchError("token is revoked"))
+	})
 })
+
+type staticRevocations struct {
+	revokeAll bool
+}
+
+func (s *staticRevocations) IsRevoked(string, string) (bool, error) {
+	return s.revokeAll, nil
+}
+
+type staticSigningKeyAccessor struct {
+	key []byte
+}
+
+func (s *staticSigningKeyAccessor) GetSigningKey(string) (builtin_issuer.SigningKey, error) {
+	return builtin_issuer.SigningKey{Key: s.key}, nil
+}
+
+func (s *staticSigningKeyAccessor) GetValidationKey(string, int) ([]byte, error) {
+	return s.key, nil
+}
+
+func (s *staticSigningKeyAccessor) GetPublicKey(string, int) ([]byte, error) {
+	return nil, builtin_issuer.SigningKeyNotFound("default")
+}
@@ -67,6 +67,16 @@ stats_config:
     regex: '^kafka(\.(\S*[0-9]))\.'
   - tag_name: kafka_type
     regex: '^kafka\..*\.(.*)'
+  - tag_name: redis_name
+    regex: '^redis(\.([^.]+))\.'
+  - tag_name: redis_command
+    regex: '^redis\..*\.command\.(([^.]+)\.)'
+  - tag_name: mongo_name
+    regex: '^mongo(\.([^.]+))\.'
+  - tag_name: mysql_name
+    regex: '^mysql(\.([^.]+))\.'
+  - tag_name: postgres_name
+    regex: '^postgres(\.([^.]+))\.'
   - tag_name: worker
     regex: '(worker_([0-9]+)\.)'
   - tag_name: listener
@@ -25,10 +25,49 @@ func Kafka(statsName string) FilterChainBuilderOpt {
 	})
 }
 
-func Tracing(backend *mesh_proto.TracingBackend) FilterChainBuilderOpt {
+func Mongo(statsName string) FilterChainBuilderOpt {
 	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.AddV3(&v3.MongoConfigurer{
+			StatsName: statsName,
+		})
+	})
+}
+
+func MySQL(statsName string) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.AddV3(&v3.MySQLConfigurer{
+			StatsName: statsName,
+		})
+	})
+}
+
+func Postgres(statsName string) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.AddV3(&v3.PostgresConfigurer{
+			StatsName: statsName,
+		})
+	})
+}
+
+func RedisProxy(statsName string, cluster string) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.AddV3(&v3.RedisProxyConfigurer{
+			StatsName: statsName,
+			Cluster:   cluster,
+		})
+	})
+}
+
+func Tracing(backend *mesh_proto.TracingBackend, trafficTrace *mesh_core.TrafficTraceResource, service string) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		var conf *mesh_proto.TrafficTrace_Conf
+		if trafficTrace != nil {
+			conf = trafficTrace.Spec.GetConf()
+		}
 		config.AddV3(&v3.TracingConfigurer{
 			Backend: backend,
+			Conf:    conf,
+			Service: service,
 		})
 	})
 }
@@ -110,11 +149,22 @@ func InboundListener(listenerName string, address string, port uint32, protocol
 	})
 }
 
-func NetworkRBAC(statsName string, rbacEnabled bool, permission *mesh_core.TrafficPermissionResource) FilterChainBuilderOpt {
+func NetworkRBAC(statsName string, rbacEnabled bool, permission *mesh_core.TrafficPermissionResource, denyPermissions ...*mesh_core.TrafficPermissionResource) FilterChainBuilderOpt {
 	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
 		if rbacEnabled {
 			config.AddV3(&v3.NetworkRBACConfigurer{
-				StatsName:  statsName,
+				StatsName:       statsName,
+				Permission:      permission,
+				DenyPermissions: denyPermissions,
+			})
+		}
+	})
+}
+
+func HttpRBAC(rbacEnabled bool, permission *mesh_core.TrafficPermissionResource) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		if rbacEnabled {
+			config.AddV3(&v3.HttpRBACConfigurer{
 				Permission: permission,
 			})
 		}
@@ -175,10 +225,20 @@ func FaultInjection(faultInjection *mesh_proto.FaultInjection) FilterChainBuilde
 	})
 }
 
-func RateLimit(rateLimits []*mesh_proto.RateLimit) FilterChainBuilderOpt {
+func RateLimit(rateLimits []*mesh_proto.RateLimit, mesh *mesh_core.MeshResource) FilterChainBuilderOpt {
 	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
 		config.AddV3(&v3.RateLimitConfigurer{
 			RateLimits: rateLimits,
+			Mesh:       mesh,
+		})
+	})
+}
+
+func TcpRateLimit(statsName string, rateLimits []*mesh_proto.RateLimit) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.AddV3(&v3.TcpRateLimitConfigurer{
+			StatsName:  statsName,
+			RateLimits: rateLimits,
 		})
 	})
 }
@@ -200,7 +260,7 @@ func NetworkAccessLog(mesh string, trafficDirection envoy_common.TrafficDirectio
 	})
 }
 
-func HttpAccessLog(mesh string, trafficDirection envoy_common.TrafficDirection, sourceService string, destinationService string, backend *mesh_proto.LoggingBackend, proxy *core_xds.Proxy) FilterChainBuilderOpt {
+func HttpAccessLog(mesh string, trafficDirection envoy_common.TrafficDirection, sourceService string, destinationService string, backend *mesh_proto.LoggingBackend, filters []*mesh_proto.TrafficLog_Conf_Filter, proxy *core_xds.Proxy) FilterChainBuilderOpt {
 	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
 		if backend != nil {
 			config.AddV3(&v3.HttpAccessLogConfigurer{
@@ -212,6 +272,7 @@ func HttpAccessLog(mesh string, trafficDirection envoy_common.TrafficDirection,
 					Backend:            backend,
 					Proxy:              proxy,
 				},
+				Filters: filters,
 			})
 		}
 	})
@@ -3,6 +3,7 @@ package v3
 import (
 	"fmt"
 	"net"
+	"strings"
 
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/pkg/errors"
@@ -34,6 +35,25 @@ func convertLoggingBackend(mesh string, trafficDirection envoy.TrafficDirection,
 	if backend == nil {
 		return nil, nil
 	}
+
+	variables := accesslog.InterpolationVariables{
+		accesslog.CMD_KUMA_SOURCE_ADDRESS:              net.JoinHostPort(proxy.Dataplane.GetIP(), "0"), // deprecated variable
+		accesslog.CMD_KUMA_SOURCE_ADDRESS_WITHOUT_PORT: proxy.Dataplane.GetIP(),                        // replacement variable
+		accesslog.CMD_KUMA_SOURCE_SERVICE:              sourceService,
+		accesslog.CMD_KUMA_DESTINATION_SERVICE:         destinationService,
+		accesslog.CMD_KUMA_MESH:                        mesh,
+		accesslog.CMD_KUMA_TRAFFIC_DIRECTION:           string(trafficDirection),
+	}
+
+	if backend.GetType() == mesh_proto.LoggingGrpcType {
+		metadata := collectorMetadata(mesh, trafficDirection, destinationService, proxy)
+		return convertGrpcLoggingBackend(backend, variables, metadata)
+	}
+
+	if len(backend.JsonFormat) > 0 {
+		return convertJsonLoggingBackend(backend, variables)
+	}
+
 	formatString := defaultFormat
 	if backend.Format != "" {
 		formatString = backend.Format
@@ -44,39 +64,151 @@ func convertLoggingBackend(mesh string, trafficDirection envoy.TrafficDirection,
 		return nil, errors.Wrapf(err, "invalid access log format string: %s", formatString)
 	}
 
-	variables := accesslog.InterpolationVariables{
-		accesslog.CMD_KUMA_SOURCE_ADDRESS:              net.JoinHostPort(proxy.Dataplane.GetIP(), "0"), // deprecated variable
-		accesslog.CMD_KUMA_SOURCE_ADDRESS_WITHOUT_PORT: proxy.Dataplane.GetIP(),                        // replacement variable
-		accesslog.CMD_KUMA_SOURCE_SERVICE:              sourceService,
-		accesslog.CMD_KUMA_DESTINATION_SERVICE:         destinationService,
-		accesslog.CMD_KUMA_MESH:                        mesh,
-		accesslog.CMD_KUMA_TRAFFIC_DIRECTION:           string(trafficDirection),
+	format, err = format.Interpolate(variables)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to interpolate access log format string with Kuma-specific variables: %s", formatString)
+	}
+
+	switch backend.GetType() {
+	case mesh_proto.LoggingFileType:
+		return fileAccessLog(textFormat(format), backend.Conf)
+	case mesh_proto.LoggingTcpType:
+		return tcpAccessLog(format, format.String(), backend.Conf)
+	default: // should be caught by validator
+		return nil, errors.Errorf("could not convert LoggingBackend of type %T to AccessLog", backend.GetType())
+	}
+}
+
+func convertJsonLoggingBackend(backend *mesh_proto.LoggingBackend, variables accesslog.InterpolationVariables) (*envoy_accesslog.AccessLog, error) {
+	format, err := accesslog.ParseJsonFormat(backend.JsonFormat)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid access log JSON format")
 	}
 
 	format, err = format.Interpolate(variables)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to interpolate access log format string with Kuma-specific variables: %s", formatString)
+		return nil, errors.Wrap(err, "failed to interpolate access log JSON format with Kuma-specific variables")
 	}
 
 	switch backend.GetType() {
 	case mesh_proto.LoggingFileType:
-		return fileAccessLog(format, backend.Conf)
+		return fileAccessLog(jsonFormat(format), backend.Conf)
 	case mesh_proto.LoggingTcpType:
-		return tcpAccessLog(format, backend.Conf)
+		// kuma-dp recognizes JSON format by the "json;" prefix
+		return tcpAccessLog(format, fmt.Sprintf("json;%s", format), backend.Conf)
 	default: // should be caught by validator
 		return nil, errors.Errorf("could not convert LoggingBackend of type %T to AccessLog", backend.GetType())
 	}
 }
 
-func tcpAccessLog(format *accesslog.AccessLogFormat, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
+// convertGrpcLoggingBackend makes Envoy stream access logs to the collector built into kuma-dp.
+// Unless a format is set, the collector renders log entries as structured JSON records.
+func convertGrpcLoggingBackend(backend *mesh_proto.LoggingBackend, variables accesslog.InterpolationVariables, metadata accesslog.CollectorMetadata) (*envoy_accesslog.AccessLog, error) {
+	cfg := mesh_proto.GrpcLoggingBackendConfig{}
+	if err := proto.ToTyped(backend.Conf, &cfg); err != nil {
+		return nil, errors.Wrap(err, "could not parse backend config")
+	}
+	collectorConfig := &accesslog.CollectorConfig{
+		Path:     cfg.Path,
+		Address:  cfg.Address,
+		Url:      cfg.Url,
+		Metadata: metadata,
+	}
+
+	var format accesslog.HttpLogConfigurer
+	switch {
+	case len(backend.JsonFormat) > 0:
+		jsonFormat, err := accesslog.ParseJsonFormat(backend.JsonFormat)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid access log JSON format")
+		}
+		jsonFormat, err = jsonFormat.Interpolate(variables)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to interpolate access log JSON format with Kuma-specific variables")
+		}
+		collectorConfig.JsonFormat = jsonFormat.Strings()
+		format = jsonFormat
+	case backend.Format != "":
+		textFormat, err := accesslog.ParseFormat(backend.Format + "\n")
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid access log format string: %s", backend.Format)
+		}
+		textFormat, err = textFormat.Interpolate(variables)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to interpolate access log format string with Kuma-specific variables: %s", backend.Format)
+		}
+		collectorConfig.Format = textFormat.String()
+		format = textFormat
+	}
+
+	logName, err := collectorConfig.LogName()
+	if err != nil {
+		return nil, err
+	}
+	return grpcAccessLog(logName, format)
+}
+
+func collectorMetadata(mesh string, trafficDirection envoy.TrafficDirection, destinationService string, proxy *core_xds.Proxy) accesslog.CollectorMetadata {
+	source := map[string]string{}
+	if proxy.Dataplane != nil {
+		tags := proxy.Dataplane.Spec.TagSet()
+		for _, key := range tags.Keys() {
+			source[key] = strings.Join(tags.UniqueValues(key), ",")
+		}
+	}
+	return accesslog.CollectorMetadata{
+		Mesh:             mesh,
+		TrafficDirection: string(trafficDirection),
+		Source:           source,
+		Destination: map[string]string{
+			mesh_proto.ServiceTag: destinationService,
+		},
+	}
+}
+
+func textFormat(format *accesslog.AccessLogFormat) *envoy_core.SubstitutionFormatString {
+	return &envoy_core.SubstitutionFormatString{
+		Format: &envoy_core.SubstitutionFormatString_TextFormatSource{
+			TextFormatSource: &envoy_core.DataSource{
+				Specifier: &envoy_core.DataSource_InlineString{
+					InlineString: format.String(),
+				},
+			},
+		},
+	}
+}
+
+func jsonFormat(format *accesslog.AccessLogJsonFormat) *envoy_core.SubstitutionFormatString {
+	fields := map[string]*structpb.Value{}
+	for key, value := range format.Strings() {
+		fields[key] = &structpb.Value{
+			Kind: &structpb.Value_StringValue{
+				StringValue: value,
+			},
+		}
+	}
+	return &envoy_core.SubstitutionFormatString{
+		Format: &envoy_core.SubstitutionFormatString_JsonFormat{
+			JsonFormat: &structpb.Struct{
+				Fields: fields,
+			},
+		},
+	}
+}
+
+func tcpAccessLog(format accesslog.HttpLogConfigurer, formatString string, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
 	cfg := mesh_proto.TcpLoggingBackendConfig{}
 	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
 		return nil, errors.Wrap(err, "could not parse backend config")
 	}
+	return grpcAccessLog(fmt.Sprintf("%s;%s", cfg.Address, formatString), format)
+}
 
+// grpcAccessLog streams access logs to kuma-dp, which identifies a log stream by a given log name.
+func grpcAccessLog(logName string, format accesslog.HttpLogConfigurer) (*envoy_accesslog.AccessLog, error) {
 	httpGrpcAccessLog := &access_loggers_grpc.HttpGrpcAccessLogConfig{
 		CommonConfig: &access_loggers_grpc.CommonGrpcAccessLogConfig{
-			LogName:             fmt.Sprintf("%s;%s", cfg.Address, format.String()),
+			LogName:             logName,
 			TransportApiVersion: envoy_core.ApiVersion_V3,
 			GrpcService: &envoy_core.GrpcService{
 				TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
@@ -87,8 +219,10 @@ func tcpAccessLog(format *accesslog.AccessLogFormat, cfgStr *structpb.Struct) (*
 			},
 		},
 	}
-	if err := format.ConfigureHttpLog(httpGrpcAccessLog); err != nil {
-		return nil, errors.Wrapf(err, "failed to configure %T according to the format string: %s", httpGrpcAccessLog, format)
+	if format != nil {
+		if err := format.ConfigureHttpLog(httpGrpcAccessLog); err != nil {
+			return nil, errors.Wrapf(err, "failed to configure %T according to the format string: %s", httpGrpcAccessLog, format)
+		}
 	}
 	marshalled, err := proto.MarshalAnyDeterministic(httpGrpcAccessLog)
 	if err != nil {
@@ -102,7 +236,7 @@ func tcpAccessLog(format *accesslog.AccessLogFormat, cfgStr *structpb.Struct) (*
 	}, nil
 }
 
-func fileAccessLog(format *accesslog.AccessLogFormat, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
+func fileAccessLog(format *envoy_core.SubstitutionFormatString, cfgStr *structpb.Struct) (*envoy_accesslog.AccessLog, error) {
 	cfg := mesh_proto.FileLoggingBackendConfig{}
 	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
 		return nil, errors.Wrap(err, "could not parse backend config")
@@ -110,15 +244,7 @@ func fileAccessLog(format *accesslog.AccessLogFormat, cfgStr *structpb.Struct) (
 
 	fileAccessLog := &access_loggers_file.FileAccessLog{
 		AccessLogFormat: &access_loggers_file.FileAccessLog_LogFormat{
-			LogFormat: &envoy_core.SubstitutionFormatString{
-				Format: &envoy_core.SubstitutionFormatString_TextFormatSource{
-					TextFormatSource: &envoy_core.DataSource{
-						Specifier: &envoy_core.DataSource_InlineString{
-							InlineString: format.String(),
-						},
-					},
-				},
-			},
+			LogFormat: format,
 		},
 		Path: cfg.Path,
 	}
@@ -1,8 +1,23 @@
 package v3
 
 import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
 )
 
 const defaultHttpAccessLogFormat = `[%START_TIME%] %KUMA_MESH% "%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%" %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% %RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% "%REQ(X-FORWARDED-FOR)%" "%REQ(USER-AGENT)%" "%REQ(X-REQUEST-ID)%" "%REQ(:AUTHORITY)%" "%KUMA_SOURCE_SERVICE%" "%KUMA_DESTINATION_SERVICE%" "%KUMA_SOURCE_ADDRESS_WITHOUT_PORT%" "%UPSTREAM_HOST%"
@@ -10,6 +25,8 @@ const defaultHttpAccessLogFormat = `[%START_TIME%] %KUMA_MESH% "%REQ(:METHOD)% %
 
 type HttpAccessLogConfigurer struct {
 	AccessLogConfigurer
+	// Filters of the TrafficLog. A request is logged if it matches at least one of them.
+	Filters []*mesh_proto.TrafficLog_Conf_Filter
 }
 
 func (c *HttpAccessLogConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
@@ -18,8 +35,176 @@ func (c *HttpAccessLogConfigurer) Configure(filterChain *envoy_listener.FilterCh
 		return err
 	}
 
+	accessLog.Filter, err = accessLogFilter(c.Filters)
+	if err != nil {
+		return err
+	}
+
 	return UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
 		hcm.AccessLog = append(hcm.AccessLog, accessLog)
 		return nil
 	})
 }
+
+func accessLogFilter(filters []*mesh_proto.TrafficLog_Conf_Filter) (*envoy_accesslog.AccessLogFilter, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	var envoyFilters []*envoy_accesslog.AccessLogFilter
+	for i, filter := range filters {
+		envoyFilter, err := andAccessLogFilter(i, filter)
+		if err != nil {
+			return nil, err
+		}
+		envoyFilters = append(envoyFilters, envoyFilter)
+	}
+	if len(envoyFilters) == 1 {
+		return envoyFilters[0], nil
+	}
+	return &envoy_accesslog.AccessLogFilter{
+		FilterSpecifier: &envoy_accesslog.AccessLogFilter_OrFilter{
+			OrFilter: &envoy_accesslog.OrFilter{
+				Filters: envoyFilters,
+			},
+		},
+	}, nil
+}
+
+// andAccessLogFilter converts all the conditions of a single TrafficLog filter.
+// Runtime keys are derived only from the position of the filter in the TrafficLog, so they are shared
+// by all the listeners and overriding a key in the runtime changes filters at this position in every listener.
+func andAccessLogFilter(idx int, filter *mesh_proto.TrafficLog_Conf_Filter) (*envoy_accesslog.AccessLogFilter, error) {
+	runtimeKey := func(name string) string {
+		return fmt.Sprintf("kuma.access_log.filter_%d.%s", idx, name)
+	}
+
+	var envoyFilters []*envoy_accesslog.AccessLogFilter
+	if statusCodes := filter.GetStatusCodes(); statusCodes != nil {
+		envoyFilters = append(envoyFilters,
+			statusCodeFilter(envoy_accesslog.ComparisonFilter_GE, statusCodes.GetMin(), runtimeKey("min_status_code")),
+			statusCodeFilter(envoy_accesslog.ComparisonFilter_LE, statusCodes.GetMax(), runtimeKey("max_status_code")),
+		)
+	}
+	if filter.GetMinDuration() != nil {
+		minDuration, err := ptypes.Duration(filter.GetMinDuration())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid min duration of the access log filter")
+		}
+		envoyFilters = append(envoyFilters, &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_DurationFilter{
+				DurationFilter: &envoy_accesslog.DurationFilter{
+					Comparison: comparison(envoy_accesslog.ComparisonFilter_GE, uint32(minDuration.Milliseconds()), runtimeKey("min_duration")),
+				},
+			},
+		})
+	}
+	envoyFilters = append(envoyFilters, matchFilters(filter.GetMatch())...)
+	if filter.GetSampling() != nil {
+		envoyFilters = append(envoyFilters, &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_RuntimeFilter{
+				RuntimeFilter: &envoy_accesslog.RuntimeFilter{
+					RuntimeKey: runtimeKey("sampling"),
+					PercentSampled: &envoy_type.FractionalPercent{
+						Numerator:   uint32(filter.GetSampling().GetValue() * 10000),
+						Denominator: envoy_type.FractionalPercent_MILLION,
+					},
+				},
+			},
+		})
+	}
+
+	if len(envoyFilters) == 1 {
+		return envoyFilters[0], nil
+	}
+	return &envoy_accesslog.AccessLogFilter{
+		FilterSpecifier: &envoy_accesslog.AccessLogFilter_AndFilter{
+			AndFilter: &envoy_accesslog.AndFilter{
+				Filters: envoyFilters,
+			},
+		},
+	}, nil
+}
+
+func statusCodeFilter(op envoy_accesslog.ComparisonFilter_Op, value uint32, runtimeKey string) *envoy_accesslog.AccessLogFilter {
+	return &envoy_accesslog.AccessLogFilter{
+		FilterSpecifier: &envoy_accesslog.AccessLogFilter_StatusCodeFilter{
+			StatusCodeFilter: &envoy_accesslog.StatusCodeFilter{
+				Comparison: comparison(op, value, runtimeKey),
+			},
+		},
+	}
+}
+
+func comparison(op envoy_accesslog.ComparisonFilter_Op, value uint32, runtimeKey string) *envoy_accesslog.ComparisonFilter {
+	return &envoy_accesslog.ComparisonFilter{
+		Op: op,
+		Value: &envoy_core.RuntimeUInt32{
+			DefaultValue: value,
+			RuntimeKey:   runtimeKey,
+		},
+	}
+}
+
+func matchFilters(match *mesh_proto.TrafficRoute_Http_Match) []*envoy_accesslog.AccessLogFilter {
+	if match == nil {
+		return nil
+	}
+	var envoyFilters []*envoy_accesslog.AccessLogFilter
+	headerFilter := func(name string, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) *envoy_accesslog.AccessLogFilter {
+		return &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_HeaderFilter{
+				HeaderFilter: &envoy_accesslog.HeaderFilter{
+					Header: envoy_routes.HeaderMatcher(name, matcher),
+				},
+			},
+		}
+	}
+	if match.GetMethod() != nil {
+		envoyFilters = append(envoyFilters, headerFilter(":method", match.GetMethod()))
+	}
+	if match.GetPath() != nil {
+		envoyFilters = append(envoyFilters, &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_HeaderFilter{
+				HeaderFilter: &envoy_accesslog.HeaderFilter{
+					Header: pathMatcher(match.GetPath()),
+				},
+			},
+		})
+	}
+	var headers []string
+	for name := range match.GetHeaders() {
+		headers = append(headers, name)
+	}
+	sort.Strings(headers) // sort for stability of Envoy config
+	for _, name := range headers {
+		envoyFilters = append(envoyFilters, headerFilter(name, match.GetHeaders()[name]))
+	}
+	return envoyFilters
+}
+
+// pathMatcher matches the :path header, which contains the query string, the way TrafficRoute matches the path without it.
+// A prefix cannot be affected by the query string, an exact path is allowed to be followed by any query string.
+// A regex is allowed to be followed by any query string as well, but unlike in TrafficRoute
+// it is still matched against the query string if it can match the '?' character.
+func pathMatcher(matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) *envoy_route.HeaderMatcher {
+	var regex string
+	switch matcher.MatcherType.(type) {
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact:
+		regex = regexp.QuoteMeta(matcher.GetExact())
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Regex:
+		regex = fmt.Sprintf("(?:%s)", matcher.GetRegex())
+	default:
+		return envoy_routes.HeaderMatcher(":path", matcher)
+	}
+	return &envoy_route.HeaderMatcher{
+		Name: ":path",
+		HeaderMatchSpecifier: &envoy_route.HeaderMatcher_SafeRegexMatch{
+			SafeRegexMatch: &envoy_type_matcher.RegexMatcher{
+				EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+					GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{},
+				},
+				Regex: regex + `(\?.*)?`,
+			},
+		},
+	}
+}
@@ -1,6 +1,10 @@
 package v3_test
 
 import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -25,6 +29,7 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
 		statsName        string
 		routeName        string
 		backend          *mesh_proto.LoggingBackend
+		filters          []*mesh_proto.TrafficLog_Conf_Filter
 		expected         string
 	}
 
@@ -64,7 +69,7 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
 				Configure(OutboundListener(given.listenerName, given.listenerAddress, given.listenerPort, given.listenerProtocol)).
 				Configure(FilterChain(NewFilterChainBuilder(envoy.APIV3).
 					Configure(HttpConnectionManager(given.statsName, false)).
-					Configure(HttpAccessLog(mesh, envoy.TrafficDirectionOutbound, sourceService, destinationService, given.backend, proxy)))).
+					Configure(HttpAccessLog(mesh, envoy.TrafficDirectionOutbound, sourceService, destinationService, given.backend, given.filters, proxy)))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -190,6 +195,374 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
                   - name: envoy.filters.http.router
                   statPrefix: backend
             name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with file access log in JSON format", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name: "file",
+				JsonFormat: map[string]string{
+					"start_time":  "%START_TIME%",
+					"source":      "%KUMA_SOURCE_SERVICE%",
+					"destination": "%KUMA_DESTINATION_SERVICE%",
+					"method":      "%REQ(:METHOD)%",
+				},
+				Type: mesh_proto.LoggingFileType,
+				Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+					Path: "/tmp/log",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.file
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                      logFormat:
+                        jsonFormat:
+                          destination: backend
+                          method: '%REQ(:method)%'
+                          source: web
+                          start_time: '%START_TIME%'
+                      path: /tmp/log
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with tcp access log in JSON format", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name: "tcp",
+				JsonFormat: map[string]string{
+					"start_time": "%START_TIME%",
+					"source":     "%KUMA_SOURCE_SERVICE%",
+					"origin":     "%REQ(ORIGIN)%",
+					"server":     "%RESP(SERVER)%",
+				},
+				Type: mesh_proto.LoggingTcpType,
+				Conf: util_proto.MustToStruct(&mesh_proto.TcpLoggingBackendConfig{
+					Address: "127.0.0.1:1234",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.http_grpc
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig
+                      additionalRequestHeadersToLog:
+                      - origin
+                      additionalResponseHeadersToLog:
+                      - server
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: '127.0.0.1:1234;json;{"origin":"%REQ(origin)%","server":"%RESP(server)%","source":"web","start_time":"%START_TIME%"}'
+                        transportApiVersion: V3
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with grpc access log", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name: "grpc",
+				Type: mesh_proto.LoggingGrpcType,
+				Conf: util_proto.MustToStruct(&mesh_proto.GrpcLoggingBackendConfig{
+					Path: "/tmp/log",
+					Url:  "http://collector.local:8080/logs",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.http_grpc
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: 'grpc;{"path":"/tmp/log","url":"http://collector.local:8080/logs","metadata":{"mesh":"demo","trafficDirection":"OUTBOUND","source":{"kuma.io/service":"web"},"destination":{"kuma.io/service":"backend"}}}'
+                        transportApiVersion: V3
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with grpc access log and format string", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name:   "grpc",
+				Format: `%REQ(ORIGIN)% %KUMA_SOURCE_SERVICE%`,
+				Type:   mesh_proto.LoggingGrpcType,
+				Conf: util_proto.MustToStruct(&mesh_proto.GrpcLoggingBackendConfig{
+					Address: "127.0.0.1:1234",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.http_grpc
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig
+                      additionalRequestHeadersToLog:
+                      - origin
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: 'grpc;{"address":"127.0.0.1:1234","format":"%REQ(origin)% web\n","metadata":{"mesh":"demo","trafficDirection":"OUTBOUND","source":{"kuma.io/service":"web"},"destination":{"kuma.io/service":"backend"}}}'
+                        transportApiVersion: V3
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with filtered file access log", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name:   "file",
+				Format: "%RESPONSE_CODE%",
+				Type:   mesh_proto.LoggingFileType,
+				Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+					Path: "/tmp/log",
+				}),
+			},
+			filters: []*mesh_proto.TrafficLog_Conf_Filter{
+				{
+					StatusCodes: &mesh_proto.TrafficLog_Conf_Filter_StatusCodes{
+						Min: 500,
+						Max: 599,
+					},
+				},
+				{
+					StatusCodes: &mesh_proto.TrafficLog_Conf_Filter_StatusCodes{
+						Min: 200,
+						Max: 499,
+					},
+					MinDuration: ptypes.DurationProto(1500 * time.Millisecond),
+					Match: &mesh_proto.TrafficRoute_Http_Match{
+						Method: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+							MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact{
+								Exact: "GET",
+							},
+						},
+						Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+							MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix{
+								Prefix: "/api",
+							},
+						},
+					},
+					Sampling: &wrappers.DoubleValue{Value: 1.5},
+				},
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.file
+                    filter:
+                      orFilter:
+                        filters:
+                        - andFilter:
+                            filters:
+                            - statusCodeFilter:
+                                comparison:
+                                  op: GE
+                                  value:
+                                    defaultValue: 500
+                                    runtimeKey: kuma.access_log.filter_0.min_status_code
+                            - statusCodeFilter:
+                                comparison:
+                                  op: LE
+                                  value:
+                                    defaultValue: 599
+                                    runtimeKey: kuma.access_log.filter_0.max_status_code
+                        - andFilter:
+                            filters:
+                            - statusCodeFilter:
+                                comparison:
+                                  op: GE
+                                  value:
+                                    defaultValue: 200
+                                    runtimeKey: kuma.access_log.filter_1.min_status_code
+                            - statusCodeFilter:
+                                comparison:
+                                  op: LE
+                                  value:
+                                    defaultValue: 499
+                                    runtimeKey: kuma.access_log.filter_1.max_status_code
+                            - durationFilter:
+                                comparison:
+                                  op: GE
+                                  value:
+                                    defaultValue: 1500
+                                    runtimeKey: kuma.access_log.filter_1.min_duration
+                            - headerFilter:
+                                header:
+                                  exactMatch: GET
+                                  name: :method
+                            - headerFilter:
+                                header:
+                                  name: :path
+                                  prefixMatch: /api
+                            - runtimeFilter:
+                                percentSampled:
+                                  denominator: MILLION
+                                  numerator: 15000
+                                runtimeKey: kuma.access_log.filter_1.sampling
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                      logFormat:
+                        textFormatSource:
+                          inlineString: |
+                            %RESPONSE_CODE%
+                      path: /tmp/log
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic http_connection_manager with file access log filtered by path regardless of the query string", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name:   "file",
+				Format: "%RESPONSE_CODE%",
+				Type:   mesh_proto.LoggingFileType,
+				Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+					Path: "/tmp/log",
+				}),
+			},
+			filters: []*mesh_proto.TrafficLog_Conf_Filter{
+				{
+					Match: &mesh_proto.TrafficRoute_Http_Match{
+						Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+							MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact{
+								Exact: "/api/v1.0/users",
+							},
+						},
+					},
+				},
+				{
+					Match: &mesh_proto.TrafficRoute_Http_Match{
+						Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+							MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Regex{
+								Regex: "/api/v1.0/users/[0-9]+",
+							},
+						},
+					},
+				},
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.access_loggers.file
+                    filter:
+                      orFilter:
+                        filters:
+                        - headerFilter:
+                            header:
+                              name: :path
+                              safeRegexMatch:
+                                googleRe2: {}
+                                regex: '/api/v1\.0/users(\?.*)?'
+                        - headerFilter:
+                            header:
+                              name: :path
+                              safeRegexMatch:
+                                googleRe2: {}
+                                regex: '(?:/api/v1.0/users/[0-9]+)(\?.*)?'
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                      logFormat:
+                        textFormatSource:
+                          inlineString: |
+                            %RESPONSE_CODE%
+                      path: /tmp/log
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: backend
+            name: outbound:127.0.0.1:27070
             trafficDirection: OUTBOUND`,
 		}),
 	)
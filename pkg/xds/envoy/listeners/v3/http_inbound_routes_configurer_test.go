@@ -311,6 +311,82 @@ var _ = Describe("HttpInboundRouteConfigurer", func() {
                               maxTokens: 100
                               tokensPerFill: 100
                   statPrefix: localhost_8080
+`,
+		}),
+		Entry("basic http_connection_manager with a single destination cluster and global rate limiter", testCase{
+			listenerName:    "inbound:192.168.0.1:8080",
+			listenerAddress: "192.168.0.1",
+			listenerPort:    8080,
+			statsName:       "localhost:8080",
+			service:         "backend",
+			routes: envoy_common.Routes{routeWithRateLimiter(&v1alpha1.RateLimit{
+				Sources: []*v1alpha1.Selector{
+					{
+						Match: map[string]string{
+							"kuma.io/service": "web",
+							"version":         "*",
+						},
+					},
+				},
+				Destinations: []*v1alpha1.Selector{
+					{
+						Match: map[string]string{
+							"kuma.io/service": "backend",
+						},
+					},
+				},
+				Conf: &v1alpha1.RateLimit_Conf{
+					Http: &v1alpha1.RateLimit_Conf_Http{
+						Mode: v1alpha1.RateLimit_Conf_Http_GLOBAL,
+					},
+				},
+			})},
+			expected: `
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  forwardClientCertDetails: SANITIZE_SET
+                  setCurrentClientCertDetails:
+                    uri: true
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  routeConfig:
+                    name: inbound:backend
+                    validateClusters: false
+                    requestHeadersToRemove:
+                    - x-kuma-tags
+                    virtualHosts:
+                    - domains:
+                      - '*'
+                      name: backend
+                      routes:
+                      - match:
+                          headers:
+                          - name: x-kuma-tags
+                            safeRegexMatch:
+                              googleRe2: {}
+                              regex: .*&kuma.io/service=[^&]*web[,&].*&version=.*
+                          prefix: /
+                        route:
+                          cluster: localhost:8080
+                          rateLimits:
+                          - actions:
+                            - genericKey:
+                                descriptorKey: destination.kuma.io/service
+                                descriptorValue: backend
+                            - genericKey:
+                                descriptorKey: source.kuma.io/service
+                                descriptorValue: web
+                          timeout: 0s
+                  statPrefix: localhost_8080
 `,
 		}),
 	)
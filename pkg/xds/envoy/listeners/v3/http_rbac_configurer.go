@@ -0,0 +1,160 @@
+package v3
+
+import (
+	"sort"
+
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	rbac_config "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/util/proto"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
+)
+
+// HttpRBACConfigurer restricts requests coming to the inbound to the ones matching HTTP rules of the Traffic Permission.
+// Identity of the source is still verified by the network RBAC filter, which has to be configured alongside.
+type HttpRBACConfigurer struct {
+	Permission *mesh_core.TrafficPermissionResource
+}
+
+func (c *HttpRBACConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.Permission == nil || len(c.Permission.Spec.GetConf().GetHttp().GetRules()) == 0 {
+		return nil
+	}
+
+	rbacMarshalled, err := proto.MarshalAnyDeterministic(createHttpRbacRule(c.Permission))
+	if err != nil {
+		return err
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		// RBAC filter should be the first in the chain
+		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{
+			{
+				Name: "envoy.filters.http.rbac",
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: rbacMarshalled,
+				},
+			},
+		}, manager.HttpFilters...)
+		return nil
+	})
+}
+
+func createHttpRbacRule(permission *mesh_core.TrafficPermissionResource) *rbac.RBAC {
+	policy := createPolicy(permission)
+	policy.Permissions = nil
+	for _, rule := range permission.Spec.GetConf().GetHttp().GetRules() {
+		policy.Permissions = append(policy.Permissions, permissionFromHttpRule(rule)) // the relation between many rules is OR
+	}
+
+	return &rbac.RBAC{
+		Rules: &rbac_config.RBAC{
+			Action: rbac_config.RBAC_ALLOW,
+			Policies: map[string]*rbac_config.Policy{
+				permission.GetMeta().GetName(): policy,
+			},
+		},
+	}
+}
+
+func permissionFromHttpRule(rule *mesh_proto.TrafficPermission_Conf_Http_Rule) *rbac_config.Permission {
+	var permissions []*rbac_config.Permission
+
+	if len(rule.GetMethods()) > 0 {
+		var methods []*rbac_config.Permission
+		for _, method := range rule.GetMethods() {
+			methods = append(methods, headerPermission(":method", &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+				MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact{
+					Exact: method,
+				},
+			}))
+		}
+		permissions = append(permissions, orPermissions(methods)) // request can match any of the methods
+	}
+
+	if rule.GetPath() != nil {
+		permissions = append(permissions, &rbac_config.Permission{
+			Rule: &rbac_config.Permission_UrlPath{
+				UrlPath: &envoy_type_matcher.PathMatcher{
+					Rule: &envoy_type_matcher.PathMatcher_Path{
+						Path: stringMatcher(rule.GetPath()),
+					},
+				},
+			},
+		})
+	}
+
+	var headers []string
+	for name := range rule.GetHeaders() {
+		headers = append(headers, name)
+	}
+	sort.Strings(headers) // sort for stability of Envoy config
+	for _, name := range headers {
+		permissions = append(permissions, headerPermission(name, rule.GetHeaders()[name]))
+	}
+
+	if len(permissions) == 1 {
+		return permissions[0]
+	}
+	return &rbac_config.Permission{
+		Rule: &rbac_config.Permission_AndRules{ // all the elements of the rule have to match therefore AND
+			AndRules: &rbac_config.Permission_Set{
+				Rules: permissions,
+			},
+		},
+	}
+}
+
+func orPermissions(permissions []*rbac_config.Permission) *rbac_config.Permission {
+	if len(permissions) == 1 {
+		return permissions[0]
+	}
+	return &rbac_config.Permission{
+		Rule: &rbac_config.Permission_OrRules{
+			OrRules: &rbac_config.Permission_Set{
+				Rules: permissions,
+			},
+		},
+	}
+}
+
+func headerPermission(name string, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) *rbac_config.Permission {
+	return &rbac_config.Permission{
+		Rule: &rbac_config.Permission_Header{
+			Header: envoy_routes.HeaderMatcher(name, matcher),
+		},
+	}
+}
+
+func stringMatcher(matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) *envoy_type_matcher.StringMatcher {
+	switch matcher.GetMatcherType().(type) {
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_Prefix{
+				Prefix: matcher.GetPrefix(),
+			},
+		}
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Regex:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_SafeRegex{
+				SafeRegex: &envoy_type_matcher.RegexMatcher{
+					EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+						GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{},
+					},
+					Regex: matcher.GetRegex(),
+				},
+			},
+		}
+	default:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+				Exact: matcher.GetExact(),
+			},
+		}
+	}
+}
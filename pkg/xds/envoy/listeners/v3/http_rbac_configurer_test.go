@@ -0,0 +1,157 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("HttpRBACConfigurer", func() {
+	type testCase struct {
+		rbacEnabled bool
+		permission  string
+		expected    string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			permission := &mesh_core.TrafficPermissionResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "tp-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.TrafficPermission{},
+			}
+			Expect(util_proto.FromYAML([]byte(given.permission), permission.Spec)).To(Succeed())
+
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy.APIV3).
+				Configure(HttpConnectionManager("stats", false)).
+				Configure(HttpRBAC(given.rbacEnabled, permission)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("permission with http rules", testCase{
+			rbacEnabled: true,
+			permission: `
+            sources:
+            - match:
+                kuma.io/service: web
+            destinations:
+            - match:
+                kuma.io/service: backend
+            conf:
+              http:
+                rules:
+                - methods: [GET, HEAD]
+                  path:
+                    prefix: /api
+                  headers:
+                    x-role:
+                      exact: admin
+                    x-tenant:
+                      regex: 'team-.*'
+                - path:
+                    exact: /health
+`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.rbac
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.rbac.v3.RBAC
+                    rules:
+                      policies:
+                        tp-1:
+                          permissions:
+                          - andRules:
+                              rules:
+                              - orRules:
+                                  rules:
+                                  - header:
+                                      exactMatch: GET
+                                      name: :method
+                                  - header:
+                                      exactMatch: HEAD
+                                      name: :method
+                              - urlPath:
+                                  path:
+                                    prefix: /api
+                              - header:
+                                  exactMatch: admin
+                                  name: x-role
+                              - header:
+                                  name: x-tenant
+                                  safeRegexMatch:
+                                    googleRe2: {}
+                                    regex: team-.*
+                          - urlPath:
+                              path:
+                                exact: /health
+                          principals:
+                          - authenticated:
+                              principalName:
+                                exact: spiffe://default/web
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("permission without http rules", testCase{
+			rbacEnabled: true,
+			permission: `
+            sources:
+            - match:
+                kuma.io/service: web
+            destinations:
+            - match:
+                kuma.io/service: backend
+`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("RBAC disabled", testCase{
+			rbacEnabled: false,
+			permission: `
+            sources:
+            - match:
+                kuma.io/service: web
+            destinations:
+            - match:
+                kuma.io/service: backend
+            conf:
+              http:
+                rules:
+                - methods: [GET]
+`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+	)
+})
@@ -0,0 +1,36 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_mongo "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/mongo_proxy/v3"
+
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type MongoConfigurer struct {
+	StatsName string
+}
+
+var _ FilterChainConfigurer = &MongoConfigurer{}
+
+func (c *MongoConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	pbst, err := proto.MarshalAnyDeterministic(
+		&envoy_mongo.MongoProxy{
+			StatPrefix: util_xds.SanitizeMetric(c.StatsName),
+		})
+	if err != nil {
+		return err
+	}
+
+	filterChain.Filters = append([]*envoy_listener.Filter{
+		{
+			Name: "envoy.filters.network.mongo_proxy",
+			ConfigType: &envoy_listener.Filter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		},
+	}, filterChain.Filters...)
+	return nil
+}
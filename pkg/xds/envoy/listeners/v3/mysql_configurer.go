@@ -0,0 +1,36 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_mysql "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/mysql_proxy/v3"
+
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type MySQLConfigurer struct {
+	StatsName string
+}
+
+var _ FilterChainConfigurer = &MySQLConfigurer{}
+
+func (c *MySQLConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	pbst, err := proto.MarshalAnyDeterministic(
+		&envoy_mysql.MySQLProxy{
+			StatPrefix: util_xds.SanitizeMetric(c.StatsName),
+		})
+	if err != nil {
+		return err
+	}
+
+	filterChain.Filters = append([]*envoy_listener.Filter{
+		{
+			Name: "envoy.filters.network.mysql_proxy",
+			ConfigType: &envoy_listener.Filter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		},
+	}, filterChain.Filters...)
+	return nil
+}
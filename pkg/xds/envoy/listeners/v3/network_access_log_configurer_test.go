@@ -196,6 +196,52 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
                   cluster: db
                   statPrefix: db
             name: outbound:127.0.0.1:5432
+            trafficDirection: OUTBOUND`,
+		}),
+		Entry("basic tcp_proxy with file access log in JSON format", testCase{
+			listenerName:    "outbound:127.0.0.1:5432",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    5432,
+			statsName:       "db",
+			clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+				envoy_common.WithService("db"),
+				envoy_common.WithWeight(200),
+			)},
+			backend: &mesh_proto.LoggingBackend{
+				Name: "file",
+				JsonFormat: map[string]string{
+					"mesh":        "%KUMA_MESH%",
+					"destination": "%KUMA_DESTINATION_SERVICE%",
+					"sent":        "%BYTES_SENT%",
+				},
+				Type: mesh_proto.LoggingFileType,
+				Conf: util_proto.MustToStruct(&mesh_proto.FileLoggingBackendConfig{
+					Path: "/tmp/log",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  accessLog:
+                  - name: envoy.access_loggers.file
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog
+                      logFormat:
+                        jsonFormat:
+                          destination: db
+                          mesh: demo
+                          sent: '%BYTES_SENT%'
+                      path: /tmp/log
+                  cluster: db
+                  statPrefix: db
+            name: outbound:127.0.0.1:5432
             trafficDirection: OUTBOUND`,
 		}),
 	)
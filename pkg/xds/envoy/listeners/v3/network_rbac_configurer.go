@@ -16,23 +16,35 @@ import (
 )
 
 type NetworkRBACConfigurer struct {
-	StatsName  string
-	Permission *mesh_core.TrafficPermissionResource
+	StatsName       string
+	Permission      *mesh_core.TrafficPermissionResource
+	DenyPermissions []*mesh_core.TrafficPermissionResource
 }
 
 func (c *NetworkRBACConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
-	filter, err := createRbacFilter(c.StatsName, c.Permission)
+	filters := []*envoy_listener.Filter{}
+
+	// Denying filter goes before the allowing one, so deny takes precedence
+	if len(c.DenyPermissions) > 0 {
+		filter, err := createRbacFilter(createDenyRbacRule(c.StatsName, c.DenyPermissions))
+		if err != nil {
+			return err
+		}
+		filters = append(filters, filter)
+	}
+
+	filter, err := createRbacFilter(createRbacRule(c.StatsName, c.Permission))
 	if err != nil {
 		return err
 	}
+	filters = append(filters, filter)
 
-	// RBAC filter should be the first in the chain
-	filterChain.Filters = append([]*envoy_listener.Filter{filter}, filterChain.Filters...)
+	// RBAC filters should be the first in the chain
+	filterChain.Filters = append(filters, filterChain.Filters...)
 	return nil
 }
 
-func createRbacFilter(statsName string, permission *mesh_core.TrafficPermissionResource) (*envoy_listener.Filter, error) {
-	rbacRule := createRbacRule(statsName, permission)
+func createRbacFilter(rbacRule *rbac.RBAC) (*envoy_listener.Filter, error) {
 	rbacMarshalled, err := proto.MarshalAnyDeterministic(rbacRule)
 	if err != nil {
 		return nil, err
@@ -62,6 +74,21 @@ func createRbacRule(statsName string, permission *mesh_core.TrafficPermissionRes
 	}
 }
 
+func createDenyRbacRule(statsName string, permissions []*mesh_core.TrafficPermissionResource) *rbac.RBAC {
+	policies := make(map[string]*rbac_config.Policy)
+	for _, permission := range permissions {
+		policies[permission.GetMeta().GetName()] = createPolicy(permission)
+	}
+
+	return &rbac.RBAC{
+		Rules: &rbac_config.RBAC{
+			Action:   rbac_config.RBAC_DENY,
+			Policies: policies,
+		},
+		StatPrefix: fmt.Sprintf("%s.deny.", util_xds.SanitizeMetric(statsName)), // separate prefix so metrics of the denying filter do not mix with the allowing one
+	}
+}
+
 func createPolicy(permission *mesh_core.TrafficPermissionResource) *rbac_config.Policy {
 	principals := []*rbac_config.Principal{}
 
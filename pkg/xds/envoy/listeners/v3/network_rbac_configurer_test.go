@@ -28,6 +28,7 @@ var _ = Describe("NetworkRbacConfigurer", func() {
 		clusters         []envoy_common.Cluster
 		rbacEnabled      bool
 		permission       *mesh_core.TrafficPermissionResource
+		denyPermissions  []*mesh_core.TrafficPermissionResource
 		expected         string
 	}
 
@@ -38,7 +39,7 @@ var _ = Describe("NetworkRbacConfigurer", func() {
 				Configure(InboundListener(given.listenerName, given.listenerAddress, given.listenerPort, given.listenerProtocol)).
 				Configure(FilterChain(NewFilterChainBuilder(envoy_common.APIV3).
 					Configure(TcpProxy(given.statsName, given.clusters...)).
-					Configure(NetworkRBAC(given.listenerName, given.rbacEnabled, given.permission)))).
+					Configure(NetworkRBAC(given.listenerName, given.rbacEnabled, given.permission, given.denyPermissions...)))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -165,6 +166,104 @@ var _ = Describe("NetworkRbacConfigurer", func() {
                   '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
                   cluster: localhost:8080
                   statPrefix: localhost_8080
+`,
+		}),
+		Entry("basic tcp_proxy with network RBAC enabled and denying permissions", testCase{
+			listenerName:    "inbound:192.168.0.1:8080",
+			listenerAddress: "192.168.0.1",
+			listenerPort:    8080,
+			statsName:       "localhost:8080",
+			clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+				envoy_common.WithService("localhost:8080"),
+				envoy_common.WithWeight(200),
+			)},
+			rbacEnabled: true,
+			permission: &mesh_core.TrafficPermissionResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "tp-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.TrafficPermission{
+					Sources: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "*",
+							},
+						},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "backend1",
+							},
+						},
+					},
+				},
+			},
+			denyPermissions: []*mesh_core.TrafficPermissionResource{
+				{
+					Meta: &test_model.ResourceMeta{
+						Name: "deny-web1",
+						Mesh: "default",
+					},
+					Spec: &mesh_proto.TrafficPermission{
+						Sources: []*mesh_proto.Selector{
+							{
+								Match: map[string]string{
+									"kuma.io/service": "web1",
+								},
+							},
+						},
+						Destinations: []*mesh_proto.Selector{
+							{
+								Match: map[string]string{
+									"kuma.io/service": "*",
+								},
+							},
+						},
+						Action: mesh_proto.TrafficPermission_DENY,
+					},
+				},
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.rbac
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.rbac.v3.RBAC
+                  rules:
+                    action: DENY
+                    policies:
+                      deny-web1:
+                        permissions:
+                        - any: true
+                        principals:
+                        - authenticated:
+                            principalName:
+                              exact: spiffe://default/web1
+                  statPrefix: inbound_192_168_0_1_8080.deny.
+              - name: envoy.filters.network.rbac
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.rbac.v3.RBAC
+                  rules:
+                    policies:
+                      tp-1:
+                        permissions:
+                        - any: true
+                        principals:
+                        - any: true
+                  statPrefix: inbound_192_168_0_1_8080.
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  cluster: localhost:8080
+                  statPrefix: localhost_8080
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
 `,
 		}),
 	)
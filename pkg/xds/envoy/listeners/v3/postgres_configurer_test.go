@@ -0,0 +1,40 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("PostgresConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+			Configure(TcpProxy("db:5432", envoy_common.NewCluster(envoy_common.WithService("db")))).
+			Configure(Postgres("db:5432")).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(filterChain)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+        filters:
+        - name: envoy.filters.network.postgres_proxy
+          typedConfig:
+            '@type': type.googleapis.com/envoy.extensions.filters.network.postgres_proxy.v3alpha.PostgresProxy
+            statPrefix: db_5432
+        - name: envoy.filters.network.tcp_proxy
+          typedConfig:
+            '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+            cluster: db
+            statPrefix: db_5432
+`))
+	})
+})
@@ -1,45 +1,116 @@
 package v3
 
 import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
 	envoy_extensions_filters_http_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_extensions_filters_http_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/golang/protobuf/ptypes/duration"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/util/proto"
+	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
 )
 
+var defaultRateLimitServiceTimeout = &duration.Duration{Nanos: 20000000} // 20ms
+
 type RateLimitConfigurer struct {
 	RateLimits []*mesh_proto.RateLimit
+	// Mesh is used to configure rate limits in GLOBAL mode
+	Mesh *mesh_core.MeshResource
 }
 
 func (r *RateLimitConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
-	if !r.hasHttpRateLimit() {
-		return nil
+	var filters []*envoy_hcm.HttpFilter
+
+	if r.hasLocalRateLimit() {
+		config := &envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
+			StatPrefix: "rate_limit",
+		}
+		pbst, err := proto.MarshalAnyDeterministic(config)
+		if err != nil {
+			return err
+		}
+		filters = append(filters, &envoy_hcm.HttpFilter{
+			Name: "envoy.filters.http.local_ratelimit",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		})
 	}
 
-	config := &envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
-		StatPrefix: "rate_limit",
+	if r.hasGlobalRateLimit() {
+		pbst, err := proto.MarshalAnyDeterministic(r.globalRateLimitConfig())
+		if err != nil {
+			return err
+		}
+		filters = append(filters, &envoy_hcm.HttpFilter{
+			Name: "envoy.filters.http.ratelimit",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		})
 	}
 
-	pbst, err := proto.MarshalAnyDeterministic(config)
-	if err != nil {
-		return err
+	if len(filters) == 0 {
+		return nil
 	}
 
 	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
-		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{
-			{
-				Name: "envoy.filters.http.local_ratelimit",
-				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
-					TypedConfig: pbst,
-				},
-			},
-		}, manager.HttpFilters...)
+		manager.HttpFilters = append(filters, manager.HttpFilters...)
 		return nil
 	})
 }
 
-func (r *RateLimitConfigurer) hasHttpRateLimit() bool {
-	return len(r.RateLimits) > 0
+func (r *RateLimitConfigurer) globalRateLimitConfig() *envoy_extensions_filters_http_ratelimit_v3.RateLimit {
+	service := r.Mesh.Spec.GetRateLimits().GetService()
+	domain := service.GetDomain()
+	if domain == "" {
+		domain = r.Mesh.GetMeta().GetName()
+	}
+	timeout := service.GetTimeout()
+	if timeout == nil {
+		timeout = defaultRateLimitServiceTimeout
+	}
+	return &envoy_extensions_filters_http_ratelimit_v3.RateLimit{
+		Domain:          domain,
+		Timeout:         timeout,
+		FailureModeDeny: service.GetFailureModeDeny(),
+		RateLimitService: &envoy_config_ratelimit_v3.RateLimitServiceConfig{
+			GrpcService: &envoy_config_core_v3.GrpcService{
+				TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+						ClusterName: envoy_names.GetRateLimitServiceClusterName(),
+					},
+				},
+			},
+			TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		},
+	}
+}
+
+func (r *RateLimitConfigurer) hasLocalRateLimit() bool {
+	for _, rateLimit := range r.RateLimits {
+		if rateLimit.GetConf().GetHttp() != nil && !rateLimit.IsGlobal() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasGlobalRateLimit returns true only when the rate limit service is defined in the Mesh.
+// Otherwise rate limits in GLOBAL mode cannot be enforced.
+func (r *RateLimitConfigurer) hasGlobalRateLimit() bool {
+	if r.Mesh == nil || r.Mesh.Spec.GetRateLimits().GetService() == nil {
+		return false
+	}
+	for _, rateLimit := range r.RateLimits {
+		if rateLimit.IsGlobal() {
+			return true
+		}
+	}
+	return false
 }
@@ -6,6 +6,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	"github.com/kumahq/kuma/pkg/xds/envoy"
 	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
@@ -14,6 +16,7 @@ import (
 var _ = Describe("RateLimitConfigurer", func() {
 	type testCase struct {
 		input    []*mesh_proto.RateLimit
+		mesh     *mesh_core.MeshResource
 		expected string
 	}
 	DescribeTable("should generate proper Envoy config",
@@ -21,7 +24,7 @@ var _ = Describe("RateLimitConfigurer", func() {
 			// when
 			filterChain, err := NewFilterChainBuilder(envoy.APIV3).
 				Configure(HttpConnectionManager("stats", false)).
-				Configure(RateLimit(given.input)).
+				Configure(RateLimit(given.input, given.mesh)).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -63,5 +66,82 @@ var _ = Describe("RateLimitConfigurer", func() {
                 - name: envoy.filters.http.router
                 statPrefix: stats`,
 		}),
+		Entry("global mode", testCase{
+			input: []*mesh_proto.RateLimit{
+				{
+					Sources: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "web",
+							},
+						},
+					},
+					Conf: &mesh_proto.RateLimit_Conf{
+						Http: &mesh_proto.RateLimit_Conf_Http{
+							Mode: mesh_proto.RateLimit_Conf_Http_GLOBAL,
+						},
+					},
+				},
+			},
+			mesh: &mesh_core.MeshResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "demo",
+				},
+				Spec: &mesh_proto.Mesh{
+					RateLimits: &mesh_proto.RateLimits{
+						Service: &mesh_proto.RateLimits_Service{
+							Address:         "ratelimit.svc:8081",
+							FailureModeDeny: true,
+						},
+					},
+				},
+			},
+
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.ratelimit
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.ratelimit.v3.RateLimit
+                    domain: demo
+                    failureModeDeny: true
+                    rateLimitService:
+                      grpcService:
+                        envoyGrpc:
+                          clusterName: kuma:rate_limit_service
+                      transportApiVersion: V3
+                    timeout: 0.020s
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
+		Entry("global mode without rate limit service in the mesh", testCase{
+			input: []*mesh_proto.RateLimit{
+				{
+					Conf: &mesh_proto.RateLimit_Conf{
+						Http: &mesh_proto.RateLimit_Conf_Http{
+							Mode: mesh_proto.RateLimit_Conf_Http_GLOBAL,
+						},
+					},
+				},
+			},
+			mesh: &mesh_core.MeshResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "demo",
+				},
+				Spec: &mesh_proto.Mesh{},
+			},
+
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                statPrefix: stats`,
+		}),
 	)
 })
@@ -0,0 +1,51 @@
+package v3
+
+import (
+	"time"
+
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_redis "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/redis_proxy/v3"
+	"github.com/golang/protobuf/ptypes"
+
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const defaultRedisOpTimeout = 10 * time.Second
+
+// RedisProxyConfigurer terminates Redis connections and routes every command to the Cluster.
+// It replaces tcp_proxy, therefore it has to be the last filter in the chain.
+type RedisProxyConfigurer struct {
+	StatsName string
+	Cluster   string
+}
+
+var _ FilterChainConfigurer = &RedisProxyConfigurer{}
+
+func (c *RedisProxyConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	pbst, err := proto.MarshalAnyDeterministic(
+		&envoy_redis.RedisProxy{
+			StatPrefix: util_xds.SanitizeMetric(c.StatsName),
+			Settings: &envoy_redis.RedisProxy_ConnPoolSettings{
+				OpTimeout:          ptypes.DurationProto(defaultRedisOpTimeout),
+				EnableCommandStats: true,
+			},
+			PrefixRoutes: &envoy_redis.RedisProxy_PrefixRoutes{
+				CatchAllRoute: &envoy_redis.RedisProxy_PrefixRoutes_Route{
+					Cluster: c.Cluster,
+				},
+			},
+		})
+	if err != nil {
+		return err
+	}
+
+	filterChain.Filters = append(filterChain.Filters, &envoy_listener.Filter{
+		Name: "envoy.filters.network.redis_proxy",
+		ConfigType: &envoy_listener.Filter_TypedConfig{
+			TypedConfig: pbst,
+		},
+	})
+	return nil
+}
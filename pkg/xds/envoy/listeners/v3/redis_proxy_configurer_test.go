@@ -0,0 +1,40 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("RedisProxyConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+			Configure(RedisProxy("redis:6379", "redis")).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(filterChain)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+        filters:
+        - name: envoy.filters.network.redis_proxy
+          typedConfig:
+            '@type': type.googleapis.com/envoy.extensions.filters.network.redis_proxy.v3.RedisProxy
+            prefixRoutes:
+              catchAllRoute:
+                cluster: redis
+            settings:
+              enableCommandStats: true
+              opTimeout: 10s
+            statPrefix: redis_6379
+`))
+	})
+})
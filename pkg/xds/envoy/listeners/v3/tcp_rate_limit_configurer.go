@@ -0,0 +1,67 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_network_local_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/util/proto"
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+)
+
+type TcpRateLimitConfigurer struct {
+	StatsName  string
+	RateLimits []*mesh_proto.RateLimit
+}
+
+var _ FilterChainConfigurer = &TcpRateLimitConfigurer{}
+
+func (c *TcpRateLimitConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	tcp := c.tcpRateLimit()
+	if tcp == nil {
+		return nil
+	}
+
+	pbst, err := proto.MarshalAnyDeterministic(
+		&envoy_network_local_ratelimit.LocalRateLimit{
+			StatPrefix: util_xds.SanitizeMetric(c.StatsName),
+			TokenBucket: &envoy_type_v3.TokenBucket{
+				MaxTokens: tcp.GetConnections(),
+				TokensPerFill: &wrappers.UInt32Value{
+					Value: tcp.GetConnections(),
+				},
+				FillInterval: tcp.GetInterval(),
+			},
+		})
+	if err != nil {
+		return err
+	}
+
+	filterChain.Filters = append([]*envoy_listener.Filter{
+		{
+			Name: "envoy.filters.network.local_ratelimit",
+			ConfigType: &envoy_listener.Filter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		},
+	}, filterChain.Filters...)
+	return nil
+}
+
+// tcpRateLimit returns the configuration of the most specific RateLimit.
+// Connections cannot be distinguished by the source, so there is only one limit per inbound.
+// RateLimits scoped to specific sources are rejected by the validation, the ones created before are ignored,
+// otherwise they would limit connections of every source.
+func (c *TcpRateLimitConfigurer) tcpRateLimit() *mesh_proto.RateLimit_Conf_Tcp {
+	for _, rateLimit := range c.RateLimits {
+		if !rateLimit.AppliesToAllSources() {
+			continue
+		}
+		if tcp := rateLimit.GetConf().GetTcp(); tcp != nil {
+			return tcp
+		}
+	}
+	return nil
+}
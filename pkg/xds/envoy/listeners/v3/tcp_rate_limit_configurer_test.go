@@ -0,0 +1,135 @@
+package v3_test
+
+import (
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("TcpRateLimitConfigurer", func() {
+	type testCase struct {
+		input    []*mesh_proto.RateLimit
+		expected string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(TcpProxy("localhost:6379", envoy_common.NewCluster(envoy_common.WithService("localhost:6379")))).
+				Configure(TcpRateLimit("localhost:6379", given.input)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("the most specific tcp rate limit", testCase{
+			input: []*mesh_proto.RateLimit{
+				{
+					Conf: &mesh_proto.RateLimit_Conf{
+						Http: &mesh_proto.RateLimit_Conf_Http{
+							Requests: 100,
+						},
+					},
+				},
+				{
+					Sources: []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+					Conf: &mesh_proto.RateLimit_Conf{
+						Tcp: &mesh_proto.RateLimit_Conf_Tcp{
+							Connections: 10,
+							Interval:    &duration.Duration{Seconds: 1},
+						},
+					},
+				},
+				{
+					Sources: []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+					Conf: &mesh_proto.RateLimit_Conf{
+						Tcp: &mesh_proto.RateLimit_Conf_Tcp{
+							Connections: 100,
+							Interval:    &duration.Duration{Seconds: 1},
+						},
+					},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.local_ratelimit
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.local_ratelimit.v3.LocalRateLimit
+                statPrefix: localhost_6379
+                tokenBucket:
+                  fillInterval: 1s
+                  maxTokens: 10
+                  tokensPerFill: 10
+            - name: envoy.filters.network.tcp_proxy
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                cluster: localhost:6379
+                statPrefix: localhost_6379`,
+		}),
+		Entry("source-scoped tcp rate limit is not applied to other sources", testCase{
+			input: []*mesh_proto.RateLimit{
+				{
+					Sources: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("frontend")}},
+					Conf: &mesh_proto.RateLimit_Conf{
+						Tcp: &mesh_proto.RateLimit_Conf_Tcp{
+							Connections: 10,
+							Interval:    &duration.Duration{Seconds: 1},
+						},
+					},
+				},
+				{
+					Sources: []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+					Conf: &mesh_proto.RateLimit_Conf{
+						Tcp: &mesh_proto.RateLimit_Conf_Tcp{
+							Connections: 100,
+							Interval:    &duration.Duration{Seconds: 1},
+						},
+					},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.local_ratelimit
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.local_ratelimit.v3.LocalRateLimit
+                statPrefix: localhost_6379
+                tokenBucket:
+                  fillInterval: 1s
+                  maxTokens: 100
+                  tokensPerFill: 100
+            - name: envoy.filters.network.tcp_proxy
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                cluster: localhost:6379
+                statPrefix: localhost_6379`,
+		}),
+		Entry("no tcp rate limit", testCase{
+			input: []*mesh_proto.RateLimit{
+				{
+					Conf: &mesh_proto.RateLimit_Conf{
+						Http: &mesh_proto.RateLimit_Conf_Http{
+							Requests: 100,
+						},
+					},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.tcp_proxy
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                cluster: localhost:6379
+                statPrefix: localhost_6379`,
+		}),
+	)
+})
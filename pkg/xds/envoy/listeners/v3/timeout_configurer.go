@@ -23,7 +23,8 @@ func (c *TimeoutConfigurer) Configure(filterChain *envoy_listener.FilterChain) e
 	}
 
 	switch c.Protocol {
-	case core_mesh.ProtocolUnknown, core_mesh.ProtocolTCP, core_mesh.ProtocolKafka:
+	case core_mesh.ProtocolUnknown, core_mesh.ProtocolTCP, core_mesh.ProtocolKafka,
+		core_mesh.ProtocolMongo, core_mesh.ProtocolMySQL, core_mesh.ProtocolPostgres:
 		return UpdateTCPProxy(filterChain, func(proxy *envoy_tcp.TcpProxy) error {
 			proxy.IdleTimeout = ptypes.DurationProto(c.Conf.GetTcp().GetIdleTimeout().AsDuration())
 			return nil
@@ -40,6 +41,9 @@ func (c *TimeoutConfigurer) Configure(filterChain *envoy_listener.FilterChain) e
 			manager.StreamIdleTimeout = ptypes.DurationProto(c.Conf.GetGrpc().GetStreamIdleTimeout().AsDuration())
 			return nil
 		})
+	case core_mesh.ProtocolRedis:
+		// redis_proxy terminates the connection and has its own operation timeout
+		return nil
 	default:
 		return errors.Errorf("unsupported protocol %s", c.Protocol)
 	}
@@ -10,14 +10,20 @@ import (
 	"github.com/kumahq/kuma/pkg/util/proto"
 	"github.com/kumahq/kuma/pkg/xds/envoy/names"
 
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_trace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tracing "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
 	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 )
 
 type TracingConfigurer struct {
 	Backend *mesh_proto.TracingBackend
+	// Conf of the TrafficTrace that selected the backend. It may override sampling and define custom tags.
+	Conf *mesh_proto.TrafficTrace_Conf
+	// Service is a name of the service reported by tracers that require it (e.g. Datadog)
+	Service string
 }
 
 var _ FilterChainConfigurer = &TracingConfigurer{}
@@ -34,6 +40,26 @@ func (c *TracingConfigurer) Configure(filterChain *envoy_listener.FilterChain) e
 				Value: c.Backend.Sampling.Value,
 			}
 		}
+		if sampling := c.Conf.GetSampling(); sampling != nil {
+			if sampling.GetRandom() != nil {
+				hcm.Tracing.RandomSampling = &envoy_type.Percent{
+					Value: sampling.GetRandom().GetValue(),
+				}
+			}
+			if sampling.GetClient() != nil {
+				hcm.Tracing.ClientSampling = &envoy_type.Percent{
+					Value: sampling.GetClient().GetValue(),
+				}
+			}
+			if sampling.GetOverall() != nil {
+				hcm.Tracing.OverallSampling = &envoy_type.Percent{
+					Value: sampling.GetOverall().GetValue(),
+				}
+			}
+		}
+		for _, tag := range c.Conf.GetTags() {
+			hcm.Tracing.CustomTags = append(hcm.Tracing.CustomTags, customTag(tag))
+		}
 		switch c.Backend.Type {
 		case mesh_proto.TracingZipkinType:
 			tracing, err := zipkinConfig(c.Backend.Conf, c.Backend.Name)
@@ -41,11 +67,52 @@ func (c *TracingConfigurer) Configure(filterChain *envoy_listener.FilterChain) e
 				return err
 			}
 			hcm.Tracing.Provider = tracing
+		case mesh_proto.TracingDatadogType:
+			tracing, err := datadogConfig(c.Backend.Conf, c.Backend.Name, c.Service)
+			if err != nil {
+				return err
+			}
+			hcm.Tracing.Provider = tracing
+		case mesh_proto.TracingOpenTelemetryType:
+			tracing, err := openTelemetryConfig(c.Backend.Conf)
+			if err != nil {
+				return err
+			}
+			hcm.Tracing.Provider = tracing
 		}
 		return nil
 	})
 }
 
+func customTag(tag *mesh_proto.TrafficTrace_Conf_Tag) *envoy_tracing.CustomTag {
+	customTag := &envoy_tracing.CustomTag{
+		Tag: tag.GetName(),
+	}
+	switch {
+	case tag.GetHeader() != nil:
+		customTag.Type = &envoy_tracing.CustomTag_RequestHeader{
+			RequestHeader: &envoy_tracing.CustomTag_Header{
+				Name:         tag.GetHeader().GetName(),
+				DefaultValue: tag.GetHeader().GetDefault(),
+			},
+		}
+	case tag.GetEnvironment() != nil:
+		customTag.Type = &envoy_tracing.CustomTag_Environment_{
+			Environment: &envoy_tracing.CustomTag_Environment{
+				Name:         tag.GetEnvironment().GetName(),
+				DefaultValue: tag.GetEnvironment().GetDefault(),
+			},
+		}
+	default:
+		customTag.Type = &envoy_tracing.CustomTag_Literal_{
+			Literal: &envoy_tracing.CustomTag_Literal{
+				Value: tag.GetLiteral(),
+			},
+		}
+	}
+	return customTag
+}
+
 func zipkinConfig(cfgStr *structpb.Struct, backendName string) (*envoy_trace.Tracing_Http, error) {
 	cfg := mesh_proto.ZipkinTracingBackendConfig{}
 	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
@@ -77,6 +144,71 @@ func zipkinConfig(cfgStr *structpb.Struct, backendName string) (*envoy_trace.Tra
 	return tracingConfig, nil
 }
 
+func datadogConfig(cfgStr *structpb.Struct, backendName string, service string) (*envoy_trace.Tracing_Http, error) {
+	cfg := mesh_proto.DatadogTracingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend")
+	}
+
+	datadogConfig := envoy_trace.DatadogConfig{
+		CollectorCluster: names.GetTracingClusterName(backendName),
+		ServiceName:      service,
+	}
+	datadogConfigAny, err := proto.MarshalAnyDeterministic(&datadogConfig)
+	if err != nil {
+		return nil, err
+	}
+	tracingConfig := &envoy_trace.Tracing_Http{
+		Name: "envoy.tracers.datadog",
+		ConfigType: &envoy_trace.Tracing_Http_TypedConfig{
+			TypedConfig: datadogConfigAny,
+		},
+	}
+	return tracingConfig, nil
+}
+
+// openTelemetryConfig exports spans to the OpenCensus receiver of OpenTelemetry collector.
+// Unlike Zipkin and Datadog, the collector cannot be reached through a cluster generated by TracingProxyGenerator:
+// OpenCensus tracer of Envoy exports spans with its own gRPC channel and rejects ocagent_grpc_service
+// other than Google gRPC ("Opencensus ocagent tracer only support GoogleGrpc"), so EnvoyGrpc cannot be used.
+func openTelemetryConfig(cfgStr *structpb.Struct) (*envoy_trace.Tracing_Http, error) {
+	cfg := mesh_proto.OpenTelemetryTracingBackendConfig{}
+	if err := proto.ToTyped(cfgStr, &cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend")
+	}
+
+	openCensusConfig := envoy_trace.OpenCensusConfig{
+		OcagentExporterEnabled: true,
+		OcagentGrpcService: &envoy_core.GrpcService{
+			TargetSpecifier: &envoy_core.GrpcService_GoogleGrpc_{
+				GoogleGrpc: &envoy_core.GrpcService_GoogleGrpc{
+					TargetUri:  cfg.Address,
+					StatPrefix: "opentelemetry",
+				},
+			},
+		},
+		IncomingTraceContext: []envoy_trace.OpenCensusConfig_TraceContext{
+			envoy_trace.OpenCensusConfig_TRACE_CONTEXT,
+			envoy_trace.OpenCensusConfig_B3,
+		},
+		OutgoingTraceContext: []envoy_trace.OpenCensusConfig_TraceContext{
+			envoy_trace.OpenCensusConfig_TRACE_CONTEXT,
+			envoy_trace.OpenCensusConfig_B3,
+		},
+	}
+	openCensusConfigAny, err := proto.MarshalAnyDeterministic(&openCensusConfig)
+	if err != nil {
+		return nil, err
+	}
+	tracingConfig := &envoy_trace.Tracing_Http{
+		Name: "envoy.tracers.opencensus",
+		ConfigType: &envoy_trace.Tracing_Http_TypedConfig{
+			TypedConfig: openCensusConfigAny,
+		},
+	}
+	return tracingConfig, nil
+}
+
 func apiVersion(zipkin *mesh_proto.ZipkinTracingBackendConfig, url *net_url.URL) envoy_trace.ZipkinConfig_CollectorEndpointVersion {
 	if zipkin.ApiVersion == "" { // try to infer it from the URL
 		if url.Path == "/api/v2/spans" {
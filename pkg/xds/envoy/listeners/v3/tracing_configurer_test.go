@@ -3,6 +3,7 @@ package v3_test
 import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 
+	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/xds"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -18,8 +19,9 @@ import (
 var _ = Describe("TracingConfigurer", func() {
 
 	type testCase struct {
-		backend  *mesh_proto.TracingBackend
-		expected string
+		backend      *mesh_proto.TracingBackend
+		trafficTrace *mesh_core.TrafficTraceResource
+		expected     string
 	}
 
 	DescribeTable("should generate proper Envoy config",
@@ -29,7 +31,7 @@ var _ = Describe("TracingConfigurer", func() {
 				Configure(InboundListener("inbound:192.168.0.1:8080", "192.168.0.1", 8080, xds.SocketAddressProtocolTCP)).
 				Configure(FilterChain(NewFilterChainBuilder(envoy.APIV3).
 					Configure(HttpConnectionManager("localhost:8080", false)).
-					Configure(Tracing(given.backend)))).
+					Configure(Tracing(given.backend, given.trafficTrace, "backend")))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -74,6 +76,88 @@ var _ = Describe("TracingConfigurer", func() {
                         collectorEndpointVersion: HTTP_JSON
                         collectorHostname: zipkin.us:9090
             name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND`,
+		}),
+		Entry("backend specified with sampling and custom tags of traffic trace", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name:     "zipkin",
+				Sampling: &wrappers.DoubleValue{Value: 30.5},
+				Type:     mesh_proto.TracingZipkinType,
+				Conf: util_proto.MustToStruct(&mesh_proto.ZipkinTracingBackendConfig{
+					Url: "http://zipkin.us:9090/v2/spans",
+				}),
+			},
+			trafficTrace: &mesh_core.TrafficTraceResource{
+				Spec: &mesh_proto.TrafficTrace{
+					Conf: &mesh_proto.TrafficTrace_Conf{
+						Backend: "zipkin",
+						Sampling: &mesh_proto.TrafficTrace_Conf_Sampling{
+							Random:  &wrappers.DoubleValue{Value: 10},
+							Client:  &wrappers.DoubleValue{Value: 100},
+							Overall: &wrappers.DoubleValue{Value: 50},
+						},
+						Tags: []*mesh_proto.TrafficTrace_Conf_Tag{
+							{
+								Name:    "team",
+								Literal: "core",
+							},
+							{
+								Name: "user",
+								Header: &mesh_proto.TrafficTrace_Conf_Tag_Header{
+									Name:    "x-user-id",
+									Default: "anonymous",
+								},
+							},
+							{
+								Name: "pod",
+								Environment: &mesh_proto.TrafficTrace_Conf_Tag_Environment{
+									Name: "HOSTNAME",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: localhost_8080
+                  tracing:
+                    clientSampling:
+                      value: 100
+                    customTags:
+                    - literal:
+                        value: core
+                      tag: team
+                    - requestHeader:
+                        defaultValue: anonymous
+                        name: x-user-id
+                      tag: user
+                    - environment:
+                        name: HOSTNAME
+                      tag: pod
+                    overallSampling:
+                      value: 50
+                    randomSampling:
+                      value: 10
+                    provider:
+                      name: envoy.zipkin
+                      typedConfig:
+                        '@type': type.googleapis.com/envoy.config.trace.v3.ZipkinConfig
+                        collectorCluster: tracing:zipkin
+                        collectorEndpoint: /v2/spans
+                        collectorEndpointVersion: HTTP_JSON
+                        collectorHostname: zipkin.us:9090
+            name: inbound:192.168.0.1:8080
             trafficDirection: INBOUND`,
 		}),
 		Entry("backend specified without sampling", testCase{
@@ -107,6 +191,78 @@ var _ = Describe("TracingConfigurer", func() {
                         collectorEndpointVersion: HTTP_JSON
                         collectorHostname: zipkin.us:9090
             name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND`,
+		}),
+		Entry("datadog backend specified", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "datadog",
+				Type: mesh_proto.TracingDatadogType,
+				Conf: util_proto.MustToStruct(&mesh_proto.DatadogTracingBackendConfig{
+					Address: "datadog-agent",
+					Port:    8126,
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: localhost_8080
+                  tracing:
+                    provider:
+                      name: envoy.tracers.datadog
+                      typedConfig:
+                        '@type': type.googleapis.com/envoy.config.trace.v3.DatadogConfig
+                        collectorCluster: tracing:datadog
+                        serviceName: backend
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND`,
+		}),
+		Entry("opentelemetry backend specified", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "otel",
+				Type: mesh_proto.TracingOpenTelemetryType,
+				Conf: util_proto.MustToStruct(&mesh_proto.OpenTelemetryTracingBackendConfig{
+					Address: "otel-collector:55678",
+				}),
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                  httpFilters:
+                  - name: envoy.filters.http.router
+                  statPrefix: localhost_8080
+                  tracing:
+                    provider:
+                      name: envoy.tracers.opencensus
+                      typedConfig:
+                        '@type': type.googleapis.com/envoy.config.trace.v3.OpenCensusConfig
+                        incomingTraceContext:
+                        - TRACE_CONTEXT
+                        - B3
+                        ocagentExporterEnabled: true
+                        ocagentGrpcService:
+                          googleGrpc:
+                            statPrefix: opentelemetry
+                            targetUri: otel-collector:55678
+                        outgoingTraceContext:
+                        - TRACE_CONTEXT
+                        - B3
+            name: inbound:192.168.0.1:8080
             trafficDirection: INBOUND`,
 		}),
 		Entry("no backend specified", testCase{
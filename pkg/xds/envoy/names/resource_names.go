@@ -67,6 +67,10 @@ func GetTracingClusterName(backendName string) string {
 	return fmt.Sprintf("tracing:%s", backendName)
 }
 
+func GetRateLimitServiceClusterName() string {
+	return "kuma:rate_limit_service"
+}
+
 func GetDNSListenerName() string {
 	return "kuma:dns"
 }
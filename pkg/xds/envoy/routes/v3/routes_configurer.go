@@ -27,10 +27,14 @@ type RoutesConfigurer struct {
 
 func (c RoutesConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
 	for _, route := range c.Routes {
+		routeAction := c.routeAction(route.Clusters, route.Modify)
+		if route.RateLimit.IsGlobal() {
+			routeAction.RateLimits = c.globalRateLimits(route.RateLimit)
+		}
 		envoyRoute := &envoy_route.Route{
 			Match: c.routeMatch(route.Match),
 			Action: &envoy_route.Route_Route{
-				Route: c.routeAction(route.Clusters, route.Modify),
+				Route: routeAction,
 			},
 		}
 
@@ -98,16 +102,17 @@ func (c RoutesConfigurer) routeMatch(match *mesh_proto.TrafficRoute_Http_Match)
 	}
 	sort.Strings(headers) // sort for stability of Envoy config
 	for _, headerName := range headers {
-		envoyMatch.Headers = append(envoyMatch.Headers, c.headerMatcher(headerName, match.Headers[headerName]))
+		envoyMatch.Headers = append(envoyMatch.Headers, HeaderMatcher(headerName, match.Headers[headerName]))
 	}
 	if match.GetMethod() != nil {
-		envoyMatch.Headers = append(envoyMatch.Headers, c.headerMatcher(":method", match.GetMethod()))
+		envoyMatch.Headers = append(envoyMatch.Headers, HeaderMatcher(":method", match.GetMethod()))
 	}
 
 	return envoyMatch
 }
 
-func (c RoutesConfigurer) headerMatcher(name string, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) *envoy_route.HeaderMatcher {
+// HeaderMatcher converts Kuma string matcher of a request header into Envoy header matcher.
+func HeaderMatcher(name string, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) *envoy_route.HeaderMatcher {
 	headerMatcher := &envoy_route.HeaderMatcher{
 		Name: name,
 	}
@@ -245,7 +250,7 @@ func (c RoutesConfigurer) setModifications(routeAction *envoy_route.RouteAction,
 func (c *RoutesConfigurer) typedPerFilterConfig(route *envoy_common.Route) (map[string]*any.Any, error) {
 	typedPerFilterConfig := map[string]*any.Any{}
 
-	if route.RateLimit != nil {
+	if route.RateLimit != nil && !route.RateLimit.IsGlobal() {
 		rateLimit, err := c.createRateLimit(route.RateLimit.GetConf().GetHttp())
 		if err != nil {
 			return nil, err
@@ -256,6 +261,42 @@ func (c *RoutesConfigurer) typedPerFilterConfig(route *envoy_common.Route) (map[
 	return typedPerFilterConfig, nil
 }
 
+// globalRateLimits builds descriptors sent to the rate limit service out of destination and source tags,
+// e.g. [("destination.kuma.io/service", "backend"), ("source.kuma.io/service", "web")].
+// Tags matching any value are omitted.
+func (c RoutesConfigurer) globalRateLimits(rateLimit *mesh_proto.RateLimit) []*envoy_route.RateLimit {
+	var actions []*envoy_route.RateLimit_Action
+	for _, selector := range rateLimit.GetDestinations() {
+		actions = append(actions, c.descriptorEntries("destination.", selector.Match)...)
+	}
+	for _, selector := range rateLimit.GetSources() {
+		actions = append(actions, c.descriptorEntries("source.", selector.Match)...)
+	}
+	return []*envoy_route.RateLimit{
+		{
+			Actions: actions,
+		},
+	}
+}
+
+func (c RoutesConfigurer) descriptorEntries(prefix string, tags mesh_proto.SingleValueTagSet) []*envoy_route.RateLimit_Action {
+	var actions []*envoy_route.RateLimit_Action
+	for _, key := range tags.Keys() {
+		if tags[key] == mesh_proto.MatchAllTag {
+			continue
+		}
+		actions = append(actions, &envoy_route.RateLimit_Action{
+			ActionSpecifier: &envoy_route.RateLimit_Action_GenericKey_{
+				GenericKey: &envoy_route.RateLimit_Action_GenericKey{
+					DescriptorKey:   prefix + key,
+					DescriptorValue: tags[key],
+				},
+			},
+		})
+	}
+	return actions
+}
+
 func (c *RoutesConfigurer) createRateLimit(rlHttp *mesh_proto.RateLimit_Conf_Http) (*any.Any, error) {
 	var status *envoy_type_v3.HttpStatus
 	var responseHeaders []*envoy_config_core_v3.HeaderValueOption
@@ -11,16 +11,24 @@ import (
 )
 
 func CreateCaSecret(secret *core_xds.CaSecret) *envoy_auth.Secret {
+	validationContext := &envoy_auth.CertificateValidationContext{
+		TrustedCa: &envoy_core.DataSource{
+			Specifier: &envoy_core.DataSource_InlineBytes{
+				InlineBytes: bytes.Join(secret.PemCerts, []byte("\n")),
+			},
+		},
+	}
+	if len(secret.PemCrls) > 0 {
+		validationContext.Crl = &envoy_core.DataSource{
+			Specifier: &envoy_core.DataSource_InlineBytes{
+				InlineBytes: bytes.Join(secret.PemCrls, []byte("\n")),
+			},
+		}
+	}
 	return &envoy_auth.Secret{
 		Name: tls.MeshCaResource,
 		Type: &envoy_auth.Secret_ValidationContext{
-			ValidationContext: &envoy_auth.CertificateValidationContext{
-				TrustedCa: &envoy_core.DataSource{
-					Specifier: &envoy_core.DataSource_InlineBytes{
-						InlineBytes: bytes.Join(secret.PemCerts, []byte("\n")),
-					},
-				},
-			},
+			ValidationContext: validationContext,
 		},
 	}
 }
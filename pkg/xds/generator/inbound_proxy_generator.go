@@ -1,6 +1,9 @@
 package generator
 
 import (
+	"net"
+	"strconv"
+
 	"github.com/pkg/errors"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -57,15 +60,17 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 			Origin:   OriginInbound,
 		})
 
+		service := iface.GetService()
 		routes, err := g.buildInboundRoutes(
 			envoy_common.NewCluster(envoy_common.WithService(localClusterName)),
-			proxy.Policies.RateLimits[endpoint])
+			service,
+			proxy.Policies.RateLimits[endpoint],
+			ctx.Mesh.Resource)
 		if err != nil {
 			return nil, err
 		}
 
 		// generate LDS resource
-		service := iface.GetService()
 		inboundListenerName := envoy_names.GetInboundListenerName(endpoint.DataplaneIP, endpoint.DataplanePort)
 		filterChainBuilder := func() *envoy_listeners.FilterChainBuilder {
 			filterChainBuilder := envoy_listeners.NewFilterChainBuilder(proxy.APIVersion)
@@ -75,30 +80,49 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName, true)).
 					Configure(envoy_listeners.FaultInjection(proxy.Policies.FaultInjections[endpoint])).
-					Configure(envoy_listeners.RateLimit(proxy.Policies.RateLimits[endpoint])).
-					Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend)).
+					Configure(envoy_listeners.RateLimit(proxy.Policies.RateLimits[endpoint], ctx.Mesh.Resource)).
+					Configure(envoy_listeners.HttpRBAC(ctx.Mesh.Resource.MTLSEnabled(), proxy.Policies.TrafficPermissions[endpoint])).
+					Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend, proxy.Policies.TrafficTrace, service)).
 					Configure(envoy_listeners.HttpInboundRoutes(service, routes))
 			case mesh_core.ProtocolGRPC:
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName, true)).
 					Configure(envoy_listeners.GrpcStats()).
 					Configure(envoy_listeners.FaultInjection(proxy.Policies.FaultInjections[endpoint])).
-					Configure(envoy_listeners.RateLimit(proxy.Policies.RateLimits[endpoint])).
-					Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend)).
+					Configure(envoy_listeners.RateLimit(proxy.Policies.RateLimits[endpoint], ctx.Mesh.Resource)).
+					Configure(envoy_listeners.HttpRBAC(ctx.Mesh.Resource.MTLSEnabled(), proxy.Policies.TrafficPermissions[endpoint])).
+					Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend, proxy.Policies.TrafficTrace, service)).
 					Configure(envoy_listeners.HttpInboundRoutes(service, routes))
 			case mesh_core.ProtocolKafka:
 				filterChainBuilder.
 					Configure(envoy_listeners.Kafka(localClusterName)).
-					Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.NewCluster(envoy_common.WithService(localClusterName))))
+					Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.NewCluster(envoy_common.WithService(localClusterName)))).
+					Configure(envoy_listeners.TcpRateLimit(localClusterName, proxy.Policies.RateLimits[endpoint]))
+			case mesh_core.ProtocolMongo, mesh_core.ProtocolMySQL, mesh_core.ProtocolPostgres:
+				filterChainBuilder.
+					Configure(protocolStats(protocol, localClusterName)).
+					Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.NewCluster(envoy_common.WithService(localClusterName)))).
+					Configure(envoy_listeners.TcpRateLimit(localClusterName, proxy.Policies.RateLimits[endpoint]))
+			case mesh_core.ProtocolRedis:
+				if iface.GetTags()[mesh_proto.RedisProxyTag] == "enabled" {
+					filterChainBuilder.
+						Configure(envoy_listeners.RedisProxy(localClusterName, localClusterName)).
+						Configure(envoy_listeners.TcpRateLimit(localClusterName, proxy.Policies.RateLimits[endpoint]))
+					break
+				}
+				// without command-level proxying Redis traffic, including AUTH, is passed through as opaque TCP
+				fallthrough
 			case mesh_core.ProtocolTCP:
 				fallthrough
 			default:
 				// configuration for non-HTTP cases
-				filterChainBuilder.Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.NewCluster(envoy_common.WithService(localClusterName))))
+				filterChainBuilder.
+					Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.NewCluster(envoy_common.WithService(localClusterName)))).
+					Configure(envoy_listeners.TcpRateLimit(localClusterName, proxy.Policies.RateLimits[endpoint]))
 			}
 			return filterChainBuilder.
 				Configure(envoy_listeners.ServerSideMTLS(ctx, proxy.Metadata)).
-				Configure(envoy_listeners.NetworkRBAC(inboundListenerName, ctx.Mesh.Resource.MTLSEnabled(), proxy.Policies.TrafficPermissions[endpoint]))
+				Configure(envoy_listeners.NetworkRBAC(inboundListenerName, ctx.Mesh.Resource.MTLSEnabled(), proxy.Policies.TrafficPermissions[endpoint], proxy.Policies.TrafficDenyPermissions[endpoint]...))
 		}()
 		inboundListener, err := envoy_listeners.NewListenerBuilder(proxy.APIVersion).
 			Configure(envoy_listeners.InboundListener(inboundListenerName, endpoint.DataplaneIP, endpoint.DataplanePort, model.SocketAddressProtocolTCP)).
@@ -114,48 +138,124 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 			Origin:   OriginInbound,
 		})
 	}
+	if rlsCluster, err := g.buildRateLimitServiceCluster(ctx.Mesh.Resource, proxy); err != nil {
+		return nil, err
+	} else if rlsCluster != nil {
+		resources.Add(rlsCluster)
+	}
 	return resources, nil
 }
 
-func (g *InboundProxyGenerator) buildInboundRoutes(cluster envoy_common.Cluster, rateLimits []*mesh_proto.RateLimit) (envoy_common.Routes, error) {
+// buildRateLimitServiceCluster generates a cluster pointing to the rate limit service of the Mesh
+// if any inbound of the Dataplane is rate limited in GLOBAL mode.
+func (g *InboundProxyGenerator) buildRateLimitServiceCluster(mesh *mesh_core.MeshResource, proxy *model.Proxy) (*model.Resource, error) {
+	service := mesh.Spec.GetRateLimits().GetService()
+	if service == nil {
+		return nil, nil
+	}
+	global := false
+	for _, rateLimits := range proxy.Policies.RateLimits {
+		for _, rateLimit := range rateLimits {
+			if rateLimit.IsGlobal() {
+				global = true
+			}
+		}
+	}
+	if !global {
+		return nil, nil
+	}
+	host, port, err := net.SplitHostPort(service.GetAddress())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid address of the rate limit service %q", service.GetAddress())
+	}
+	portValue, err := strconv.ParseUint(port, 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid port of the rate limit service %q", service.GetAddress())
+	}
+	name := envoy_names.GetRateLimitServiceClusterName()
+	cluster, err := envoy_clusters.NewClusterBuilder(proxy.APIVersion).
+		Configure(envoy_clusters.DNSCluster(name, host, uint32(portValue))).
+		Configure(envoy_clusters.Http2()).
+		Build()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not generate cluster %s", name)
+	}
+	return &model.Resource{
+		Name:     name,
+		Resource: cluster,
+		Origin:   OriginInbound,
+	}, nil
+}
+
+func (g *InboundProxyGenerator) buildInboundRoutes(cluster envoy_common.Cluster, service string, rateLimits []*mesh_proto.RateLimit, mesh *mesh_core.MeshResource) (envoy_common.Routes, error) {
 	routes := envoy_common.Routes{}
 
 	// Iterate over that RateLimits and generate the relevant Routes.
 	// We do assume that the rateLimits resource is sorted, so the most
 	// specific source matches come first.
 	for _, rateLimit := range rateLimits {
-		if rateLimit.GetConf().GetHttp() != nil {
-			route := envoy_common.NewRouteFromCluster(cluster)
-			if len(rateLimit.GetSources()) > 0 {
-				if route.Match == nil {
-					route.Match = &mesh_proto.TrafficRoute_Http_Match{}
-				}
+		if rateLimit.GetConf().GetHttp() == nil {
+			continue
+		}
+		if !rateLimit.IsGlobal() {
+			routes = append(routes, g.rateLimitedRoute(cluster, rateLimit.SourceTags(), rateLimit))
+			continue
+		}
+		// Global rate limits are enforced only when the Mesh points to a rate limit service.
+		if mesh.Spec.GetRateLimits().GetService() == nil {
+			continue
+		}
+		// The rate limit service receives descriptors of a single source,
+		// so we generate a separate route for every source selector.
+		destinations := []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: service},
+		}}
+		if len(rateLimit.GetSources()) == 0 {
+			routes = append(routes, g.rateLimitedRoute(cluster, nil, &mesh_proto.RateLimit{
+				Destinations: destinations,
+				Conf:         rateLimit.GetConf(),
+			}))
+			continue
+		}
+		for _, source := range rateLimit.GetSources() {
+			routes = append(routes, g.rateLimitedRoute(cluster, []mesh_proto.SingleValueTagSet{source.Match}, &mesh_proto.RateLimit{
+				Sources:      []*mesh_proto.Selector{source},
+				Destinations: destinations,
+				Conf:         rateLimit.GetConf(),
+			}))
+		}
+	}
 
-				if route.Match.Headers == nil {
-					route.Match.Headers = make(map[string]*mesh_proto.TrafficRoute_Http_Match_StringMatcher)
-				}
+	// Add the defaul fall-back route
+	routes = append(routes, envoy_common.NewRouteFromCluster(cluster))
 
-				var selectorRegexs []string
-				for _, selector := range rateLimit.SourceTags() {
-					selectorRegexs = append(selectorRegexs, tags.MatchingRegex(selector))
-				}
-				regexOR := tags.RegexOR(selectorRegexs...)
+	return routes, nil
+}
 
-				route.Match.Headers[v3.TagsHeaderName] = &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
-					MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Regex{
-						Regex: regexOR,
-					},
-				}
-			}
+func (g *InboundProxyGenerator) rateLimitedRoute(cluster envoy_common.Cluster, sources []mesh_proto.SingleValueTagSet, rateLimit *mesh_proto.RateLimit) envoy_common.Route {
+	route := envoy_common.NewRouteFromCluster(cluster)
+	if len(sources) > 0 {
+		if route.Match == nil {
+			route.Match = &mesh_proto.TrafficRoute_Http_Match{}
+		}
+
+		if route.Match.Headers == nil {
+			route.Match.Headers = make(map[string]*mesh_proto.TrafficRoute_Http_Match_StringMatcher)
+		}
 
-			route.RateLimit = rateLimit
+		var selectorRegexs []string
+		for _, selector := range sources {
+			selectorRegexs = append(selectorRegexs, tags.MatchingRegex(selector))
+		}
+		regexOR := tags.RegexOR(selectorRegexs...)
 
-			routes = append(routes, route)
+		route.Match.Headers[v3.TagsHeaderName] = &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+			MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Regex{
+				Regex: regexOR,
+			},
 		}
 	}
 
-	// Add the defaul fall-back route
-	routes = append(routes, envoy_common.NewRouteFromCluster(cluster))
-
-	return routes, nil
+	route.RateLimit = rateLimit
+	return route
 }
@@ -204,6 +204,37 @@ var _ = Describe("InboundProxyGenerator", func() {
 								},
 							},
 						},
+						mesh_proto.InboundInterface{
+							DataplaneIP:   "192.168.0.1",
+							DataplanePort: 9092,
+							WorkloadIP:    "127.0.0.1",
+							WorkloadPort:  19092,
+						}: []*mesh_proto.RateLimit{
+							{
+								Sources: []*mesh_proto.Selector{
+									{
+										Match: map[string]string{
+											"kuma.io/service": "*",
+										},
+									},
+								},
+								Destinations: []*mesh_proto.Selector{
+									{
+										Match: map[string]string{
+											"kuma.io/service": "kafka",
+										},
+									},
+								},
+								Conf: &mesh_proto.RateLimit_Conf{
+									Tcp: &mesh_proto.RateLimit_Conf_Tcp{
+										Connections: 100,
+										Interval: &duration.Duration{
+											Seconds: 1,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 				Metadata: &model.DataplaneMetadata{},
@@ -243,5 +274,13 @@ var _ = Describe("InboundProxyGenerator", func() {
 			dataplaneFile: "4-dataplane.input.yaml",
 			expected:      "4-envoy-config.golden.yaml",
 		}),
+		Entry("05. protocols=redis,mongo,mysql,postgres", testCase{
+			dataplaneFile: "5-dataplane.input.yaml",
+			expected:      "5-envoy-config.golden.yaml",
+		}),
+		Entry("06. protocol=kafka", testCase{
+			dataplaneFile: "6-dataplane.input.yaml",
+			expected:      "6-envoy-config.golden.yaml",
+		}),
 	)
 })
@@ -105,21 +105,22 @@ func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, routes envoy_com
 		case mesh_core.ProtocolGRPC:
 			filterChainBuilder.
 				Configure(envoy_listeners.HttpConnectionManager(serviceName, false)).
-				Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend)).
-				Configure(envoy_listeners.HttpAccessLog(meshName, envoy_common.TrafficDirectionOutbound, sourceService, serviceName, proxy.Policies.Logs[serviceName], proxy)).
+				Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend, proxy.Policies.TrafficTrace, sourceService)).
+				Configure(envoy_listeners.HttpAccessLog(meshName, envoy_common.TrafficDirectionOutbound, sourceService, serviceName, proxy.Policies.Logs[serviceName], proxy.Policies.LogFilters[serviceName], proxy)).
 				Configure(envoy_listeners.HttpOutboundRoute(serviceName, routes, proxy.Dataplane.Spec.TagSet())).
 				Configure(envoy_listeners.Retry(retryPolicy, protocol)).
 				Configure(envoy_listeners.GrpcStats())
 		case mesh_core.ProtocolHTTP, mesh_core.ProtocolHTTP2:
 			filterChainBuilder.
 				Configure(envoy_listeners.HttpConnectionManager(serviceName, false)).
-				Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend)).
+				Configure(envoy_listeners.Tracing(proxy.Policies.TracingBackend, proxy.Policies.TrafficTrace, sourceService)).
 				Configure(envoy_listeners.HttpAccessLog(
 					meshName,
 					envoy_common.TrafficDirectionOutbound,
 					sourceService,
 					serviceName,
 					proxy.Policies.Logs[serviceName],
+					proxy.Policies.LogFilters[serviceName],
 					proxy,
 				)).
 				Configure(envoy_listeners.HttpOutboundRoute(serviceName, routes, proxy.Dataplane.Spec.TagSet())).
@@ -137,7 +138,42 @@ func (_ OutboundProxyGenerator) generateLDS(proxy *model.Proxy, routes envoy_com
 					proxy,
 				)).
 				Configure(envoy_listeners.MaxConnectAttempts(retryPolicy))
-
+		case mesh_core.ProtocolMongo, mesh_core.ProtocolMySQL, mesh_core.ProtocolPostgres:
+			filterChainBuilder.
+				Configure(protocolStats(protocol, serviceName)).
+				Configure(envoy_listeners.TcpProxy(serviceName, routes.Clusters()...)).
+				Configure(envoy_listeners.NetworkAccessLog(
+					meshName,
+					envoy_common.TrafficDirectionOutbound,
+					sourceService,
+					serviceName,
+					proxy.Policies.Logs[serviceName],
+					proxy,
+				)).
+				Configure(envoy_listeners.MaxConnectAttempts(retryPolicy))
+		case mesh_core.ProtocolRedis:
+			// redis_proxy supports neither traffic split, access logs nor connect retries,
+			// so the traffic is proxied by tcp_proxy whenever any of them applies
+			clusters := routes.Clusters()
+			if len(clusters) == 1 &&
+				redisProxyEnabled(proxy.Routing.OutboundTargets[clusters[0].Service()]) &&
+				proxy.Policies.Logs[serviceName] == nil &&
+				(retryPolicy == nil || retryPolicy.Spec.Conf.GetTcp() == nil) {
+				filterChainBuilder.
+					Configure(envoy_listeners.RedisProxy(serviceName, clusters[0].Name()))
+				break
+			}
+			filterChainBuilder.
+				Configure(envoy_listeners.TcpProxy(serviceName, routes.Clusters()...)).
+				Configure(envoy_listeners.NetworkAccessLog(
+					meshName,
+					envoy_common.TrafficDirectionOutbound,
+					sourceService,
+					serviceName,
+					proxy.Policies.Logs[serviceName],
+					proxy,
+				)).
+				Configure(envoy_listeners.MaxConnectAttempts(retryPolicy))
 		case mesh_core.ProtocolTCP:
 			fallthrough
 		default:
@@ -118,6 +118,54 @@ var _ = Describe("OutboundProxyGenerator", func() {
 						Weight: 1,
 					},
 				},
+				"redis": []model.Endpoint{
+					{
+						Target: "192.168.0.10",
+						Port:   6379,
+						Tags:   map[string]string{"kuma.io/service": "redis", "kuma.io/protocol": "redis", "kuma.io/redis-proxy": "enabled"},
+						Weight: 1,
+					},
+				},
+				"redis-cache": []model.Endpoint{ // notice that endpoints do not opt into command-level proxying
+					{
+						Target: "192.168.0.14",
+						Port:   6379,
+						Tags:   map[string]string{"kuma.io/service": "redis-cache", "kuma.io/protocol": "redis"},
+						Weight: 1,
+					},
+				},
+				"redis-sessions": []model.Endpoint{ // notice that traffic to this service is logged
+					{
+						Target: "192.168.0.15",
+						Port:   6379,
+						Tags:   map[string]string{"kuma.io/service": "redis-sessions", "kuma.io/protocol": "redis", "kuma.io/redis-proxy": "enabled"},
+						Weight: 1,
+					},
+				},
+				"mongo": []model.Endpoint{
+					{
+						Target: "192.168.0.11",
+						Port:   27017,
+						Tags:   map[string]string{"kuma.io/service": "mongo", "kuma.io/protocol": "mongo"},
+						Weight: 1,
+					},
+				},
+				"mysql": []model.Endpoint{
+					{
+						Target: "192.168.0.12",
+						Port:   3306,
+						Tags:   map[string]string{"kuma.io/service": "mysql", "kuma.io/protocol": "mysql"},
+						Weight: 1,
+					},
+				},
+				"postgres": []model.Endpoint{
+					{
+						Target: "192.168.0.13",
+						Port:   5432,
+						Tags:   map[string]string{"kuma.io/service": "postgres", "kuma.io/protocol": "postgres"},
+						Weight: 1,
+					},
+				},
 				"backend": []model.Endpoint{ // notice that not every endpoint has a tag `kuma.io/protocol: http`
 					{
 						Target: "192.168.0.1",
@@ -291,6 +339,66 @@ var _ = Describe("OutboundProxyGenerator", func() {
 								},
 							},
 						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "127.0.0.1",
+							DataplanePort: 16379,
+						}: &mesh_core.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Destination: mesh_proto.MatchService("redis"),
+								},
+							},
+						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "127.0.0.1",
+							DataplanePort: 16380,
+						}: &mesh_core.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Destination: mesh_proto.MatchService("redis-cache"),
+								},
+							},
+						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "127.0.0.1",
+							DataplanePort: 16381,
+						}: &mesh_core.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Destination: mesh_proto.MatchService("redis-sessions"),
+								},
+							},
+						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "127.0.0.1",
+							DataplanePort: 27017,
+						}: &mesh_core.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Destination: mesh_proto.MatchService("mongo"),
+								},
+							},
+						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "127.0.0.1",
+							DataplanePort: 13306,
+						}: &mesh_core.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Destination: mesh_proto.MatchService("mysql"),
+								},
+							},
+						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "127.0.0.1",
+							DataplanePort: 15432,
+						}: &mesh_core.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Destination: mesh_proto.MatchService("postgres"),
+								},
+							},
+						},
 						mesh_proto.OutboundInterface{
 							DataplaneIP:   "127.0.0.1",
 							DataplanePort: 4040,
@@ -314,6 +422,13 @@ var _ = Describe("OutboundProxyGenerator", func() {
 								Address: "logstash:1234",
 							}),
 						},
+						"redis-sessions": &mesh_proto.LoggingBackend{
+							Name: "elk",
+							Type: mesh_proto.LoggingTcpType,
+							Conf: util_proto.MustToStruct(&mesh_proto.TcpLoggingBackendConfig{
+								Address: "logstash:1234",
+							}),
+						},
 					},
 					CircuitBreakers: model.CircuitBreakerMap{
 						"api-http": &mesh_core.CircuitBreakerResource{
@@ -466,6 +581,37 @@ var _ = Describe("OutboundProxyGenerator", func() {
 `,
 			expected: "07.envoy.golden.yaml",
 		}),
+		Entry("08. protocols=redis,mongo,mysql,postgres", testCase{
+			ctx: plainCtx,
+			dataplane: `
+            networking:
+              address: 10.0.0.1
+              inbound:
+              - port: 8080
+                tags:
+                  kuma.io/service: web
+              outbound:
+              - port: 16379
+                tags:
+                  kuma.io/service: redis
+              - port: 16380
+                tags:
+                  kuma.io/service: redis-cache
+              - port: 16381
+                tags:
+                  kuma.io/service: redis-sessions
+              - port: 27017
+                tags:
+                  kuma.io/service: mongo
+              - port: 13306
+                tags:
+                  kuma.io/service: mysql
+              - port: 15432
+                tags:
+                  kuma.io/service: postgres
+`,
+			expected: "08.envoy.golden.yaml",
+		}),
 	)
 
 	It("Add sanitized alternative cluster name for stats", func() {
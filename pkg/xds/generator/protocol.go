@@ -4,6 +4,7 @@ import (
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	mesh_core "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
 )
 
 var (
@@ -12,11 +13,15 @@ var (
 	// GRPC has a protocol stack [GRPC, HTTP2, TCP],
 	// TCP  has a protocol stack [TCP].
 	protocolStacks = map[mesh_core.Protocol]mesh_core.ProtocolList{
-		mesh_core.ProtocolGRPC:  {mesh_core.ProtocolGRPC, mesh_core.ProtocolHTTP2, mesh_core.ProtocolTCP},
-		mesh_core.ProtocolHTTP2: {mesh_core.ProtocolHTTP2, mesh_core.ProtocolTCP},
-		mesh_core.ProtocolHTTP:  {mesh_core.ProtocolHTTP, mesh_core.ProtocolTCP},
-		mesh_core.ProtocolKafka: {mesh_core.ProtocolKafka, mesh_core.ProtocolTCP},
-		mesh_core.ProtocolTCP:   {mesh_core.ProtocolTCP},
+		mesh_core.ProtocolGRPC:     {mesh_core.ProtocolGRPC, mesh_core.ProtocolHTTP2, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolHTTP2:    {mesh_core.ProtocolHTTP2, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolHTTP:     {mesh_core.ProtocolHTTP, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolKafka:    {mesh_core.ProtocolKafka, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolRedis:    {mesh_core.ProtocolRedis, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolMongo:    {mesh_core.ProtocolMongo, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolMySQL:    {mesh_core.ProtocolMySQL, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolPostgres: {mesh_core.ProtocolPostgres, mesh_core.ProtocolTCP},
+		mesh_core.ProtocolTCP:      {mesh_core.ProtocolTCP},
 	}
 )
 
@@ -65,3 +70,30 @@ func InferServiceProtocol(endpoints []core_xds.Endpoint) mesh_core.Protocol {
 	}
 	return serviceProtocol
 }
+
+// protocolStats returns a filter that collects protocol specific stats of the TCP connection.
+func protocolStats(protocol mesh_core.Protocol, statsName string) envoy_listeners.FilterChainBuilderOpt {
+	switch protocol {
+	case mesh_core.ProtocolMongo:
+		return envoy_listeners.Mongo(statsName)
+	case mesh_core.ProtocolMySQL:
+		return envoy_listeners.MySQL(statsName)
+	case mesh_core.ProtocolPostgres:
+		return envoy_listeners.Postgres(statsName)
+	default:
+		return envoy_listeners.FilterChainBuilderOptFunc(func(*envoy_listeners.FilterChainBuilderConfig) {})
+	}
+}
+
+// redisProxyEnabled returns true when all endpoints opt into command-level proxying of Redis traffic.
+func redisProxyEnabled(endpoints []core_xds.Endpoint) bool {
+	if len(endpoints) == 0 {
+		return false
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.Tags[mesh_proto.RedisProxyTag] != "enabled" {
+			return false
+		}
+	}
+	return true
+}
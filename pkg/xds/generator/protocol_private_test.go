@@ -107,5 +107,15 @@ var _ = Describe("getCommonProtocol()", func() {
 			another:  mesh_core.ProtocolTCP,
 			expected: mesh_core.ProtocolTCP,
 		}),
+		Entry("`redis` and `tcp`", testCase{
+			one:      mesh_core.ProtocolRedis,
+			another:  mesh_core.ProtocolTCP,
+			expected: mesh_core.ProtocolTCP,
+		}),
+		Entry("`postgres` and `mysql`", testCase{
+			one:      mesh_core.ProtocolPostgres,
+			another:  mesh_core.ProtocolMySQL,
+			expected: mesh_core.ProtocolTCP,
+		}),
 	)
 })
@@ -53,6 +53,12 @@ var _ = Describe("InferServiceProtocol()", func() {
 			},
 			expected: mesh_core.ProtocolKafka,
 		}),
+		Entry("one-item list: `kuma.io/protocol: redis`", testCase{
+			endpoints: []core_xds.Endpoint{
+				{Tags: map[string]string{"kuma.io/service": "redis", "kuma.io/protocol": "redis"}},
+			},
+			expected: mesh_core.ProtocolRedis,
+		}),
 		Entry("one-item list: `kuma.io/protocol: tcp`", testCase{
 			endpoints: []core_xds.Endpoint{
 				{Tags: map[string]string{"kuma.io/service": "backend", "kuma.io/protocol": "tcp"}},
@@ -99,5 +99,33 @@ var _ = Describe("TracingProxyGenerator", func() {
 			},
 			expected: "zipkin.envoy-config.golden.yaml",
 		}),
+		Entry("should create cluster for Datadog", testCase{
+			proxy: &model.Proxy{
+				Id: model.ProxyId{Name: "demo.backend-01"},
+				Dataplane: &mesh_core.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: "demo",
+					},
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "192.168.0.1",
+						},
+					},
+				},
+				APIVersion: envoy_common.APIV3,
+				Policies: model.MatchedPolicies{
+					TracingBackend: &mesh_proto.TracingBackend{
+						Name: "datadog",
+						Type: mesh_proto.TracingDatadogType,
+						Conf: util_proto.MustToStruct(&mesh_proto.DatadogTracingBackendConfig{
+							Address: "datadog-agent.us",
+							Port:    8126,
+						}),
+					},
+				},
+			},
+			expected: "datadog.envoy-config.golden.yaml",
+		}),
 	)
 })
@@ -149,7 +149,12 @@ func (p *DataplaneProxyBuilder) matchPolicies(ctx context.Context, meshContext *
 		return nil, err
 	}
 
-	matchedLogs, err := p.LogsMatcher.Match(ctx, dataplane)
+	matchedDenyPermissions, err := p.PermissionMatcher.MatchDeny(ctx, dataplane, meshContext.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	matchedLogs, matchedLogFilters, err := p.LogsMatcher.Match(ctx, dataplane)
 	if err != nil {
 		return nil, err
 	}
@@ -170,16 +175,18 @@ func (p *DataplaneProxyBuilder) matchPolicies(ctx context.Context, meshContext *
 	}
 
 	matchedPolicies := &xds.MatchedPolicies{
-		TrafficPermissions: matchedPermissions,
-		Logs:               matchedLogs,
-		HealthChecks:       healthChecks,
-		CircuitBreakers:    circuitBreakers,
-		TrafficTrace:       trafficTrace,
-		TracingBackend:     tracingBackend,
-		FaultInjections:    faultInjection,
-		Retries:            retries,
-		Timeouts:           timeouts,
-		RateLimits:         ratelimits,
+		TrafficPermissions:     matchedPermissions,
+		TrafficDenyPermissions: matchedDenyPermissions,
+		Logs:                   matchedLogs,
+		LogFilters:             matchedLogFilters,
+		HealthChecks:           healthChecks,
+		CircuitBreakers:        circuitBreakers,
+		TrafficTrace:           trafficTrace,
+		TracingBackend:         tracingBackend,
+		FaultInjections:        faultInjection,
+		Retries:                retries,
+		Timeouts:               timeouts,
+		RateLimits:             ratelimits,
 	}
 	return matchedPolicies, nil
 }
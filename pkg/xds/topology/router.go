@@ -37,6 +37,9 @@ func (m *pseudoMeta) GetCreationTime() time.Time {
 func (m *pseudoMeta) GetModificationTime() time.Time {
 	return time.Now()
 }
+func (m *pseudoMeta) GetLabels() map[string]string {
+	return nil
+}
 
 // GetRoutes picks a single the most specific route for each outbound interface of a given Dataplane.
 func GetRoutes(ctx context.Context, dataplane *mesh_core.DataplaneResource, manager core_manager.ReadOnlyResourceManager) (core_xds.RouteMap, error) {
@@ -39,6 +39,9 @@ gen_help kumactl install metrics
 gen_help kumactl install tracing
 gen_help kumactl generate tls-certificate
 gen_help kumactl generate dataplane-token
+gen_help kumactl generate user-token
+gen_help kumactl rotate dataplane-token-signing-key
+gen_help kumactl retire dataplane-token-signing-key
 gen_help kumactl get
 gen_help kumactl get meshes
 gen_help kumactl get dataplanes
@@ -58,4 +61,5 @@ gen_help kumactl delete
 gen_help kumactl inspect
 gen_help kumactl inspect dataplanes
 gen_help kumactl inspect zones
+gen_help kumactl inspect traffic-permissions
 gen_help kumactl version